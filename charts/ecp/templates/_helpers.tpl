@@ -127,5 +127,38 @@ defaults to seca.region.
 {{- with .Values.auth.authz.skipProviders }}
 - --authz-skip-providers={{ . }}
 {{- end }}
+{{- if and .Values.auth.authz.enabled .Values.auth.authz.policies }}
+- --authz-policy-crs
 {{- end }}
+{{- if .Values.auth.tokenRevocation.enabled }}
+- --token-revocation
+{{- end }}
+{{- with .Values.auth.serviceAccountTokens }}
+{{- if .enabled }}
+- --sa-token-key=/etc/ecp/sa-token/sa.pub
+- --sa-token-signing-method={{ .signingMethod }}
+- --sa-token-issuer={{ .issuer }}
+- --sa-token-ttl={{ .ttl }}
+- --sa-token-max-ttl={{ .maxTTL }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+
+{{/*
+Secret holding the service-account token keys. Fails rendering when tokens are
+enabled without one: the chart never renders private keys from values.
+*/}}
+{{- define "ecp.saTokenSecretName" -}}
+{{- if not .Values.auth.serviceAccountTokens.existingSecret }}
+{{- fail "auth.serviceAccountTokens.enabled needs auth.serviceAccountTokens.existingSecret: a Secret with the PEM private key under sa.key and its public key under sa.pub" }}
+{{- end }}
+{{- .Values.auth.serviceAccountTokens.existingSecret }}
+{{- end }}
+
+{{/*
+Whether service-account tokens are on: "true" or "".
+*/}}
+{{- define "ecp.saTokensEnabled" -}}
+{{- if and .Values.auth.enabled .Values.auth.serviceAccountTokens.enabled }}true{{- end }}
 {{- end }}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"flag"
 	"log/slog"
 	"os"
 	"time"
@@ -19,8 +20,11 @@ import (
 	arubaconverter "github.com/eu-sovereign-cloud/ecp/csp/aruba/pkg/adapter/converter"
 	arubahandler "github.com/eu-sovereign-cloud/ecp/csp/aruba/pkg/adapter/handler"
 	arubarepository "github.com/eu-sovereign-cloud/ecp/csp/aruba/pkg/adapter/repository"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/envelope"
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
 	frameworkbuilder "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/builder"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/refgraph"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	instancek8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance/backend/kubernetes"
 	computeskuk8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/sku/backend/kubernetes"
 	igwk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway/backend/kubernetes"
@@ -58,15 +62,20 @@ func init() {
 }
 
 func main() {
+	var managerFlags frameworkbuilder.ManagerFlags
+	managerFlags.BindFlags(flag.CommandLine, "aruba")
+	flag.Parse()
+
 	opts := zap.Options{Development: true}
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	mgrOpts := ctrl.Options{
 		Scheme:                 scheme,
 		HealthProbeBindAddress: ":8081",
-		LeaderElection:         false,
-	})
+	}
+	managerFlags.Apply(&mgrOpts)
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), mgrOpts)
 	if err != nil {
 		logger.Error("unable to start manager", "error", err)
 		os.Exit(1)
@@ -78,9 +87,31 @@ func main() {
 		os.Exit(1)
 	}
 
+	sealer, err := envelope.SealerFromKeyFile(os.Getenv(envelope.KeyFileEnv))
+	if err != nil {
+		logger.Error("unable to load encryption key file", "error", err)
+		os.Exit(1)
+	}
+
+	sharder, err := managerFlags.Sharder(mgr, logger.With("component", "sharder"))
+	if err != nil {
+		logger.Error("unable to set up sharding", "error", err)
+		os.Exit(1)
+	}
+
 	controllerOpts := []frameworkbuilder.Option{
 		frameworkbuilder.WithLogger(logger.With("component", "controller-set")),
 		frameworkbuilder.WithRequeueAfter(1 * time.Second),
+		frameworkbuilder.WithPlugin("aruba"),
+		frameworkbuilder.WithReferenceGraph(refgraph.New(dynClient, commonbackend.Referrers...)),
+		// No tenant holds more than half of the reconcile workers of a controller.
+		frameworkbuilder.WithFairQueue(frameworkbuilder.DefaultMaxConcurrentReconciles / 2),
+		frameworkbuilder.WithSharder(sharder),
+		frameworkbuilder.WithSealer(sealer),
+		// A provider API call that hangs fails, and is retried, instead of holding a worker.
+		frameworkbuilder.WithOperationTimeout(2 * time.Minute),
+		// Tags edited in the Aruba console are put back within the hour.
+		frameworkbuilder.WithDriftCheckInterval(time.Hour),
 	}
 
 	controllerSet := frameworkbuilder.NewControllerSet()
@@ -115,7 +146,6 @@ func loadControllers(ctx context.Context, dynClient dynamic.Interface, mgr ctrl.
 	// their security groups, and the standalone rules those groups reference).
 	secaWsRepo := k8sadapter.NewReaderAdapter(dynClient, wsk8s.WorkspaceGVR, logger, wsk8s.WorkspaceFromCR)
 	secaSkuRepo := k8sadapter.NewReaderAdapter(dynClient, ssk8s.StorageSKUGVR, logger, ssk8s.StorageSKUFromCR)
-	secaIgwRepo := k8sadapter.NewReaderAdapter(dynClient, igwk8s.InternetGatewayGVR, logger, igwk8s.InternetGatewayFromCR)
 	secaNicRepo := k8sadapter.NewReaderAdapter(dynClient, nick8s.NICGVR, logger, nick8s.NicFromCR)
 	secaSgRepo := k8sadapter.NewReaderAdapter(dynClient, sgk8s.SecurityGroupGVR, logger, sgk8s.SecurityGroupFromCR)
 	secaSgrRepo := k8sadapter.NewReaderAdapter(dynClient, sgrk8s.SecurityGroupRuleGVR, logger, sgrk8s.SecurityGroupRuleFromCR)
@@ -142,7 +172,7 @@ func loadControllers(ctx context.Context, dynClient dynamic.Interface, mgr ctrl.
 	// Create aruba-specific handlers
 	wsPlugin := arubahandler.NewWorkspaceHandler(wr, wc)
 	bsPlugin := arubahandler.NewBlockStorageHandler(secaWsRepo, secaSkuRepo, br, wr, bc, wc)
-	netPlugin := arubahandler.NewNetworkHandler(secaWsRepo, secaIgwRepo, vpcRepo, wr, netConv, wc)
+	netPlugin := arubahandler.NewNetworkHandler(secaWsRepo, vpcRepo, wr, netConv, wc)
 	subnetPlugin := arubahandler.NewSubnetHandler(secaWsRepo, subnetRepo, vpcRepo, wr, subnetConv, wc)
 	pipPlugin := arubahandler.NewPublicIpHandler(secaWsRepo, eipRepo, wr, pipConv, wc)
 	instancePlugin := arubahandler.NewComputeInstanceHandler(secaWsRepo, secaNicRepo, secaSgRepo, secaSgrRepo,
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
+	publicipdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/public-ip"
+	subnetdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/subnet"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
+
+	adaptconverter "github.com/eu-sovereign-cloud/ecp/csp/aruba/pkg/adapter/converter"
+)
+
+// Ensure the handlers report the Aruba objects backing their resources.
+var (
+	_ backend.BackendReferencer[*wsdom.Workspace]      = (*WorkspaceHandler)(nil)
+	_ backend.BackendReferencer[*netdom.Network]       = (*NetworkHandler)(nil)
+	_ backend.BackendReferencer[*subnetdom.Subnet]     = (*SubnetHandler)(nil)
+	_ backend.BackendReferencer[*publicipdom.PublicIp] = (*PublicIpHandler)(nil)
+	_ backend.BackendReferencer[*bsdom.BlockStorage]   = (*BlockStorageHandler)(nil)
+	_ backend.BackendReferencer[*instancedom.Instance] = (*ComputeInstanceHandler)(nil)
+)
+
+// arubaRef identifies the Aruba CR obj as "namespace/name".
+func arubaRef(kind string, obj client.Object) backend.BackendRef {
+	return backend.BackendRef{Kind: kind, ID: obj.GetNamespace() + "/" + obj.GetName()}
+}
+
+// BackendRefs returns the Aruba Project backing the workspace.
+func (h *WorkspaceHandler) BackendRefs(_ context.Context, resource *wsdom.Workspace) ([]backend.BackendRef, error) {
+	project, err := h.converter.FromSECAToAruba(resource)
+	if err != nil {
+		return nil, err
+	}
+	return []backend.BackendRef{arubaRef("Project", project)}, nil
+}
+
+// BackendRefs returns the Aruba VPC backing the network.
+func (h *NetworkHandler) BackendRefs(_ context.Context, resource *netdom.Network) ([]backend.BackendRef, error) {
+	vpc, err := h.netConverter.FromSECAToAruba(resource)
+	if err != nil {
+		return nil, err
+	}
+	return []backend.BackendRef{arubaRef("VPC", vpc)}, nil
+}
+
+// BackendRefs returns the Aruba Subnet backing the subnet.
+func (h *SubnetHandler) BackendRefs(_ context.Context, resource *subnetdom.Subnet) ([]backend.BackendRef, error) {
+	subnet, err := h.subnetConverter.FromSECAToAruba(resource)
+	if err != nil {
+		return nil, err
+	}
+	return []backend.BackendRef{arubaRef("Subnet", subnet)}, nil
+}
+
+// BackendRefs returns the Aruba ElasticIP backing the public IP.
+func (h *PublicIpHandler) BackendRefs(_ context.Context, resource *publicipdom.PublicIp) ([]backend.BackendRef, error) {
+	eip, err := h.pipConverter.FromSECAToAruba(resource)
+	if err != nil {
+		return nil, err
+	}
+	return []backend.BackendRef{arubaRef("ElasticIP", eip)}, nil
+}
+
+// BackendRefs returns the Aruba BlockStorage backing the block storage.
+func (h *BlockStorageHandler) BackendRefs(_ context.Context, resource *bsdom.BlockStorage) ([]backend.BackendRef, error) {
+	bs, err := h.bsConverter.FromSECAToAruba(resource)
+	if err != nil {
+		return nil, err
+	}
+	return []backend.BackendRef{arubaRef("BlockStorage", bs)}, nil
+}
+
+// BackendRefs returns the Aruba CloudServer backing the instance, and its KeyPair when the
+// instance has SSH keys.
+func (h *ComputeInstanceHandler) BackendRefs(_ context.Context, resource *instancedom.Instance) ([]backend.BackendRef, error) {
+	namespace := k8sadapter.ComputeNamespace(resource)
+	refs := []backend.BackendRef{{Kind: "CloudServer", ID: namespace + "/" + resource.Name}}
+	if len(resource.Spec.SshKeys) > 0 {
+		refs = append(refs, backend.BackendRef{Kind: "KeyPair", ID: namespace + "/" + resource.Name + adaptconverter.KeyPairSuffix})
+	}
+	return refs, nil
+}
@@ -39,9 +39,11 @@ var _ instancek8s.InstancePlugin = (*ComputeInstanceHandler)(nil)
 // SECA does not model as its own resource - the security groups (per VPC, at attach time) and the
 // key pair (from the inline ssh key). See csp/aruba/README.md.
 //
-// Missing dependencies (a NIC not created yet, a subnet not active, no ssh key, no security group)
-// gate the create with backend.ErrStillProcessing: the instance stays in "creating" and is retried,
-// matching the other Aruba handlers. Aruba's CloudServer CRD carries no power field, so PowerOn and
+// The SECA resources an instance references are active before Create is called: the instance
+// slice holds it pending until its NICs and volumes are, and the NIC slice holds each NIC until its
+// subnet is. What is left missing (an Aruba counterpart not provisioned yet, no ssh key, no security
+// group) gates the create with backend.ErrStillProcessing: the instance stays in "creating" and is
+// retried, matching the other Aruba handlers. Aruba's CloudServer CRD carries no power field, so PowerOn and
 // PowerOff are no-ops.
 type ComputeInstanceHandler struct {
 	wsRepository         persistence.ReaderRepo[*wsdom.Workspace]
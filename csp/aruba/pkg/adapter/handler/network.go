@@ -6,10 +6,7 @@ import (
 	"github.com/Arubacloud/arubacloud-resource-operator/api/v1alpha1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
-	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
-	res "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
-	igwdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway"
 	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
 	netk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network/backend/kubernetes"
 	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
@@ -29,7 +26,6 @@ var _ netk8s.NetworkPlugin = (*NetworkHandler)(nil)
 // and managing their lifecycle (Create/Delete).
 type NetworkHandler struct {
 	wsRepository    persistence.ReaderRepo[*wsdom.Workspace]
-	igwRepository   persistence.ReaderRepo[*igwdom.InternetGateway]
 	vpcRepository   repository.Repository[*v1alpha1.VPC, *v1alpha1.VPCList]
 	prjRepository   repository.Repository[*v1alpha1.Project, *v1alpha1.ProjectList]
 	netConverter    converter.Converter[*netdom.Network, *v1alpha1.VPC]
@@ -53,7 +49,6 @@ type ArubaNetworkBundle struct {
 // The handler uses bypass mutators since no mutation is needed on the Aruba VPC objects.
 func NewNetworkHandler(
 	wsRepo persistence.ReaderRepo[*wsdom.Workspace],
-	igwRepo persistence.ReaderRepo[*igwdom.InternetGateway],
 	vpcRepo repository.Repository[*v1alpha1.VPC, *v1alpha1.VPCList],
 	prjRepo repository.Repository[*v1alpha1.Project, *v1alpha1.ProjectList],
 	netConv converter.Converter[*netdom.Network, *v1alpha1.VPC],
@@ -61,7 +56,6 @@ func NewNetworkHandler(
 ) *NetworkHandler {
 	handler := &NetworkHandler{
 		wsRepository:  wsRepo,
-		igwRepository: igwRepo,
 		vpcRepository: vpcRepo,
 		prjRepository: prjRepo,
 		netConverter:  netConv,
@@ -69,7 +63,7 @@ func NewNetworkHandler(
 	}
 
 	handler.createDelegated = delegated.NewDelegated(
-		handler.resolveSecaNetworkDependencies,
+		handler.resolveSecaNetworkWorkspace,
 		handler.FromSECABundleToAruba,
 		handler.resolveArubaNetworkDependencies,
 		mutator_bypass.BypassMutateFunc[*ArubaNetworkBundle, *SecaNetworkBundle],
@@ -136,33 +130,20 @@ func (h *NetworkHandler) BypassDependencyResolver(_ context.Context, domain *net
 	}, nil
 }
 
-// resolveSecaNetworkDependencies gates the VPC creation on the workspace being active and on an
-// InternetGateway existing in that workspace.
+// resolveSecaNetworkWorkspace loads the workspace whose Aruba Project parents the VPC.
 //
-// Only the InternetGateway gates: an Aruba VPC always provides internet egress, so the SECA
-// model must carry the gateway that represents it before the VPC exists. No RouteTable is
-// required - the operator creates VPCs with preset=false, so Aruba never derives a subnet or a
-// route table from the VPC, and both are created independently afterwards.
-func (h *NetworkHandler) resolveSecaNetworkDependencies(ctx context.Context, domain *netdom.Network) (*SecaNetworkBundle, error) {
+// It gates nothing Aruba-specific: the network controller holds the network pending until its
+// workspace, and an internet gateway in it, are active (see the network's declared
+// dependencies). The gateway is what the SECA model needs before the VPC exists, since an Aruba
+// VPC always provides internet egress. No RouteTable is required - the operator creates VPCs
+// with preset=false, so Aruba never derives a subnet or a route table from the VPC, and both
+// are created independently afterwards.
+func (h *NetworkHandler) resolveSecaNetworkWorkspace(ctx context.Context, domain *netdom.Network) (*SecaNetworkBundle, error) {
 	ws, err := loadActiveWorkspace(ctx, h.wsRepository, domain)
 	if err != nil {
 		return nil, err
 	}
 
-	var igws []*igwdom.InternetGateway
-	if _, err := h.igwRepository.List(ctx, res.ListParams{
-		Scope: res.Scope{
-			Tenant:    domain.GetTenant(),
-			Workspace: domain.GetWorkspace(),
-		},
-	}, &igws); err != nil {
-		return nil, err
-	}
-
-	if len(igws) == 0 {
-		return nil, backend.ErrStillProcessing // No internet gateway yet, wait for one to be created
-	}
-
 	return &SecaNetworkBundle{
 		Network:   domain,
 		Workspace: ws,
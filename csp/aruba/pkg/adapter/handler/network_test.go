@@ -8,8 +8,6 @@ import (
 	"github.com/Arubacloud/arubacloud-resource-operator/api/v1alpha1"
 	"go.uber.org/mock/gomock"
 
-	res "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
-	igwdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway"
 	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
 	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
 )
@@ -18,7 +16,6 @@ import (
 // can wire only the behaviour it needs.
 type netMocks struct {
 	wsRepo  *MockReaderRepo[*wsdom.Workspace]
-	igwRepo *MockReaderRepo[*igwdom.InternetGateway]
 	vpcRepo *MockRepository[*v1alpha1.VPC, *v1alpha1.VPCList]
 	prjRepo *MockRepository[*v1alpha1.Project, *v1alpha1.ProjectList]
 	netConv *MockConverter[*netdom.Network, *v1alpha1.VPC]
@@ -28,7 +25,6 @@ type netMocks struct {
 func newNetMocks(ctrl *gomock.Controller) *netMocks {
 	return &netMocks{
 		wsRepo:  NewMockReaderRepo[*wsdom.Workspace](ctrl),
-		igwRepo: NewMockReaderRepo[*igwdom.InternetGateway](ctrl),
 		vpcRepo: NewMockRepository[*v1alpha1.VPC, *v1alpha1.VPCList](ctrl),
 		prjRepo: NewMockRepository[*v1alpha1.Project, *v1alpha1.ProjectList](ctrl),
 		netConv: NewMockConverter[*netdom.Network, *v1alpha1.VPC](ctrl),
@@ -37,7 +33,7 @@ func newNetMocks(ctrl *gomock.Controller) *netMocks {
 }
 
 func (m *netMocks) handler() *NetworkHandler {
-	return NewNetworkHandler(m.wsRepo, m.igwRepo, m.vpcRepo, m.prjRepo, m.netConv, m.wsConv)
+	return NewNetworkHandler(m.wsRepo, m.vpcRepo, m.prjRepo, m.netConv, m.wsConv)
 }
 
 // vpc returns a converted Aruba VPC in the given phase.
@@ -49,20 +45,6 @@ func vpc(phase v1alpha1.ResourcePhase) *v1alpha1.VPC {
 	}
 }
 
-// expectInternetGateway makes the internet gateway reader report count gateways in the
-// workspace, which the network create flow requires before it proceeds.
-func expectInternetGateway(m *MockReaderRepo[*igwdom.InternetGateway], count int) {
-	m.EXPECT().
-		List(gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, _ res.ListFilter, list *[]*igwdom.InternetGateway) (*string, error) {
-			for range count {
-				*list = append(*list, &igwdom.InternetGateway{})
-			}
-			return nil, nil
-		}).
-		AnyTimes()
-}
-
 func TestNetwork_create(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -81,34 +63,10 @@ func TestNetwork_create(t *testing.T) {
 			wantErr:     true,
 			errContains: "operation still in progress",
 		},
-		{
-			// An Aruba VPC always provides internet egress, so the SECA gateway
-			// representing it must exist before the VPC is created.
-			name: "no internet gateway - still processing",
-			setupMocks: func(m *netMocks) {
-				expectWorkspaceActive(m.wsRepo)
-				expectInternetGateway(m.igwRepo, 0)
-			},
-			wantErr:     true,
-			errContains: "operation still in progress",
-		},
-		{
-			name: "internet gateway list error",
-			setupMocks: func(m *netMocks) {
-				expectWorkspaceActive(m.wsRepo)
-				m.igwRepo.EXPECT().
-					List(gomock.Any(), gomock.Any(), gomock.Any()).
-					Return(nil, fmt.Errorf("list error")).
-					AnyTimes()
-			},
-			wantErr:     true,
-			errContains: "list error",
-		},
 		{
 			name: "conversion error",
 			setupMocks: func(m *netMocks) {
 				expectWorkspaceActive(m.wsRepo)
-				expectInternetGateway(m.igwRepo, 1)
 				m.wsConv.EXPECT().
 					FromSECAToAruba(gomock.Any()).
 					Return(nil, fmt.Errorf("conversion error"))
@@ -120,7 +78,6 @@ func TestNetwork_create(t *testing.T) {
 			name: "project not ready - still processing",
 			setupMocks: func(m *netMocks) {
 				expectWorkspaceActive(m.wsRepo)
-				expectInternetGateway(m.igwRepo, 1)
 				m.wsConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(activeProject(), nil).AnyTimes()
 				m.netConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(vpc(v1alpha1.ResourcePhaseCreating), nil).AnyTimes()
 				m.prjRepo.EXPECT().Load(gomock.Any(), gomock.Any()).Return(notFoundErr("project")).AnyTimes()
@@ -132,7 +89,6 @@ func TestNetwork_create(t *testing.T) {
 			name: "create error",
 			setupMocks: func(m *netMocks) {
 				expectWorkspaceActive(m.wsRepo)
-				expectInternetGateway(m.igwRepo, 1)
 				m.wsConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(activeProject(), nil).AnyTimes()
 				m.netConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(vpc(v1alpha1.ResourcePhaseCreating), nil).AnyTimes()
 				m.prjRepo.EXPECT().Load(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -146,7 +102,6 @@ func TestNetwork_create(t *testing.T) {
 			name: "pending creation - still processing",
 			setupMocks: func(m *netMocks) {
 				expectWorkspaceActive(m.wsRepo)
-				expectInternetGateway(m.igwRepo, 1)
 				m.wsConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(activeProject(), nil).AnyTimes()
 				m.netConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(vpc(v1alpha1.ResourcePhaseCreating), nil).AnyTimes()
 				m.prjRepo.EXPECT().Load(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -161,7 +116,6 @@ func TestNetwork_create(t *testing.T) {
 			name: "create idempotent on already exists - still processing",
 			setupMocks: func(m *netMocks) {
 				expectWorkspaceActive(m.wsRepo)
-				expectInternetGateway(m.igwRepo, 1)
 				m.wsConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(activeProject(), nil).AnyTimes()
 				m.netConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(vpc(v1alpha1.ResourcePhaseCreating), nil).AnyTimes()
 				m.prjRepo.EXPECT().Load(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
@@ -175,7 +129,6 @@ func TestNetwork_create(t *testing.T) {
 			name: "success create",
 			setupMocks: func(m *netMocks) {
 				expectWorkspaceActive(m.wsRepo)
-				expectInternetGateway(m.igwRepo, 1)
 				m.wsConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(activeProject(), nil).AnyTimes()
 				m.netConv.EXPECT().FromSECAToAruba(gomock.Any()).Return(vpc(v1alpha1.ResourcePhaseActive), nil).AnyTimes()
 				m.prjRepo.EXPECT().Load(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
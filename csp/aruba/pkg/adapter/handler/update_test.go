@@ -36,7 +36,7 @@ func TestNetworkHandler_Update_syncsLabelsToTags(t *testing.T) {
 
 	m := newNetMocks(ctrl)
 	// The real converter, so the test covers the label -> tag mapping end to end.
-	handler := NewNetworkHandler(m.wsRepo, m.igwRepo, m.vpcRepo, m.prjRepo, adaptconverter.NewNetworkVPCConverter(), m.wsConv)
+	handler := NewNetworkHandler(m.wsRepo, m.vpcRepo, m.prjRepo, adaptconverter.NewNetworkVPCConverter(), m.wsConv)
 
 	// The live VPC still carries the tags it was created with.
 	m.vpcRepo.EXPECT().Load(gomock.Any(), gomock.Any()).DoAndReturn(
@@ -72,7 +72,7 @@ func TestNetworkHandler_Update_writesNothingWhenTagsAlreadyMatch(t *testing.T) {
 	}
 
 	m := newNetMocks(ctrl)
-	handler := NewNetworkHandler(m.wsRepo, m.igwRepo, m.vpcRepo, m.prjRepo, adaptconverter.NewNetworkVPCConverter(), m.wsConv)
+	handler := NewNetworkHandler(m.wsRepo, m.vpcRepo, m.prjRepo, adaptconverter.NewNetworkVPCConverter(), m.wsConv)
 
 	m.vpcRepo.EXPECT().Load(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, v *v1alpha1.VPC) error {
@@ -148,7 +148,7 @@ func TestMain(m *testing.M) {
 	sgRepo = repo[*sgdom.SecurityGroup](sgk8s.SecurityGroupGVR, sgk8s.SecurityGroupToCR, sgk8s.SecurityGroupFromCR)
 	sgrRepo = repo[*sgrdom.SecurityGroupRule](sgrk8s.SecurityGroupRuleGVR, sgrk8s.SecurityGroupRuleToCR, sgrk8s.SecurityGroupRuleFromCR)
 	nicRepo = repo[*nicdom.Nic](nick8s.NICGVR, nick8s.NicToCR, nick8s.NicFromCR)
-	instRepo = repo[*instdom.Instance](instk8s.InstanceGVR, instk8s.InstanceToCR, instk8s.InstanceFromCR)
+	instRepo = repo[*instdom.Instance](instk8s.InstanceGVR, instk8s.InstanceToCR(nil), instk8s.InstanceFromCR(nil))
 
 	// The gateway normally provisions the tenant/workspace/network namespaces; this suite has no
 	// gateway, so create them (mirrors csp/dummy/test/integration TestMain).
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"log/slog"
 	"os"
 	"time"
@@ -15,7 +16,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	dummyplugin "github.com/eu-sovereign-cloud/ecp/csp/dummy/pkg/plugin"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/envelope"
 	frameworkbuilder "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/builder"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/refgraph"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	instancek8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance/backend/kubernetes"
 	internetgatewayk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway/backend/kubernetes"
 	netk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network/backend/kubernetes"
@@ -49,15 +53,20 @@ func init() {
 }
 
 func main() {
+	var managerFlags frameworkbuilder.ManagerFlags
+	managerFlags.BindFlags(flag.CommandLine, "dummy")
+	flag.Parse()
+
 	opts := zap.Options{Development: true}
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	mgrOpts := ctrl.Options{
 		Scheme:                 scheme,
 		HealthProbeBindAddress: ":8081",
-		LeaderElection:         false,
-	})
+	}
+	managerFlags.Apply(&mgrOpts)
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), mgrOpts)
 	if err != nil {
 		logger.Error("unable to start manager", "error", err)
 		os.Exit(1)
@@ -69,6 +78,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	sealer, err := envelope.SealerFromKeyFile(os.Getenv(envelope.KeyFileEnv))
+	if err != nil {
+		logger.Error("unable to load encryption key file", "error", err)
+		os.Exit(1)
+	}
+
 	bsPlugin := dummyplugin.NewBlockStorage(logger.With("plugin", "blockstorage"))
 	imgPlugin := dummyplugin.NewImage(logger.With("plugin", "image"))
 	wsPlugin := dummyplugin.NewWorkspace(logger.With("plugin", "workspace"))
@@ -80,11 +95,23 @@ func main() {
 	subnetPlugin := dummyplugin.NewSubnet(logger.With("plugin", "subnet"))
 	securityGroupPlugin := dummyplugin.NewSecurityGroup(logger.With("plugin", "securitygroup"))
 	securityGroupRulePlugin := dummyplugin.NewSecurityGroupRule(logger.With("plugin", "securitygrouprule"))
-	instancePlugin := dummyplugin.NewInstance(logger.With("plugin", "instance"))
+	instancePlugin := dummyplugin.NewInstance(logger.With("plugin", "instance"), sealer)
+
+	sharder, err := managerFlags.Sharder(mgr, logger.With("component", "sharder"))
+	if err != nil {
+		logger.Error("unable to set up sharding", "error", err)
+		os.Exit(1)
+	}
 
 	controllerOpts := []frameworkbuilder.Option{
 		frameworkbuilder.WithLogger(logger.With("component", "controller-set")),
 		frameworkbuilder.WithRequeueAfter(1 * time.Second),
+		frameworkbuilder.WithPlugin("dummy"),
+		frameworkbuilder.WithReferenceGraph(refgraph.New(dynClient, commonbackend.Referrers...)),
+		// No tenant holds more than half of the reconcile workers of a controller.
+		frameworkbuilder.WithFairQueue(frameworkbuilder.DefaultMaxConcurrentReconciles / 2),
+		frameworkbuilder.WithSharder(sharder),
+		frameworkbuilder.WithSealer(sealer),
 	}
 
 	controllerSet := frameworkbuilder.NewControllerSet()
@@ -5,31 +5,35 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/envelope"
 	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
 )
 
 type Instance struct {
 	logger *slog.Logger
+	// sealer is the one the controllers seal instances with, so that the writes of the
+	// simulation keep their spec sealed.
+	sealer *envelope.Sealer
 }
 
-func NewInstance(logger *slog.Logger) *Instance {
-	return &Instance{logger: logger}
+func NewInstance(logger *slog.Logger, sealer *envelope.Sealer) *Instance {
+	return &Instance{logger: logger, sealer: sealer}
 }
 
 func (i *Instance) Create(ctx context.Context, resource *instancedom.Instance) error {
-	return simulateInstance(ctx, "create", resource, instanceDelay(), i.logger)
+	return simulateInstance(ctx, "create", resource, instanceDelay(), i.sealer, i.logger)
 }
 
 func (i *Instance) Delete(ctx context.Context, resource *instancedom.Instance) error {
-	return simulateInstance(ctx, "delete", resource, instanceDelay(), i.logger)
+	return simulateInstance(ctx, "delete", resource, instanceDelay(), i.sealer, i.logger)
 }
 
 func (i *Instance) PowerOn(ctx context.Context, resource *instancedom.Instance) error {
-	return simulateInstance(ctx, "power-on", resource, instanceDelay(), i.logger)
+	return simulateInstance(ctx, "power-on", resource, instanceDelay(), i.sealer, i.logger)
 }
 
 func (i *Instance) PowerOff(ctx context.Context, resource *instancedom.Instance) error {
-	return simulateInstance(ctx, "power-off", resource, instanceDelay(), i.logger)
+	return simulateInstance(ctx, "power-off", resource, instanceDelay(), i.sealer, i.logger)
 }
 
 func instanceDelay() time.Duration {
@@ -10,6 +10,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/envelope"
 	kubernetesadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
 	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
@@ -157,7 +158,7 @@ func simulateWS(ctx context.Context, op string, resource *wsdom.Workspace, delay
 	)
 }
 
-func simulateInstance(ctx context.Context, op string, resource *instancedom.Instance, delay time.Duration, logger *slog.Logger) error {
+func simulateInstance(ctx context.Context, op string, resource *instancedom.Instance, delay time.Duration, sealer *envelope.Sealer, logger *slog.Logger) error {
 	return simulate(ctx, op, &resource.Annotations, resource.GetName(), delay, logger,
 		func(ctx context.Context) error {
 			dynamicClient, err := sharedDynamicClient()
@@ -168,8 +169,8 @@ func simulateInstance(ctx context.Context, op string, resource *instancedom.Inst
 				dynamicClient,
 				instanceconv.InstanceGVR,
 				logger,
-				instanceconv.InstanceToCR,
-				instanceconv.InstanceFromCR,
+				instanceconv.InstanceToCR(sealer),
+				instanceconv.InstanceFromCR(sealer),
 			)
 			_, err = repo.Update(ctx, resource)
 			return err
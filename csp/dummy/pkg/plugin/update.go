@@ -120,7 +120,7 @@ func (i *Image) Update(ctx context.Context, resource *imgdom.Image) error {
 
 func (i *Instance) Update(ctx context.Context, resource *instancedom.Instance) error {
 	return applyUpdate(ctx, resource, &resource.Annotations, resource.Labels,
-		instanceconv.InstanceGVR, instanceconv.InstanceToCR, instanceconv.InstanceFromCR, i.logger)
+		instanceconv.InstanceGVR, instanceconv.InstanceToCR(i.sealer), instanceconv.InstanceFromCR(i.sealer), i.logger)
 }
 
 func (ig *InternetGateway) Update(ctx context.Context, resource *internetgatewaydom.InternetGateway) error {
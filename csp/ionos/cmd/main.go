@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"log/slog"
 	"os"
 	"time"
@@ -19,6 +20,8 @@ import (
 
 	"github.com/eu-sovereign-cloud/ecp/csp/ionos/pkg/controllerset"
 	frameworkbuilder "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/builder"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/refgraph"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	netk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network/backend/kubernetes"
 	bsk8s "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage/backend/kubernetes"
 	wsk8s "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/backend/kubernetes"
@@ -35,11 +38,15 @@ func init() {
 }
 
 func main() {
+	var managerFlags frameworkbuilder.ManagerFlags
+	managerFlags.BindFlags(flag.CommandLine, "ionos")
+	flag.Parse()
+
 	opts := zap.Options{Development: true}
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	mgrOpts := ctrl.Options{
 		Scheme: scheme,
 		Metrics: metricsserver.Options{
 			SecureServing: false,
@@ -48,7 +55,9 @@ func main() {
 		// The charts/delegator deployment probes /healthz on 8081; match it so the
 		// per-plugin image is ready under the same chart as the other plugins.
 		HealthProbeBindAddress: ":8081",
-	})
+	}
+	managerFlags.Apply(&mgrOpts)
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), mgrOpts)
 	if err != nil {
 		logger.Error("unable to start manager", "error", err)
 		os.Exit(1)
@@ -60,10 +69,23 @@ func main() {
 		os.Exit(1)
 	}
 
+	sharder, err := managerFlags.Sharder(mgr, logger.With("component", "sharder"))
+	if err != nil {
+		logger.Error("unable to set up sharding", "error", err)
+		os.Exit(1)
+	}
+
 	controllerOpts := []frameworkbuilder.Option{
 		frameworkbuilder.WithLogger(logger.With("component", "controller-set")),
 		frameworkbuilder.WithRequeueAfter(1 * time.Second),
 		frameworkbuilder.WithMaxConditions(5),
+		frameworkbuilder.WithPlugin("ionos"),
+		frameworkbuilder.WithReferenceGraph(refgraph.New(dynClient, commonbackend.Referrers...)),
+		// No tenant holds more than half of the reconcile workers of a controller.
+		frameworkbuilder.WithFairQueue(frameworkbuilder.DefaultMaxConcurrentReconciles / 2),
+		frameworkbuilder.WithSharder(sharder),
+		// A provider API call that hangs fails, and is retried, instead of holding a worker.
+		frameworkbuilder.WithOperationTimeout(2 * time.Minute),
 	}
 
 	controllerSet := frameworkbuilder.NewControllerSet()
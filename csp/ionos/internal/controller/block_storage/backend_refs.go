@@ -0,0 +1,17 @@
+package block_storage
+
+import (
+	"context"
+
+	"github.com/eu-sovereign-cloud/ecp/csp/ionos/pkg/port"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+)
+
+type BackendRefsBlockStorage struct {
+	Store port.BlockStorageStore
+}
+
+func (r *BackendRefsBlockStorage) Do(ctx context.Context, domain *bsdom.BlockStorage) ([]backend.BackendRef, error) {
+	return r.Store.BackendRefs(ctx, domain)
+}
@@ -0,0 +1,17 @@
+package network
+
+import (
+	"context"
+
+	"github.com/eu-sovereign-cloud/ecp/csp/ionos/pkg/port"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
+)
+
+type BackendRefsNetwork struct {
+	Store port.NetworkStore
+}
+
+func (r *BackendRefsNetwork) Do(ctx context.Context, domain *netdom.Network) ([]backend.BackendRef, error) {
+	return r.Store.BackendRefs(ctx, domain)
+}
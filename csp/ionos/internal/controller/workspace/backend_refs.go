@@ -0,0 +1,17 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/eu-sovereign-cloud/ecp/csp/ionos/pkg/port"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
+)
+
+type BackendRefsWorkspace struct {
+	Store port.WorkspaceStore
+}
+
+func (r *BackendRefsWorkspace) Do(ctx context.Context, domain *wsdom.Workspace) ([]backend.BackendRef, error) {
+	return r.Store.BackendRefs(ctx, domain)
+}
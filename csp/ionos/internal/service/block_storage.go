@@ -4,6 +4,7 @@ import (
 	"context"
 
 	blockstoragectrl "github.com/eu-sovereign-cloud/ecp/csp/ionos/internal/controller/block_storage"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
 	bsk8s "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage/backend/kubernetes"
 )
@@ -13,6 +14,7 @@ var _ bsk8s.BlockStoragePlugin = (*BlockStorage)(nil)
 type BlockStorage struct {
 	Creator       *blockstoragectrl.CreateBlockStorage
 	Deleter       *blockstoragectrl.DeleteBlockStorage
+	Referencer    *blockstoragectrl.BackendRefsBlockStorage
 	SizeIncreaser *blockstoragectrl.IncreaseSizeBlockStorage
 }
 
@@ -27,3 +29,7 @@ func (s *BlockStorage) Delete(ctx context.Context, resource *bsdom.BlockStorage)
 func (s *BlockStorage) IncreaseSize(ctx context.Context, resource *bsdom.BlockStorage) error {
 	return s.SizeIncreaser.Do(ctx, resource)
 }
+
+func (s *BlockStorage) BackendRefs(ctx context.Context, resource *bsdom.BlockStorage) ([]backend.BackendRef, error) {
+	return s.Referencer.Do(ctx, resource)
+}
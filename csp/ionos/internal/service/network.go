@@ -4,6 +4,7 @@ import (
 	"context"
 
 	networkctrl "github.com/eu-sovereign-cloud/ecp/csp/ionos/internal/controller/network"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
 	netk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network/backend/kubernetes"
 )
@@ -11,8 +12,9 @@ import (
 var _ netk8s.NetworkPlugin = (*Network)(nil)
 
 type Network struct {
-	Creator *networkctrl.CreateNetwork
-	Deleter *networkctrl.DeleteNetwork
+	Creator    *networkctrl.CreateNetwork
+	Deleter    *networkctrl.DeleteNetwork
+	Referencer *networkctrl.BackendRefsNetwork
 }
 
 func (s *Network) Create(ctx context.Context, resource *netdom.Network) error {
@@ -22,3 +24,7 @@ func (s *Network) Create(ctx context.Context, resource *netdom.Network) error {
 func (s *Network) Delete(ctx context.Context, resource *netdom.Network) error {
 	return s.Deleter.Do(ctx, resource)
 }
+
+func (s *Network) BackendRefs(ctx context.Context, resource *netdom.Network) ([]backend.BackendRef, error) {
+	return s.Referencer.Do(ctx, resource)
+}
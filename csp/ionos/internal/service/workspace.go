@@ -4,6 +4,7 @@ import (
 	"context"
 
 	workspacectrl "github.com/eu-sovereign-cloud/ecp/csp/ionos/internal/controller/workspace"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
 	wsk8s "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/backend/kubernetes"
 )
@@ -11,8 +12,9 @@ import (
 var _ wsk8s.WorkspacePlugin = (*Workspace)(nil)
 
 type Workspace struct {
-	Creator *workspacectrl.CreateWorkspace
-	Deleter *workspacectrl.DeleteWorkspace
+	Creator    *workspacectrl.CreateWorkspace
+	Deleter    *workspacectrl.DeleteWorkspace
+	Referencer *workspacectrl.BackendRefsWorkspace
 }
 
 func (s *Workspace) Create(ctx context.Context, resource *wsdom.Workspace) error {
@@ -22,3 +24,7 @@ func (s *Workspace) Create(ctx context.Context, resource *wsdom.Workspace) error
 func (s *Workspace) Delete(ctx context.Context, resource *wsdom.Workspace) error {
 	return s.Deleter.Do(ctx, resource)
 }
+
+func (s *Workspace) BackendRefs(ctx context.Context, resource *wsdom.Workspace) ([]backend.BackendRef, error) {
+	return s.Referencer.Do(ctx, resource)
+}
@@ -8,6 +8,7 @@ import (
 
 	v1 "github.com/crossplane/crossplane-runtime/v2/apis/common/v1"
 	xpconditions "github.com/crossplane/crossplane-runtime/v2/pkg/conditions"
+	"github.com/crossplane/crossplane-runtime/v2/pkg/meta"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -98,6 +99,24 @@ func (c *base) checkExisting(ctx context.Context, obj xpconditions.ObjectWithCon
 	return backend.ErrStillProcessing
 }
 
+// backendRefs returns the IONOS object backing obj. Its ID is the Crossplane external
+// name, the IONOS UUID once the provider created the object, or "namespace/name" until
+// then. A CR that does not exist has no refs.
+func (c *base) backendRefs(ctx context.Context, obj client.Object) ([]backend.BackendRef, error) {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if err := c.client.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	id := meta.GetExternalName(obj)
+	if id == "" || id == obj.GetName() {
+		id = obj.GetNamespace() + "/" + obj.GetName()
+	}
+	return []backend.BackendRef{{Kind: kind, ID: id}}, nil
+}
+
 func reconcileError(obj xpconditions.ObjectWithConditions) error {
 	synced := obj.GetCondition(v1.TypeSynced)
 	if synced.Equal(v1.ReconcileError(errors.New(synced.Message))) {
@@ -316,3 +316,58 @@ func assertErr(t *testing.T, got, wantIs error, wantContains string) {
 		}
 	}
 }
+
+func TestBackendRefs(t *testing.T) {
+	errAPI := errors.New("api error")
+
+	tests := []struct {
+		name    string
+		fc      *fakeClient
+		wantID  string
+		wantErr error
+	}{
+		{
+			name:   "missing resource has no refs",
+			fc:     &fakeClient{getFunc: func(_ client.Object) error { return notFoundErr() }},
+			wantID: "",
+		},
+		{
+			name:    "get error propagates",
+			fc:      &fakeClient{getFunc: func(_ client.Object) error { return errAPI }},
+			wantErr: errAPI,
+		},
+		{
+			name:   "no external name yet falls back to namespace/name",
+			fc:     &fakeClient{},
+			wantID: "ns/obj",
+		},
+		{
+			name: "external name is the IONOS ID",
+			fc: &fakeClient{
+				getFunc: func(obj client.Object) error {
+					obj.SetAnnotations(map[string]string{"crossplane.io/external-name": "0b5c7e4a-uuid"})
+					return nil
+				},
+			},
+			wantID: "0b5c7e4a-uuid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			refs, err := discardBase(tt.fc).backendRefs(context.Background(), newTestObj())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("want error %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantID == "" {
+				if len(refs) != 0 {
+					t.Fatalf("want no refs, got %+v", refs)
+				}
+				return
+			}
+			if len(refs) != 1 || refs[0].Kind != "TestResource" || refs[0].ID != tt.wantID {
+				t.Fatalf("want [TestResource:%s], got %+v", tt.wantID, refs)
+			}
+		})
+	}
+}
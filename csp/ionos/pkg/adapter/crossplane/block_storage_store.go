@@ -14,6 +14,7 @@ import (
 
 	"github.com/eu-sovereign-cloud/ecp/csp/ionos/pkg/port"
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
 )
@@ -63,6 +64,14 @@ func (a *BlockStorageStore) IncreaseSize(ctx context.Context, domain *bsdom.Bloc
 	return a.updateCR(ctx, vol)
 }
 
+func (a *BlockStorageStore) BackendRefs(ctx context.Context, domain *bsdom.BlockStorage) ([]backend.BackendRef, error) {
+	namespace := k8sadapter.ComputeNamespace(&resource.Scope{Tenant: domain.GetTenant()})
+	return a.backendRefs(ctx, &ionosv1alpha1.Volume{
+		TypeMeta:   metav1.TypeMeta{Kind: ionosv1alpha1.Volume_Kind},
+		ObjectMeta: metav1.ObjectMeta{Name: domain.GetName(), Namespace: namespace},
+	})
+}
+
 func newVolume(domain *bsdom.BlockStorage) *ionosv1alpha1.Volume {
 	namespace := k8sadapter.ComputeNamespace(&resource.Scope{Tenant: domain.GetTenant()})
 	return &ionosv1alpha1.Volume{
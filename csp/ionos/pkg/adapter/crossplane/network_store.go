@@ -14,6 +14,7 @@ import (
 
 	"github.com/eu-sovereign-cloud/ecp/csp/ionos/pkg/port"
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
 )
@@ -69,6 +70,14 @@ func (a *NetworkStore) Delete(ctx context.Context, domain *netdom.Network) error
 	})
 }
 
+func (a *NetworkStore) BackendRefs(ctx context.Context, domain *netdom.Network) ([]backend.BackendRef, error) {
+	namespace := k8sadapter.ComputeNamespace(&resource.Scope{Tenant: domain.GetTenant()})
+	return a.backendRefs(ctx, &ionosv1alpha1.Lan{
+		TypeMeta:   metav1.TypeMeta{Kind: ionosv1alpha1.Lan_Kind},
+		ObjectMeta: metav1.ObjectMeta{Name: domain.GetName(), Namespace: namespace},
+	})
+}
+
 func newLan(domain *netdom.Network) *ionosv1alpha1.Lan {
 	namespace := k8sadapter.ComputeNamespace(&resource.Scope{Tenant: domain.GetTenant()})
 	lan := &ionosv1alpha1.Lan{
@@ -12,6 +12,7 @@ import (
 
 	"github.com/eu-sovereign-cloud/ecp/csp/ionos/pkg/port"
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
 )
@@ -39,6 +40,14 @@ func (a *WorkspaceStore) Delete(ctx context.Context, domain *wsdom.Workspace) er
 	})
 }
 
+func (a *WorkspaceStore) BackendRefs(ctx context.Context, domain *wsdom.Workspace) ([]backend.BackendRef, error) {
+	namespace := k8sadapter.ComputeNamespace(&resource.Scope{Tenant: domain.GetTenant()})
+	return a.backendRefs(ctx, &ionosv1alpha1.Datacenter{
+		TypeMeta:   metav1.TypeMeta{Kind: ionosv1alpha1.Datacenter_Kind},
+		ObjectMeta: metav1.ObjectMeta{Name: domain.GetName(), Namespace: namespace},
+	})
+}
+
 func newDatacenter(domain *wsdom.Workspace) *ionosv1alpha1.Datacenter {
 	namespace := k8sadapter.ComputeNamespace(&resource.Scope{Tenant: domain.GetTenant()})
 	return &ionosv1alpha1.Datacenter{
@@ -33,17 +33,20 @@ func Add(cs *frameworkbuilder.ControllerSet, mgr ctrl.Manager, dynClient dynamic
 	netAdapter := crossplane.NewNetworkStore(mgr.GetClient(), logger.With("adapter", "network"))
 
 	wsPlugin := &service.Workspace{
-		Creator: &workspacectrl.CreateWorkspace{Store: wsAdapter},
-		Deleter: &workspacectrl.DeleteWorkspace{Store: wsAdapter},
+		Creator:    &workspacectrl.CreateWorkspace{Store: wsAdapter},
+		Deleter:    &workspacectrl.DeleteWorkspace{Store: wsAdapter},
+		Referencer: &workspacectrl.BackendRefsWorkspace{Store: wsAdapter},
 	}
 	bsPlugin := &service.BlockStorage{
 		Creator:       &blockstoragectrl.CreateBlockStorage{Store: bsAdapter},
 		Deleter:       &blockstoragectrl.DeleteBlockStorage{Store: bsAdapter},
 		SizeIncreaser: &blockstoragectrl.IncreaseSizeBlockStorage{Store: bsAdapter},
+		Referencer:    &blockstoragectrl.BackendRefsBlockStorage{Store: bsAdapter},
 	}
 	netPlugin := &service.Network{
-		Creator: &networkctrl.CreateNetwork{Store: netAdapter},
-		Deleter: &networkctrl.DeleteNetwork{Store: netAdapter},
+		Creator:    &networkctrl.CreateNetwork{Store: netAdapter},
+		Deleter:    &networkctrl.DeleteNetwork{Store: netAdapter},
+		Referencer: &networkctrl.BackendRefsNetwork{Store: netAdapter},
 	}
 
 	cs.Add(bsk8s.NewController(mgr.GetClient(), dynClient, bsPlugin, opts...))
@@ -3,11 +3,13 @@ package port
 import (
 	"context"
 
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
 )
 
 type BlockStorageStore interface {
 	Create(ctx context.Context, domain *bsdom.BlockStorage) error
 	Delete(ctx context.Context, domain *bsdom.BlockStorage) error
+	BackendRefs(ctx context.Context, domain *bsdom.BlockStorage) ([]backend.BackendRef, error)
 	IncreaseSize(ctx context.Context, domain *bsdom.BlockStorage) error
 }
@@ -3,10 +3,12 @@ package port
 import (
 	"context"
 
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
 )
 
 type NetworkStore interface {
 	Create(ctx context.Context, domain *netdom.Network) error
 	Delete(ctx context.Context, domain *netdom.Network) error
+	BackendRefs(ctx context.Context, domain *netdom.Network) ([]backend.BackendRef, error)
 }
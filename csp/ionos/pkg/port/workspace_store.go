@@ -3,10 +3,12 @@ package port
 import (
 	"context"
 
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
 )
 
 type WorkspaceStore interface {
 	Create(ctx context.Context, domain *wsdom.Workspace) error
 	Delete(ctx context.Context, domain *wsdom.Workspace) error
+	BackendRefs(ctx context.Context, domain *wsdom.Workspace) ([]backend.BackendRef, error)
 }
@@ -0,0 +1,181 @@
+// Package envelope encrypts sensitive resource fields at rest, before they are written to a
+// CR, and decrypts them when the CR is converted back.
+//
+// A value is sealed under a fresh AES-256-GCM data encryption key (DEK), and the DEK is
+// wrapped by a kms.KMS. The sealed form carries the ID of the key encryption key (KEK)
+// that wrapped it, so values sealed before a KEK rotation keep opening as long as the KMS
+// still holds the old KEK, and are re-sealed under the new one the next time the resource
+// is written. Each value is bound to the identity of the resource holding it, so it cannot
+// be copied into another resource and opened there. See doc/ENCRYPTION.md.
+package envelope
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/kms"
+)
+
+// Prefix starts every sealed value.
+const Prefix = "ecp:enc:v1:"
+
+// cacheSize bounds the values a Sealer remembers; see Sealer.
+const cacheSize = 4096
+
+// Sealer seals and opens values with the DEKs a KMS wraps.
+//
+// Every value is sealed for an identity, the additional data of AES-GCM, and only opens for
+// that same identity. Callers pass the identity of the resource holding the value, e.g.
+// Identity(tenant, workspace, name).
+//
+// Sealing the same plaintext twice yields different values, which would make every write of
+// an unchanged resource look like a change. The Sealer therefore remembers, for the values
+// it opened under the current KEK, the sealed form of their plaintext, and seals that
+// plaintext back to it for the same identity only: two resources holding the same plaintext
+// never share a sealed value, so the values reveal nothing about each other. It remembers a
+// keyed digest of the identity and plaintext, never the plaintext.
+type Sealer struct {
+	kms kms.KMS
+
+	mu     sync.Mutex
+	digest []byte
+	sealed map[string]string
+}
+
+// NewSealer returns a Sealer wrapping its DEKs with k.
+func NewSealer(k kms.KMS) *Sealer {
+	digest := make([]byte, 32)
+	if _, err := rand.Read(digest); err != nil {
+		panic(fmt.Sprintf("envelope: read random digest key: %v", err))
+	}
+	return &Sealer{kms: k, digest: digest, sealed: map[string]string{}}
+}
+
+// Identity returns the identity a value held by the resource tenant/workspace/name is sealed
+// for. workspace is empty for a tenant-scoped resource.
+func Identity(tenant, workspace, name string) []byte {
+	return []byte(tenant + "/" + workspace + "/" + name)
+}
+
+// IsSealed reports whether value is a sealed value.
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, Prefix)
+}
+
+// KeyID returns the ID of the KEK that wrapped the DEK of a sealed value, or "" if value
+// is not sealed or malformed.
+func KeyID(value string) string {
+	keyID, _, _, err := parse(value)
+	if err != nil {
+		return ""
+	}
+	return keyID
+}
+
+// Seal returns the sealed form of plaintext for identity.
+func (s *Sealer) Seal(plaintext, identity []byte) (string, error) {
+	digest := s.digestOf(plaintext, identity)
+	s.mu.Lock()
+	cached, ok := s.sealed[digest]
+	s.mu.Unlock()
+	if ok && KeyID(cached) == s.kms.CurrentKeyID() {
+		return cached, nil
+	}
+
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return "", fmt.Errorf("generate data encryption key: %w", err)
+	}
+	aead, err := newGCM(dek)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(aead, plaintext, identity)
+	if err != nil {
+		return "", fmt.Errorf("encrypt: %w", err)
+	}
+	keyID, wrapped, err := s.kms.Wrap(dek)
+	if err != nil {
+		return "", fmt.Errorf("wrap data encryption key: %w", err)
+	}
+	value := Prefix + keyID + ":" + base64.StdEncoding.EncodeToString(wrapped) + ":" + base64.StdEncoding.EncodeToString(ciphertext)
+	s.remember(digest, value)
+	return value, nil
+}
+
+// Open returns the plaintext of a value sealed for identity. A value sealed for another
+// identity does not open.
+func (s *Sealer) Open(value string, identity []byte) ([]byte, error) {
+	keyID, wrapped, ciphertext, err := parse(value)
+	if err != nil {
+		return nil, err
+	}
+	dek, err := s.kms.Unwrap(keyID, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data encryption key: %w", err)
+	}
+	aead, err := newGCM(dek)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := open(aead, ciphertext, identity)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt: %w", err)
+	}
+	if keyID == s.kms.CurrentKeyID() {
+		s.remember(s.digestOf(plaintext, identity), value)
+	}
+	return plaintext, nil
+}
+
+// digestOf keys the remembered values by an HMAC of identity and plaintext under a
+// per-process key. The identity is length-prefixed so that no two pairs share a digest.
+func (s *Sealer) digestOf(plaintext, identity []byte) string {
+	mac := hmac.New(sha256.New, s.digest)
+	_ = binary.Write(mac, binary.BigEndian, uint64(len(identity)))
+	mac.Write(identity)
+	mac.Write(plaintext)
+	return string(mac.Sum(nil))
+}
+
+func (s *Sealer) remember(digest, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.sealed) >= cacheSize {
+		clear(s.sealed)
+	}
+	s.sealed[digest] = value
+}
+
+// parse splits a sealed value. The KEK ID may itself contain ":", so the two base64
+// fields, which cannot, are split off from the right.
+func parse(value string) (keyID string, wrapped, ciphertext []byte, err error) {
+	rest, ok := strings.CutPrefix(value, Prefix)
+	if !ok {
+		return "", nil, nil, errors.New("value is not sealed")
+	}
+	i := strings.LastIndexByte(rest, ':')
+	if i < 0 {
+		return "", nil, nil, errors.New("malformed sealed value")
+	}
+	rest, encCiphertext := rest[:i], rest[i+1:]
+	i = strings.LastIndexByte(rest, ':')
+	if i <= 0 {
+		return "", nil, nil, errors.New("malformed sealed value")
+	}
+	keyID, encWrapped := rest[:i], rest[i+1:]
+	if wrapped, err = base64.StdEncoding.DecodeString(encWrapped); err != nil {
+		return "", nil, nil, fmt.Errorf("malformed sealed value: %w", err)
+	}
+	if ciphertext, err = base64.StdEncoding.DecodeString(encCiphertext); err != nil {
+		return "", nil, nil, fmt.Errorf("malformed sealed value: %w", err)
+	}
+	return keyID, wrapped, ciphertext, nil
+}
@@ -0,0 +1,128 @@
+package envelope
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func keyLine(id string, b byte) string {
+	return id + ":" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{b}, 32))
+}
+
+// web is the identity the tests seal their values for.
+var web = Identity("t1", "w1", "web")
+
+func mustKeyFile(t *testing.T, lines ...string) *KeyFile {
+	t.Helper()
+	kf, err := ParseKeyFile([]byte(strings.Join(lines, "\n")))
+	require.NoError(t, err)
+	return kf
+}
+
+func TestSealer_RoundTrip(t *testing.T) {
+	t.Parallel()
+	s := NewSealer(mustKeyFile(t, "# current first", keyLine("k2", 2), "", keyLine("k1", 1)))
+
+	value, err := s.Seal([]byte("#cloud-config\n"), web)
+	require.NoError(t, err)
+	assert.True(t, IsSealed(value))
+	assert.Equal(t, "k2", KeyID(value))
+	assert.NotContains(t, value, "cloud-config")
+
+	plaintext, err := s.Open(value, web)
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config\n", string(plaintext))
+}
+
+func TestSealer_OpenRejectsAnotherIdentity(t *testing.T) {
+	t.Parallel()
+	s := NewSealer(mustKeyFile(t, keyLine("k1", 1)))
+
+	value, err := s.Seal([]byte("data"), web)
+	require.NoError(t, err)
+	_, err = s.Open(value, Identity("t1", "w1", "db"))
+	assert.ErrorContains(t, err, "decrypt", "a value copied into another resource does not open")
+	_, err = s.Open(value, Identity("t2", "w1", "web"))
+	assert.ErrorContains(t, err, "decrypt")
+}
+
+func TestSealer_SealIsStableForOpenedValues(t *testing.T) {
+	t.Parallel()
+	kf := mustKeyFile(t, keyLine("k1", 1))
+	writer, reader := NewSealer(kf), NewSealer(kf)
+
+	first, err := writer.Seal([]byte("data"), web)
+	require.NoError(t, err)
+	_, err = reader.Open(first, web)
+	require.NoError(t, err)
+
+	again, err := reader.Seal([]byte("data"), web)
+	require.NoError(t, err)
+	assert.Equal(t, first, again, "an unchanged plaintext keeps its sealed form")
+
+	other, err := reader.Seal([]byte("other"), web)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, other)
+
+	elsewhere, err := reader.Seal([]byte("data"), Identity("t1", "w1", "db"))
+	require.NoError(t, err)
+	assert.NotEqual(t, first, elsewhere, "the same plaintext in another resource is sealed anew")
+}
+
+func TestSealer_Rotation(t *testing.T) {
+	t.Parallel()
+	old := NewSealer(mustKeyFile(t, keyLine("k1", 1)))
+	sealed, err := old.Seal([]byte("data"), web)
+	require.NoError(t, err)
+
+	rotated := NewSealer(mustKeyFile(t, keyLine("k2", 2), keyLine("k1", 1)))
+	plaintext, err := rotated.Open(sealed, web)
+	require.NoError(t, err, "values sealed under a previous KEK still open")
+	assert.Equal(t, "data", string(plaintext))
+
+	resealed, err := rotated.Seal(plaintext, web)
+	require.NoError(t, err)
+	assert.Equal(t, "k2", KeyID(resealed), "the next write re-seals under the current KEK")
+
+	retired := NewSealer(mustKeyFile(t, keyLine("k2", 2)))
+	_, err = retired.Open(sealed, web)
+	assert.ErrorContains(t, err, `unknown key encryption key "k1"`)
+}
+
+func TestSealer_OpenRejectsTampering(t *testing.T) {
+	t.Parallel()
+	s := NewSealer(mustKeyFile(t, keyLine("k1", 1)))
+	sealed, err := s.Seal([]byte("data"), web)
+	require.NoError(t, err)
+
+	i := strings.LastIndexByte(sealed, ':')
+	ciphertext, err := base64.StdEncoding.DecodeString(sealed[i+1:])
+	require.NoError(t, err)
+	ciphertext[len(ciphertext)-1] ^= 1
+	_, err = s.Open(sealed[:i+1]+base64.StdEncoding.EncodeToString(ciphertext), web)
+	assert.ErrorContains(t, err, "decrypt")
+
+	_, err = s.Open("plain", web)
+	assert.Error(t, err)
+	_, err = s.Open(Prefix+"k1:!!", web)
+	assert.Error(t, err)
+}
+
+func TestParseKeyFile_Errors(t *testing.T) {
+	t.Parallel()
+	for name, content := range map[string]string{
+		"empty":      "# nothing\n",
+		"no id":      ":" + strings.Repeat("A", 44),
+		"short key":  "k1:" + base64.StdEncoding.EncodeToString([]byte("short")),
+		"not base64": "k1:***",
+		"duplicate":  keyLine("k1", 1) + "\n" + keyLine("k1", 2),
+	} {
+		_, err := ParseKeyFile([]byte(content))
+		assert.Error(t, err, name)
+	}
+}
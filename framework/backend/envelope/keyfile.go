@@ -0,0 +1,144 @@
+package envelope
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/kms"
+)
+
+var _ kms.KMS = (*KeyFile)(nil)
+
+// KeyFile is a kms.KMS holding AES-256 key encryption keys read from a local file. It is
+// meant for development, tests and single-cluster installs where the key file is mounted
+// from a Kubernetes Secret; production installs plug in a KMS that keeps the KEKs out of
+// the cluster.
+//
+// The file has one key per line, "<id>:<base64 of 32 bytes>". The first key is the current
+// one; the others only unwrap. Blank lines and lines starting with "#" are ignored. To
+// rotate, prepend a new key and keep the old ones until every resource has been rewritten.
+type KeyFile struct {
+	current string
+	keys    map[string]cipher.AEAD
+}
+
+// LoadKeyFile reads the keys of the file at path.
+func LoadKeyFile(path string) (*KeyFile, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read key file: %w", err)
+	}
+	return ParseKeyFile(raw)
+}
+
+// ParseKeyFile parses the content of a key file. See KeyFile for the format.
+func ParseKeyFile(raw []byte) (*KeyFile, error) {
+	kf := &KeyFile{keys: map[string]cipher.AEAD{}}
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		id, encoded, ok := strings.Cut(line, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("key file line %d: want <id>:<base64 key>", n)
+		}
+		if _, dup := kf.keys[id]; dup {
+			return nil, fmt.Errorf("key file line %d: duplicate key %q", n, id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("key file line %d: key %q: %w", n, id, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("key file line %d: key %q is %d bytes, want 32 (AES-256)", n, id, len(key))
+		}
+		aead, err := newGCM(key)
+		if err != nil {
+			return nil, fmt.Errorf("key file line %d: key %q: %w", n, id, err)
+		}
+		kf.keys[id] = aead
+		if kf.current == "" {
+			kf.current = id
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read key file: %w", err)
+	}
+	if kf.current == "" {
+		return nil, errors.New("key file holds no key")
+	}
+	return kf, nil
+}
+
+// Wrap implements kms.KMS.
+func (k *KeyFile) Wrap(dek []byte) (string, []byte, error) {
+	wrapped, err := seal(k.keys[k.current], dek, nil)
+	return k.current, wrapped, err
+}
+
+// Unwrap implements kms.KMS.
+func (k *KeyFile) Unwrap(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key encryption key %q", keyID)
+	}
+	return open(aead, wrapped, nil)
+}
+
+// CurrentKeyID implements kms.KMS.
+func (k *KeyFile) CurrentKeyID() string {
+	return k.current
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext under aead with a random nonce and additional data, returning
+// nonce||ciphertext.
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open reverses seal.
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+// KeyFileEnv names the environment variable the CSP delegators read the key file path from.
+const KeyFileEnv = "ECP_ENCRYPTION_KEY_FILE"
+
+// SealerFromKeyFile returns a Sealer wrapping its DEKs with the keys of the file at path, or
+// nil, which stores the sensitive fields in plain text, when path is empty.
+func SealerFromKeyFile(path string) (*Sealer, error) {
+	if path == "" {
+		return nil, nil
+	}
+	kf, err := LoadKeyFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewSealer(kf), nil
+}
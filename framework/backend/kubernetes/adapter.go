@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
@@ -517,9 +518,10 @@ func (a *WriterAdapter[T]) updateMetadataAndSpecRetry(
 			currObj.SetLabels(desiredLabels)
 		}
 
-		annotationsChanged := !cmp.Equal(currObj.GetAnnotations(), desiredAnnotations)
+		annotations := keepBackendAnnotations(desiredAnnotations, currObj.GetAnnotations())
+		annotationsChanged := !cmp.Equal(currObj.GetAnnotations(), annotations)
 		if annotationsChanged {
-			currObj.SetAnnotations(desiredAnnotations)
+			currObj.SetAnnotations(annotations)
 		}
 
 		if !specChanged && !commonDataChanged && !labelsChanged && !annotationsChanged {
@@ -532,6 +534,39 @@ func (a *WriterAdapter[T]) updateMetadataAndSpecRetry(
 	})
 }
 
+// backendAnnotations are the annotations the plugin's controller records on a resource.
+var backendAnnotations = []string{
+	labels.BackendPluginAnnotation,
+	labels.BackendRefsAnnotation,
+	labels.ObservedGenerationAnnotation,
+	labels.AppliedSpecHashAnnotation,
+	labels.AppliedAtAnnotation,
+}
+
+// keepBackendAnnotations returns desired plus the backend and applied-spec annotations of curr.
+// The plugin's controller records those on the resource (see labels.BackendRefsAnnotation and
+// labels.AppliedSpecHashAnnotation); the gateway's desired object never carries them, so
+// replacing the annotations wholesale would drop them on every update. desired is returned as is
+// when curr has none, keeping a nil map nil.
+func keepBackendAnnotations(desired, curr map[string]string) map[string]string {
+	var kept map[string]string
+	for _, key := range backendAnnotations {
+		value, ok := curr[key]
+		if !ok {
+			continue
+		}
+		if kept == nil {
+			kept = make(map[string]string, len(desired)+len(backendAnnotations))
+			maps.Copy(kept, desired)
+		}
+		kept[key] = value
+	}
+	if kept == nil {
+		return desired
+	}
+	return kept
+}
+
 // syncNestedMap copies an already-extracted desired value onto curr's named top-level field when
 // the two differ, reporting whether it wrote. spec and its sibling commonData get identical
 // treatment, so they share one path. A field absent from desired (found=false) is left alone rather
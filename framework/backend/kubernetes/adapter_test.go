@@ -682,3 +682,46 @@ func TestWriterAdapter_Update_NoOpDoesNotWrite(t *testing.T) {
 
 	require.Zerof(t, writes, "an update that changes nothing must not write, got %d writes", writes)
 }
+
+// TestWriterAdapter_Update_KeepsBackendAnnotations pins keepBackendAnnotations: the plugin's
+// controller records the backend annotations, the gateway's desired object never carries them,
+// and a gateway update must neither drop them nor write when nothing else changed.
+func TestWriterAdapter_Update_KeepsBackendAnnotations(t *testing.T) {
+	namespace := ComputeNamespace(&kernelresource.Scope{Tenant: "t1", Workspace: "w1"})
+
+	labelled := &testLabelled{name: "rt-1", labels: map[string]string{"env": "prod"}}
+	created, err := testLabelledToCR(labelled)
+	require.NoError(t, err)
+	created.SetAnnotations(map[string]string{
+		labels.BackendPluginAnnotation:   "aruba",
+		labels.BackendRefsAnnotation:     `[{"kind":"VPC","id":"ns/rt-1"}]`,
+		labels.AppliedSpecHashAnnotation: "abc",
+	})
+
+	dynFake := fake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(), testListKinds(), created.(*unstructured.Unstructured))
+
+	var writes int
+	dynFake.PrependReactor("update", "*", func(k8stesting.Action) (bool, runtime.Object, error) {
+		writes++
+		return false, nil, nil
+	})
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	writer := NewWriterAdapter[*testLabelled](dynFake, testGVR, logger, testLabelledToCR, testLabelledFromCR)
+
+	_, err = writer.Update(context.Background(), labelled)
+	require.NoError(t, err)
+	require.Zero(t, writes, "the backend annotations alone must not count as a change")
+
+	_, err = writer.Update(context.Background(), &testLabelled{name: "rt-1", labels: map[string]string{"env": "dev"}})
+	require.NoError(t, err)
+	require.Equal(t, 1, writes)
+
+	stored, err := dynFake.Resource(testGVR).Namespace(namespace).
+		Get(context.Background(), "rt-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "aruba", stored.GetAnnotations()[labels.BackendPluginAnnotation])
+	require.Equal(t, `[{"kind":"VPC","id":"ns/rt-1"}]`, stored.GetAnnotations()[labels.BackendRefsAnnotation])
+	require.Equal(t, "abc", stored.GetAnnotations()[labels.AppliedSpecHashAnnotation])
+}
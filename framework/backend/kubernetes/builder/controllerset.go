@@ -4,14 +4,26 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"time"
 
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/envelope"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/controller"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/refgraph"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/shard"
+	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 )
 
 const (
-	DefaultRequeueTime   = 5 * time.Minute
-	DefaultMaxConditions = 5 // use 0 or a negative value to impose no limit
+	DefaultRequeueTime             = 5 * time.Minute
+	DefaultMaxConditions           = 5 // use 0 or a negative value to impose no limit
+	DefaultMaxConcurrentReconciles = controller.DefaultMaxConcurrentReconciles
+	DefaultMaxAttempts             = 10 // use 0 or a negative value to retry forever
 )
 
 // Reconciler is any controller that can be registered with a controller-runtime Manager.
@@ -65,6 +77,32 @@ type Options struct {
 	Logger        *slog.Logger
 	RequeueAfter  time.Duration
 	MaxConditions int
+	// Plugin names the CSP plugin the controllers delegate to. When set, every controller
+	// records it, and the provider-side objects the plugin names, on the resources it
+	// reconciles.
+	Plugin string
+	// ReferenceGraph, when set, makes every controller hold the deletion of a resource other
+	// resources still reference.
+	ReferenceGraph *refgraph.Graph
+	// Queue configures the concurrency and queueing of the controllers. Pass the Queue
+	// options to a single NewController to tune one slice only.
+	Queue controller.QueueOptions
+	// Sharder, when set, makes every controller reconcile only the tenants it assigns to this
+	// replica. Its shard Leases outlast the longest of the Timeouts.
+	Sharder *shard.Sharder
+	// Timeouts bounds the operations the controllers delegate to the plugin. Pass a WithTimeout
+	// option to a single NewController to bound the operations of one slice only.
+	Timeouts backendport.Timeouts
+	// MaxAttempts bounds the attempts of a failing operation. Once exhausted, the resource is
+	// left in error until the retry action of the gateway re-arms it.
+	MaxAttempts int
+	// DriftCheckInterval, when positive, makes the controllers hand an unchanged spec to the
+	// plugin's Update again once it has passed since the spec was last applied. Otherwise Update
+	// is only called when the spec or labels of a resource change.
+	DriftCheckInterval time.Duration
+	// Sealer, when set, makes the controllers of resources with sensitive fields store them
+	// envelope-encrypted, and open them when reading.
+	Sealer *envelope.Sealer
 }
 
 // Option is a function that applies a configuration change to an Options struct.
@@ -99,17 +137,126 @@ func WithMaxConditions(maxConditions int) Option {
 	}
 }
 
+// WithPlugin names the CSP plugin the controllers delegate to, e.g. "aruba".
+func WithPlugin(plugin string) Option {
+	return func(o *Options) {
+		o.Plugin = plugin
+	}
+}
+
+// WithReferenceGraph sets the reference graph the controllers hold deletions with.
+func WithReferenceGraph(graph *refgraph.Graph) Option {
+	return func(o *Options) {
+		o.ReferenceGraph = graph
+	}
+}
+
+// WithMaxConcurrentReconciles sets the number of reconciles a controller runs in parallel.
+// If zero or negative, nothing is changed.
+func WithMaxConcurrentReconciles(n int) Option {
+	return func(o *Options) {
+		if n <= 0 {
+			return
+		}
+		o.Queue.MaxConcurrentReconciles = n
+	}
+}
+
+// WithRateLimiter sets the constructor of the rate limiter pacing the retries of failed
+// reconciles. Every controller gets a limiter of its own from newLimiter. If nil, nothing is
+// changed.
+func WithRateLimiter(newLimiter func() workqueue.TypedRateLimiter[reconcile.Request]) Option {
+	return func(o *Options) {
+		if newLimiter == nil {
+			return
+		}
+		o.Queue.NewRateLimiter = newLimiter
+	}
+}
+
+// WithFairQueue makes the controllers serve tenants round-robin, a tenant being the value
+// of the internal tenant label of a resource, and run at most maxInFlightPerTenant reconciles
+// of a tenant at once. A value of 0 or negative caps nothing.
+func WithFairQueue(maxInFlightPerTenant int) Option {
+	return func(o *Options) {
+		o.Queue.FairQueue = true
+		o.Queue.MaxInFlightPerTenant = maxInFlightPerTenant
+	}
+}
+
+// WithSharder sets the sharder splitting tenants between the replicas. If nil, every replica
+// reconciles every tenant.
+func WithSharder(sharder *shard.Sharder) Option {
+	return func(o *Options) {
+		o.Sharder = sharder
+	}
+}
+
+// WithTimeout bounds the delegated operation op by timeout. A zero or negative timeout
+// removes the bound.
+func WithTimeout(op backendport.Operation, timeout time.Duration) Option {
+	return func(o *Options) {
+		timeouts := maps.Clone(o.Timeouts)
+		if timeouts == nil {
+			timeouts = backendport.Timeouts{}
+		}
+		timeouts[op] = timeout
+		o.Timeouts = timeouts
+	}
+}
+
+// WithOperationTimeout bounds every delegated operation by timeout. A WithTimeout passed
+// after it overrides the bound of a single operation.
+func WithOperationTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		for _, op := range backendport.Operations {
+			WithTimeout(op, timeout)(o)
+		}
+	}
+}
+
+// WithMaxAttempts sets the number of attempts of a failing operation before the resource is
+// left in error. A value of 0 or negative retries forever. Pass this option explicitly to
+// override DefaultMaxAttempts.
+func WithMaxAttempts(maxAttempts int) Option {
+	return func(o *Options) {
+		o.MaxAttempts = maxAttempts
+	}
+}
+
+// WithDriftCheckInterval makes the controllers hand an unchanged spec to the plugin's Update
+// again every interval, reverting changes made at the provider. A zero or negative interval
+// disables the check.
+func WithDriftCheckInterval(interval time.Duration) Option {
+	return func(o *Options) {
+		o.DriftCheckInterval = interval
+	}
+}
+
+// WithSealer sets the sealer the controllers store sensitive fields with. If nil, they are
+// stored in plain text.
+func WithSealer(sealer *envelope.Sealer) Option {
+	return func(o *Options) {
+		o.Sealer = sealer
+	}
+}
+
 // ApplyOptions applies Option funcs to a default Options and returns the result.
 func ApplyOptions(opts []Option) Options {
 	o := Options{
 		RequeueAfter:  DefaultRequeueTime,
 		Logger:        slog.Default(),
 		MaxConditions: DefaultMaxConditions,
+		MaxAttempts:   DefaultMaxAttempts,
 	}
 	for _, opt := range opts {
 		if opt != nil {
 			opt(&o)
 		}
 	}
+	if o.Sharder != nil {
+		// A shard must not change hands while an operation of one of its tenants may still run.
+		o.Sharder.Outlast(slices.Max(append(slices.Collect(maps.Values(o.Timeouts)), 0)))
+	}
 	return o
 }
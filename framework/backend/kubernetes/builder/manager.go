@@ -0,0 +1,89 @@
+package builder
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/shard"
+)
+
+// inClusterNamespaceFile holds the namespace of the pod, mounted with its service account token.
+const inClusterNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// ManagerFlags configure how the replicas of a delegator share the work: by default they
+// elect a leader, the only one reconciling; with Shards set, every replica reconciles the
+// tenants of the shards it holds instead. They also place the metrics endpoint.
+type ManagerFlags struct {
+	LeaderElect             bool
+	LeaderElectionID        string
+	LeaderElectionNamespace string
+	Shards                  int
+	MetricsBindAddress      string
+}
+
+// BindFlags registers the flags on fs, naming the Leases after plugin by default.
+func (f *ManagerFlags) BindFlags(fs *flag.FlagSet, plugin string) {
+	fs.BoolVar(&f.LeaderElect, "leader-elect", true,
+		"Elect a leader among the replicas, the only one reconciling. Ignored with --shards.")
+	fs.StringVar(&f.LeaderElectionID, "leader-election-id", "ecp-delegator-"+plugin,
+		"Name of the Lease the replicas elect a leader with, and prefix of the shard Leases.")
+	fs.StringVar(&f.LeaderElectionNamespace, "leader-election-namespace", "",
+		"Namespace of the Leases. Defaults to the namespace of the pod.")
+	fs.IntVar(&f.Shards, "shards", 0,
+		"Split tenants into this many shards, spread over every replica, instead of electing a leader. 0 disables sharding.")
+	fs.StringVar(&f.MetricsBindAddress, "metrics-bind-address", "",
+		"Address the metrics endpoint listens on, e.g. :8080, or 0 to serve none. Empty keeps the delegator's default.")
+}
+
+// Apply sets the leader election of o, and its metrics address when one is given. Sharding
+// turns leader election off: every replica reconciles.
+func (f *ManagerFlags) Apply(o *ctrl.Options) {
+	if f.MetricsBindAddress != "" {
+		o.Metrics.BindAddress = f.MetricsBindAddress
+	}
+	o.LeaderElection = f.LeaderElect && f.Shards <= 0
+	o.LeaderElectionID = f.LeaderElectionID
+	o.LeaderElectionNamespace = f.LeaderElectionNamespace
+	// The process exits once the manager stops, so the Lease can be handed over at once.
+	o.LeaderElectionReleaseOnCancel = true
+}
+
+// Sharder returns the Sharder of this replica, added to mgr, or nil when sharding is off. The
+// replica is named after its host name, the pod name in a cluster.
+func (f *ManagerFlags) Sharder(mgr ctrl.Manager, logger *slog.Logger) (*shard.Sharder, error) {
+	if f.Shards <= 0 {
+		return nil, nil
+	}
+
+	namespace := f.LeaderElectionNamespace
+	if namespace == "" {
+		raw, err := os.ReadFile(inClusterNamespaceFile)
+		if err != nil {
+			return nil, fmt.Errorf("not running in a cluster, set --leader-election-namespace: %w", err)
+		}
+		namespace = strings.TrimSpace(string(raw))
+	}
+	identity, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to name the replica: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	sharder, err := shard.New(clientset, namespace, f.LeaderElectionID, identity, f.Shards, logger)
+	if err != nil {
+		return nil, err
+	}
+	if err := mgr.Add(sharder); err != nil {
+		return nil, fmt.Errorf("failed to add sharder: %w", err)
+	}
+	return sharder, nil
+}
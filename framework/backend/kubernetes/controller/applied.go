@@ -0,0 +1,91 @@
+package controller
+
+import (
+	"crypto/sha3"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+)
+
+// CheckDrift makes the controller hand an unchanged spec to the plugin's Update again once
+// interval has passed since it was last applied, so that changes made at the provider behind
+// the control plane's back are reverted. A zero or negative interval disables the check: the
+// plugin is then only called when the spec or labels of the resource change. Call it before
+// SetupWithManager.
+func (r *GenericController[D]) CheckDrift(interval time.Duration) {
+	r.driftCheckInterval = interval
+}
+
+// appliedRecord is what a reconcile knows of the spec the plugin last applied to a resource.
+// Its AppliedSpec travels to the handler in the context.
+type appliedRecord struct {
+	backend.AppliedSpec
+	generation int64
+	hash       string
+	// due is when the next drift check is due; zero when there is none.
+	due time.Time
+}
+
+// appliedRecordOf compares the spec of obj with the one the plugin last applied, as recorded in
+// the annotations of obj.
+func (r *GenericController[D]) appliedRecordOf(obj client.Object, now time.Time) *appliedRecord {
+	record := &appliedRecord{generation: obj.GetGeneration(), hash: specHash(obj)}
+	annotations := obj.GetAnnotations()
+	if record.hash == "" || annotations[k8slabels.AppliedSpecHashAnnotation] != record.hash {
+		return record
+	}
+	if r.driftCheckInterval > 0 {
+		appliedAt, err := time.Parse(time.RFC3339, annotations[k8slabels.AppliedAtAnnotation])
+		if err != nil || now.Sub(appliedAt) >= r.driftCheckInterval {
+			return record
+		}
+		record.due = appliedAt.Add(r.driftCheckInterval)
+	}
+	record.Current = true
+	return record
+}
+
+// annotate records, in annotations, the spec the plugin applied during the reconcile. It records
+// nothing when the plugin applied nothing.
+func (a *appliedRecord) annotate(annotations map[string]string, now time.Time) {
+	if !a.Applied || a.hash == "" {
+		return
+	}
+	annotations[k8slabels.ObservedGenerationAnnotation] = strconv.FormatInt(a.generation, 10)
+	annotations[k8slabels.AppliedSpecHashAnnotation] = a.hash
+	annotations[k8slabels.AppliedAtAnnotation] = now.UTC().Format(time.RFC3339)
+}
+
+// specHash hashes what the plugin's Update is handed: the spec and commonData of obj, its
+// labels, the internal ones aside, and its sealed spec fields. It returns "" when obj cannot be
+// converted, which never matches a recorded hash.
+//
+// The sealed fields are hashed in their sealed form: the sealer seals an unchanged plaintext
+// back to the value it was read from, so the hash only changes with them.
+func specHash(obj client.Object) string {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return ""
+	}
+	handed := map[string]any{
+		"spec":       u["spec"],
+		"commonData": u["commonData"],
+		"labels":     k8slabels.FilterInternalLabels(obj.GetLabels()),
+	}
+	if sealed, ok := obj.GetAnnotations()[k8slabels.SealedSpecAnnotation]; ok {
+		handed["sealedSpec"] = sealed
+	}
+	// encoding/json sorts map keys, so equal objects hash equally.
+	raw, err := json.Marshal(handed)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha3.Sum224(raw))
+}
@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/envelope"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+)
+
+func newAppliedObject(size int64) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "storage.v1.secapi.cloud/v1",
+		"kind":       "BlockStorage",
+		"metadata":   map[string]any{"namespace": "ns", "name": "bs-1", "generation": int64(3)},
+		"spec":       map[string]any{"sizeGB": size},
+		"status":     map[string]any{"state": stateActive},
+	}}
+	obj.SetLabels(map[string]string{"env": "prod", k8slabels.InternalTenantLabel: "t1"})
+	return obj
+}
+
+// apply records on obj that the plugin applied its spec at appliedAt, as a reconcile would.
+func apply(obj *unstructured.Unstructured, r *GenericController[persistence.IdentifiableResource], appliedAt time.Time) {
+	record := r.appliedRecordOf(obj, appliedAt)
+	record.Applied = true
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	record.annotate(annotations, appliedAt)
+	obj.SetAnnotations(annotations)
+}
+
+func TestAppliedRecord_CurrentUntilTheSpecChanges(t *testing.T) {
+	r := &GenericController[persistence.IdentifiableResource]{}
+	obj := newAppliedObject(10)
+	now := time.Now()
+
+	require.False(t, r.appliedRecordOf(obj, now).Current, "nothing applied yet")
+
+	apply(obj, r, now)
+	assert.Equal(t, "3", obj.GetAnnotations()[k8slabels.ObservedGenerationAnnotation])
+	assert.True(t, r.appliedRecordOf(obj, now).Current)
+
+	obj.Object["status"] = map[string]any{"state": stateActive, "conditions": []any{}}
+	assert.True(t, r.appliedRecordOf(obj, now).Current, "a status write changes nothing to apply")
+
+	obj.Object["spec"] = map[string]any{"sizeGB": int64(20)}
+	assert.False(t, r.appliedRecordOf(obj, now).Current)
+}
+
+func TestAppliedRecord_LabelsCount(t *testing.T) {
+	r := &GenericController[persistence.IdentifiableResource]{}
+	obj := newAppliedObject(10)
+	now := time.Now()
+	apply(obj, r, now)
+
+	labels := obj.GetLabels()
+	labels[k8slabels.InternalRegionLabel] = "eu-1"
+	obj.SetLabels(labels)
+	assert.True(t, r.appliedRecordOf(obj, now).Current, "internal labels are not handed to the plugin")
+
+	labels["env"] = "dev"
+	obj.SetLabels(labels)
+	assert.False(t, r.appliedRecordOf(obj, now).Current)
+}
+
+func TestAppliedRecord_SealedSpecCounts(t *testing.T) {
+	kf, err := envelope.ParseKeyFile([]byte("k1:" + base64.StdEncoding.EncodeToString(make([]byte, 32))))
+	require.NoError(t, err)
+	sealer := envelope.NewSealer(kf)
+	seal := func(userData string) string {
+		value, err := sealer.Seal([]byte(`{"userData":"`+userData+`"}`), envelope.Identity("t1", "ws", "vm-1"))
+		require.NoError(t, err)
+		return value
+	}
+
+	r := &GenericController[persistence.IdentifiableResource]{}
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "compute.v1.secapi.cloud/v1",
+		"kind":       "Instance",
+		"metadata":   map[string]any{"namespace": "ns", "name": "vm-1", "generation": int64(1)},
+		"spec":       map[string]any{"skuRef": map[string]any{"resource": "skus/small"}},
+	}}
+	obj.SetAnnotations(map[string]string{k8slabels.SealedSpecAnnotation: seal("#cloud-config")})
+	now := time.Now()
+	apply(obj, r, now)
+	require.True(t, r.appliedRecordOf(obj, now).Current)
+
+	annotations := obj.GetAnnotations()
+	annotations[k8slabels.SealedSpecAnnotation] = seal("#cloud-config")
+	obj.SetAnnotations(annotations)
+	assert.True(t, r.appliedRecordOf(obj, now).Current, "an unchanged userData seals back to the same value")
+
+	annotations[k8slabels.SealedSpecAnnotation] = seal("#cloud-config hostname: web")
+	obj.SetAnnotations(annotations)
+	assert.False(t, r.appliedRecordOf(obj, now).Current, "only the sealed userData changed")
+}
+
+func TestAppliedRecord_DriftCheck(t *testing.T) {
+	r := &GenericController[persistence.IdentifiableResource]{}
+	r.CheckDrift(time.Hour)
+	obj := newAppliedObject(10)
+	appliedAt := time.Now().Add(-30 * time.Minute).Truncate(time.Second)
+	apply(obj, r, appliedAt)
+
+	record := r.appliedRecordOf(obj, time.Now())
+	require.True(t, record.Current)
+	assert.Equal(t, appliedAt.Add(time.Hour), record.due)
+
+	assert.False(t, r.appliedRecordOf(obj, appliedAt.Add(time.Hour)).Current, "the drift check is due")
+}
+
+func TestAppliedRecord_NothingAppliedRecordsNothing(t *testing.T) {
+	r := &GenericController[persistence.IdentifiableResource]{}
+	annotations := map[string]string{}
+
+	r.appliedRecordOf(newAppliedObject(10), time.Now()).annotate(annotations, time.Now())
+
+	assert.Empty(t, annotations)
+}
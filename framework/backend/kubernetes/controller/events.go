@@ -0,0 +1,156 @@
+package controller
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+)
+
+// eventSource names the controllers as the source of the events they record.
+const eventSource = "ecp-delegator"
+
+// warningConditionTypes are the types of the conditions, besides those in the error state,
+// that hold a resource back. "UpdateFailed" matches the condition the update path records;
+// like stateDeleting, it is repeated here to keep the framework free of any resources package.
+var warningConditionTypes = []string{"UpdateFailed", "DeletionBlocked", "ConversionFailed"}
+
+// RecordEvents makes the controller record its events with recorder instead of the event
+// recorder of the manager. Call it before SetupWithManager.
+func (r *GenericController[D]) RecordEvents(recorder record.EventRecorder) {
+	r.recorder = recorder
+}
+
+// conditionLog remembers, per resource, the conditions a controller last saw, so that it
+// records each condition as an event once.
+type conditionLog struct {
+	mu    sync.Mutex
+	heads map[types.NamespacedName]*schemav1.StatusCondition
+}
+
+// swap remembers the head of conditions as the last seen of key, and returns the previous one.
+// seen is false the first time a resource is seen.
+func (l *conditionLog) swap(key types.NamespacedName, conditions []schemav1.StatusCondition) (previous *schemav1.StatusCondition, seen bool) {
+	var head *schemav1.StatusCondition
+	if len(conditions) > 0 {
+		c := conditions[0]
+		head = &c
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.heads == nil {
+		l.heads = map[types.NamespacedName]*schemav1.StatusCondition{}
+	}
+	previous, seen = l.heads[key]
+	l.heads[key] = head
+	return previous, seen
+}
+
+// forget drops what is remembered of key, once the resource is gone.
+func (l *conditionLog) forget(key types.NamespacedName) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.heads, key)
+}
+
+// observeConditions records an event for every condition of obj pushed since the controller
+// last saw it, oldest first, and observes the time the resource spent in each state it left.
+// Every status write, whether by a handler or by the controller itself, triggers a reconcile,
+// so a state transition, a failure, a dependency wait and a blocked deletion all surface here.
+//
+// The first time a resource is seen, after a restart or a shard takeover, its conditions are
+// only remembered: they were recorded by whichever replica pushed them. Repeats are left to
+// the recorder, which folds identical events into one with a count and rate-limits the events
+// of each resource.
+func (r *GenericController[D]) observeConditions(key types.NamespacedName, obj schemav1.ConditionedObject) {
+	if r.events == nil {
+		return
+	}
+	conditions := obj.GetConditions()
+	previous, seen := r.events.swap(key, conditions)
+	if !seen {
+		return
+	}
+	pushed := pushedSince(conditions, previous)
+
+	// A head that repeats previous moved the resource nowhere it had not already been.
+	transitions := len(pushed)
+	if transitions > 0 && previous != nil && schemav1.EqualConditions(pushed[transitions-1], *previous) {
+		transitions--
+	}
+	r.observeStateDurations(conditions, transitions)
+
+	if r.recorder == nil {
+		return
+	}
+	for _, c := range slices.Backward(pushed) {
+		eventType := corev1.EventTypeNormal
+		if c.State == schemav1.ResourceStateError || slices.Contains(warningConditionTypes, c.Type) {
+			eventType = corev1.EventTypeWarning
+		}
+		r.recorder.AnnotatedEventf(obj, map[string]string{k8slabels.EventStateAnnotation: string(c.State)},
+			eventType, eventReason(c), "%s", c.Message)
+	}
+}
+
+// observeStateDurations observes, for each of the newest n conditions that moved the resource
+// to another state, the time it spent in the state it left: from the oldest of the conditions
+// it remembers in that state to the one that moved it on. Time spent active is not observed;
+// it is where a resource settles, not a step on its way there.
+func (r *GenericController[D]) observeStateDurations(conditions []schemav1.StatusCondition, n int) {
+	for i := range min(n, len(conditions)-1) {
+		state := conditions[i+1].State
+		if conditions[i].State == state || state == schemav1.ResourceStateActive {
+			continue
+		}
+		entered := i + 1
+		for entered+1 < len(conditions) && conditions[entered+1].State == state {
+			entered++
+		}
+		if spent := conditions[i].LastTransitionAt.Sub(conditions[entered].LastTransitionAt.Time); spent >= 0 {
+			stateDuration.WithLabelValues(r.gvr, string(state)).Observe(spent.Seconds())
+		}
+	}
+}
+
+// pushedSince returns the conditions, newest first, pushed over previous. A head that repeats
+// previous, as a failure does on every attempt, counts as pushed again.
+func pushedSince(conditions []schemav1.StatusCondition, previous *schemav1.StatusCondition) []schemav1.StatusCondition {
+	if previous == nil {
+		return conditions
+	}
+	i := slices.IndexFunc(conditions, func(c schemav1.StatusCondition) bool {
+		return schemav1.EqualConditions(c, *previous)
+	})
+	switch {
+	case i < 0:
+		// Trimmed away: everything left is newer.
+		return conditions
+	case i == 0 && conditions[0].Occurrences > previous.Occurrences:
+		return conditions[:1]
+	default:
+		return conditions[:i]
+	}
+}
+
+// eventReason is the reason of the event recording c. A lifecycle transition, whose reason is
+// its state, is recorded as the state in CamelCase, e.g. "Creating".
+func eventReason(c schemav1.StatusCondition) string {
+	reason := c.Reason
+	if reason == "" {
+		reason = string(c.State)
+	}
+	if reason == "" {
+		return c.Type
+	}
+	return strings.ToUpper(reason[:1]) + reason[1:]
+}
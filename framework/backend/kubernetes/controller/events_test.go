@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+)
+
+// conditioned is the smallest ConditionedObject: conditions on an unstructured object.
+type conditioned struct {
+	unstructured.Unstructured
+	conditions []schemav1.StatusCondition
+}
+
+func (c *conditioned) GetConditions() []schemav1.StatusCondition { return c.conditions }
+func (c *conditioned) LenConditions() int                        { return len(c.conditions) }
+func (c *conditioned) PopCondition()                             { c.conditions = c.conditions[:len(c.conditions)-1] }
+
+func (c *conditioned) PeekConditions() *schemav1.StatusCondition {
+	if len(c.conditions) == 0 {
+		return nil
+	}
+	return &c.conditions[0]
+}
+
+func (c *conditioned) PushCondition(condition schemav1.StatusCondition) {
+	if prev := c.PeekConditions(); prev != nil && schemav1.EqualConditions(*prev, condition) {
+		prev.Occurrences++
+		return
+	}
+	condition.Occurrences = 1
+	c.conditions = append([]schemav1.StatusCondition{condition}, c.conditions...)
+}
+
+func stateCondition(state schemav1.ResourceState) schemav1.StatusCondition {
+	return schemav1.StatusCondition{Type: "Reconcile", State: state, Reason: string(state), Message: "Resource is " + string(state) + "."}
+}
+
+// eventLog records events as "<type> <reason> <message> (<state>)".
+type eventLog struct{ events []string }
+
+func (l *eventLog) Event(object runtime.Object, eventtype, reason, message string) {
+	l.AnnotatedEventf(object, nil, eventtype, reason, "%s", message)
+}
+
+func (l *eventLog) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...any) {
+	l.AnnotatedEventf(object, nil, eventtype, reason, messageFmt, args...)
+}
+
+func (l *eventLog) AnnotatedEventf(_ runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...any) {
+	l.events = append(l.events, fmt.Sprintf("%s %s %s (%s)", eventtype, reason, fmt.Sprintf(messageFmt, args...), annotations[k8slabels.EventStateAnnotation]))
+}
+
+// drain returns the events recorded since the last drain.
+func (l *eventLog) drain() []string {
+	events := l.events
+	l.events = nil
+	return events
+}
+
+func newEventController() (*GenericController[persistence.IdentifiableResource], *eventLog) {
+	recorder := &eventLog{}
+	r := &GenericController[persistence.IdentifiableResource]{events: &conditionLog{}}
+	r.RecordEvents(recorder)
+	return r, recorder
+}
+
+func TestRecordEvents_Transitions(t *testing.T) {
+	r, recorder := newEventController()
+	key := types.NamespacedName{Namespace: "ns", Name: "bs-1"}
+	obj := &conditioned{}
+
+	r.observeConditions(key, obj)
+	assert.Empty(t, recorder.drain(), "a resource seen for the first time records nothing")
+
+	obj.PushCondition(stateCondition(schemav1.ResourceStatePending))
+	obj.PushCondition(stateCondition(schemav1.ResourceStateCreating))
+	r.observeConditions(key, obj)
+	assert.Equal(t, []string{
+		"Normal Pending Resource is pending. (pending)",
+		"Normal Creating Resource is creating. (creating)",
+	}, recorder.drain(), "every condition pushed since, oldest first")
+
+	r.observeConditions(key, obj)
+	assert.Empty(t, recorder.drain(), "a reconcile that pushed nothing records nothing")
+
+	obj.PushCondition(schemav1.StatusCondition{Type: "ReconcileError", State: schemav1.ResourceStateError, Reason: "ReconcileError", Message: "quota exceeded"})
+	r.observeConditions(key, obj)
+	assert.Equal(t, []string{"Warning ReconcileError quota exceeded (error)"}, recorder.drain())
+
+	obj.PushCondition(schemav1.StatusCondition{Type: "ReconcileError", State: schemav1.ResourceStateError, Reason: "ReconcileError", Message: "quota exceeded"})
+	r.observeConditions(key, obj)
+	assert.Equal(t, []string{"Warning ReconcileError quota exceeded (error)"}, recorder.drain(), "a repeated failure is recorded again")
+}
+
+func TestRecordEvents_DeletionBlockedIsAWarning(t *testing.T) {
+	r, recorder := newEventController()
+	key := types.NamespacedName{Namespace: "ns", Name: "bs-1"}
+	obj := &conditioned{}
+	obj.PushCondition(stateCondition(schemav1.ResourceStateActive))
+	r.observeConditions(key, obj)
+
+	obj.PushCondition(schemav1.StatusCondition{Type: "DeletionBlocked", State: schemav1.ResourceStateActive, Reason: "ReferencedByDependent", Message: "deletion blocked: still referenced by instances/vm-1"})
+	r.observeConditions(key, obj)
+
+	assert.Equal(t, []string{"Warning ReferencedByDependent deletion blocked: still referenced by instances/vm-1 (active)"}, recorder.drain())
+}
+
+func TestRecordEvents_ForgottenResourceStartsOver(t *testing.T) {
+	r, recorder := newEventController()
+	key := types.NamespacedName{Namespace: "ns", Name: "bs-1"}
+	obj := &conditioned{}
+	obj.PushCondition(stateCondition(schemav1.ResourceStateActive))
+	r.observeConditions(key, obj)
+
+	r.events.forget(key)
+	obj.PushCondition(stateCondition(schemav1.ResourceStateDeleting))
+	r.observeConditions(key, obj)
+
+	assert.Empty(t, recorder.drain())
+}
+
+func TestPushedSince_Trimmed(t *testing.T) {
+	previous := stateCondition(schemav1.ResourceStatePending)
+	conditions := []schemav1.StatusCondition{stateCondition(schemav1.ResourceStateActive), stateCondition(schemav1.ResourceStateCreating)}
+
+	assert.Equal(t, conditions, pushedSince(conditions, &previous))
+}
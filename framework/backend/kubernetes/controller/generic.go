@@ -2,21 +2,30 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log/slog"
+	"maps"
 	"slices"
 	"strings"
 	"time"
 
+	kerrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/refgraph"
 	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/shard"
 
 	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
@@ -28,6 +37,9 @@ import (
 // If the sentinel ever changes, update this const and its test assertion.
 const stateDeleting = "deleting"
 
+// stateActive is the wire value of ResourceState once the plugin has provisioned a resource.
+const stateActive = "active"
+
 // GenericController implements a generic Kubernetes controller that reconciles
 // resources by delegating the logic to a PluginHandler.
 //
@@ -41,6 +53,17 @@ type GenericController[D persistence.IdentifiableResource] struct {
 	requeueAfter        time.Duration
 	logger              *slog.Logger
 	maxStatusConditions int
+	plugin              string
+	referencer          backend.BackendReferencer[D]
+	references          []refgraph.Reference
+	graph               *refgraph.Graph
+	collection          string
+	queue               QueueOptions
+	sharder             *shard.Sharder
+	driftCheckInterval  time.Duration
+	recorder            record.EventRecorder
+	events              *conditionLog
+	gvr                 string
 }
 
 // NewGenericController creates a new instance of GenericController.
@@ -61,25 +84,75 @@ func NewGenericController[D persistence.IdentifiableResource](
 		requeueAfter:        requeueAfter,
 		logger:              logger,
 		maxStatusConditions: maxStatusConditions,
+		events:              &conditionLog{},
 	}
 }
 
+// RecordBackend makes the controller record, on every active resource it reconciles, the
+// name of the CSP plugin and, when p implements backend.BackendReferencer, the provider-side
+// objects p names. An empty plugin records nothing. Call it before SetupWithManager.
+func (r *GenericController[D]) RecordBackend(plugin string, p any) {
+	r.plugin = plugin
+	r.referencer, _ = p.(backend.BackendReferencer[D])
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *GenericController[D]) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(r.prototype).
-		WithOptions(controller.Options{
-			// This allows 10 workers to process the queue in parallel
-			// TODO: make this configurable
-			MaxConcurrentReconciles: 10,
-		}).
-		Complete(r)
+	opts := controller.Options{
+		MaxConcurrentReconciles: r.queue.MaxConcurrentReconciles,
+	}
+	if r.queue.NewRateLimiter != nil {
+		opts.RateLimiter = r.queue.NewRateLimiter()
+	}
+	if opts.MaxConcurrentReconciles <= 0 {
+		opts.MaxConcurrentReconciles = DefaultMaxConcurrentReconciles
+	}
+	if r.recorder == nil {
+		r.recorder = mgr.GetEventRecorderFor(eventSource)
+	}
+	if r.queue.FairQueue {
+		tenantOf := r.tenantResolver(mgr.GetCache())
+		opts.NewQueue = func(name string, limiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+			return newFairQueue(name, tenantOf, r.queue.MaxInFlightPerTenant, limiter)
+		}
+	}
+	gvk, err := r.prototypeGVK(mgr)
+	if err != nil {
+		return err
+	}
+	if err := r.registerMetrics(mgr, gvk); err != nil {
+		return err
+	}
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(r.prototype, r.forOptions()...).
+		WithOptions(opts)
+	if r.sharder != nil {
+		r.watchShards(mgr, b, gvk)
+	}
+	if len(r.references) > 0 {
+		if err := r.watchReferences(mgr, b, gvk); err != nil {
+			return err
+		}
+	}
+	if r.graph != nil {
+		if err := r.watchReferrers(mgr, b, gvk); err != nil {
+			return err
+		}
+	}
+	return b.Complete(r)
 }
 
 const finalizerName = "secapi.cloud.foundation/cleanup"
 
 // Reconcile implements the reconcile.Reconciler interface.
 func (r *GenericController[D]) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	result, err := r.reconcile(ctx, req)
+	reconcileDuration.WithLabelValues(r.gvr, reconcileOutcome(result, err)).Observe(time.Since(start).Seconds())
+	return result, err
+}
+
+func (r *GenericController[D]) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := r.logger.With("resource", req.NamespacedName)
 
 	var obj schemav1.ConditionedObject
@@ -87,8 +160,16 @@ func (r *GenericController[D]) Reconcile(ctx context.Context, req ctrl.Request)
 	// 1. Fetch the K8s object
 	obj = r.prototype.DeepCopyObject().(schemav1.ConditionedObject)
 	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if kerrs.IsNotFound(err) {
+			r.events.forget(req.NamespacedName)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	if !r.owned(obj) {
+		// Another replica reconciles the tenant of the resource.
+		return ctrl.Result{}, nil
+	}
+	r.observeConditions(req.NamespacedName, obj)
 
 	// 2. Handle finalizers
 	if obj.GetDeletionTimestamp().IsZero() && !slices.Contains(obj.GetFinalizers(), finalizerName) {
@@ -100,7 +181,12 @@ func (r *GenericController[D]) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{RequeueAfter: r.requeueAfter}, nil
 	}
 
-	// 3. Convert to Domain object for normal reconciliation
+	// 3. Hold the deletion of a resource other resources still reference
+	if blocked, err := r.blockReferencedDeletion(ctx, obj); blocked || err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// 4. Convert to Domain object for normal reconciliation
 	domainResource, err := r.k8sToDomain(obj)
 	if err != nil {
 		// If conversion fails, it's likely a permanent error
@@ -124,8 +210,9 @@ func (r *GenericController[D]) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{}, nil
 	}
 
-	// 4. Delegate to the specific handler
-	requeue, err := r.handler.HandleReconcile(ctx, domainResource)
+	// 5. Delegate to the specific handler, telling it whether the plugin already applied the spec
+	applied := r.appliedRecordOf(obj, time.Now())
+	requeue, err := r.handler.HandleReconcile(backend.ContextWithAppliedSpec(ctx, &applied.AppliedSpec), domainResource)
 	if err != nil {
 		if errors.Is(err, backend.ErrStillProcessing) {
 			return ctrl.Result{RequeueAfter: r.requeueAfter}, nil
@@ -134,18 +221,30 @@ func (r *GenericController[D]) Reconcile(ctx context.Context, req ctrl.Request)
 		return ctrl.Result{RequeueAfter: r.requeueAfter}, err
 	}
 
-	// 5. Requeue the request if necessary
+	// 6. Requeue the request if necessary
 	if requeue {
 		return ctrl.Result{RequeueAfter: r.requeueAfter}, nil
 	}
 
-	// 6. Refresh the K8s object
+	// 7. Refresh the K8s object
 	obj = r.prototype.DeepCopyObject().(schemav1.ConditionedObject)
 	if err := r.client.Get(ctx, req.NamespacedName, obj); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// 7. Check if the resource deletion process is complete
+	// 8. Record the backend of an active resource and the spec the plugin applied to it
+	var result ctrl.Result
+	if obj.GetDeletionTimestamp().IsZero() && getStateFromObject(obj) == stateActive {
+		if err := r.recordBackend(ctx, obj, domainResource, applied); err != nil {
+			return ctrl.Result{}, err
+		}
+		if applied.Current && !applied.due.IsZero() {
+			// Come back for the drift check: nothing else may trigger a reconcile until then.
+			result.RequeueAfter = time.Until(applied.due)
+		}
+	}
+
+	// 9. Check if the resource deletion process is complete
 	if !obj.GetDeletionTimestamp().IsZero() &&
 		getStateFromObject(obj) == stateDeleting &&
 		slices.Contains(obj.GetFinalizers(), finalizerName) {
@@ -157,7 +256,35 @@ func (r *GenericController[D]) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 	}
 
-	return ctrl.Result{}, nil
+	return result, nil
+}
+
+// recordBackend sets the backend annotations of obj, and those of the spec the plugin applied
+// during the reconcile, writing only when they change. A plugin failing to name its objects is
+// logged and leaves the recorded ones in place: the report reading them is informational and
+// must not hold up reconciliation.
+func (r *GenericController[D]) recordBackend(ctx context.Context, obj schemav1.ConditionedObject, resource D, applied *appliedRecord) error {
+	annotations := maps.Clone(obj.GetAnnotations())
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if r.plugin != "" {
+		annotations[k8slabels.BackendPluginAnnotation] = r.plugin
+		if r.referencer != nil {
+			refs, err := r.referencer.BackendRefs(ctx, resource)
+			if err != nil {
+				r.logger.Warn("plugin failed to name the backend objects", "resource", obj.GetName(), "error", err)
+			} else if raw, err := json.Marshal(refs); err == nil {
+				annotations[k8slabels.BackendRefsAnnotation] = string(raw)
+			}
+		}
+	}
+	applied.annotate(annotations, time.Now())
+	if maps.Equal(annotations, obj.GetAnnotations()) {
+		return nil
+	}
+	obj.SetAnnotations(annotations)
+	return client.IgnoreNotFound(r.client.Update(ctx, obj))
 }
 
 // getStateFromObject reads the status.state field from any ConditionedObject via
@@ -0,0 +1,162 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// The controllers register their metrics on the controller-runtime registry, so that the
+// manager's metrics endpoint serves them next to its own workqueue and reconcile metrics.
+//
+//   - ecp_controller_tenant_queue_depth{controller,tenant} — items of a tenant waiting in
+//     the fair queue of a controller.
+//   - ecp_controller_tenant_queue_wait_seconds{controller,tenant} — time an item of a
+//     tenant waited in the fair queue before a worker took it.
+//   - ecp_controller_tenant_in_flight{controller,tenant} — reconciles of a tenant running.
+//   - ecp_controller_reconcile_duration_seconds{gvr,outcome} — time a reconcile took, by
+//     outcome: "success", "requeue" when the controller comes back to the resource later,
+//     or "error".
+//   - ecp_resource_state_duration_seconds{gvr,state} — time a resource spent in a state,
+//     observed as it moves to another. Time spent active, where a resource settles, is not.
+//   - ecp_resources{gvr,state} — resources in each state, counted at every scrape.
+var (
+	queueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ecp_controller_tenant_queue_depth",
+		Help: "Items of a tenant waiting in the fair queue of a controller.",
+	}, []string{"controller", "tenant"})
+
+	queueWait = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ecp_controller_tenant_queue_wait_seconds",
+		Help:    "Time an item of a tenant waited in the fair queue before a worker took it.",
+		Buckets: prometheus.ExponentialBuckets(1e-3, 2, 18),
+	}, []string{"controller", "tenant"})
+
+	inFlight = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ecp_controller_tenant_in_flight",
+		Help: "Reconciles of a tenant a controller is running.",
+	}, []string{"controller", "tenant"})
+
+	reconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ecp_controller_reconcile_duration_seconds",
+		Help:    "Time a reconcile of a resource took, by outcome.",
+		Buckets: prometheus.ExponentialBuckets(1e-3, 2, 16),
+	}, []string{"gvr", "outcome"})
+
+	stateDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ecp_resource_state_duration_seconds",
+		Help:    "Time a resource spent in a state before moving to another.",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 16),
+	}, []string{"gvr", "state"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(queueDepth, queueWait, inFlight, reconcileDuration, stateDuration)
+}
+
+// Outcomes of a reconcile.
+const (
+	outcomeSuccess = "success"
+	outcomeRequeue = "requeue"
+	outcomeError   = "error"
+)
+
+// reconcileOutcome classifies the result of a reconcile.
+func reconcileOutcome(result ctrl.Result, err error) string {
+	switch {
+	case err != nil:
+		return outcomeError
+	case result.RequeueAfter > 0:
+		return outcomeRequeue
+	default:
+		return outcomeSuccess
+	}
+}
+
+// stateUnknown labels the resources whose status has no state yet.
+const stateUnknown = "unknown"
+
+// stateCounter counts the resources of one kind in each state, listing them from the cache
+// of the manager at every scrape. Every replica shares the cache, sharded or not, so each
+// reports every resource of the kind.
+type stateCounter struct {
+	desc    *prometheus.Desc
+	reader  client.Reader
+	newList func() client.ObjectList
+}
+
+func (c *stateCounter) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *stateCounter) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	list := c.newList()
+	if err := c.reader.List(ctx, list); err != nil {
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+		return
+	}
+	counts := map[string]int{}
+	_ = meta.EachListItem(list, func(o runtime.Object) error {
+		state := stateUnknown
+		if obj, ok := o.(client.Object); ok {
+			if s := getStateFromObject(obj); s != "" {
+				state = s
+			}
+		}
+		counts[state]++
+		return nil
+	})
+	for state, n := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(n), state)
+	}
+}
+
+// gvrLabel names gvr in the gvr label of the metrics, e.g. "storage.v1.secapi.cloud/v1/block-storages".
+func gvrLabel(gvr schema.GroupVersionResource) string {
+	return gvr.Group + "/" + gvr.Version + "/" + gvr.Resource
+}
+
+// registerMetrics names the reconciled resource in the metrics of the controller, and counts
+// the resources of its kind in each state. A counter registered for the kind before, by a
+// previous manager, is replaced.
+func (r *GenericController[D]) registerMetrics(mgr ctrl.Manager, gvk schema.GroupVersionKind) error {
+	mapping, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return fmt.Errorf("failed to map %s to its resource: %w", gvk, err)
+	}
+	r.gvr = gvrLabel(mapping.Resource)
+
+	listGVK := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+	if _, err := mgr.GetScheme().New(listGVK); err != nil {
+		return fmt.Errorf("failed to count the resources of %s: %w", gvk, err)
+	}
+	counter := &stateCounter{
+		desc: prometheus.NewDesc("ecp_resources", "Resources in each state.",
+			[]string{"state"}, prometheus.Labels{"gvr": r.gvr}),
+		reader: mgr.GetCache(),
+		newList: func() client.ObjectList {
+			list, _ := mgr.GetScheme().New(listGVK)
+			return list.(client.ObjectList)
+		},
+	}
+	if err := metrics.Registry.Register(counter); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &registered) {
+			return err
+		}
+		metrics.Registry.Unregister(registered.ExistingCollector)
+		return metrics.Registry.Register(counter)
+	}
+	return nil
+}
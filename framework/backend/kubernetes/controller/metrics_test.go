@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+)
+
+func TestReconcileOutcome(t *testing.T) {
+	assert.Equal(t, outcomeSuccess, reconcileOutcome(ctrl.Result{}, nil))
+	assert.Equal(t, outcomeRequeue, reconcileOutcome(ctrl.Result{RequeueAfter: time.Second}, nil))
+	assert.Equal(t, outcomeError, reconcileOutcome(ctrl.Result{RequeueAfter: time.Second}, errors.New("boom")))
+}
+
+func TestObserveConditions_StateDurations(t *testing.T) {
+	defer func(d *prometheus.HistogramVec) { stateDuration = d }(stateDuration)
+	stateDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ecp_resource_state_duration_seconds",
+		Help:    "Time a resource spent in a state before moving to another.",
+		Buckets: []float64{60},
+	}, []string{"gvr", "state"})
+
+	r, _ := newEventController()
+	r.gvr = "test/v1/things"
+	key := types.NamespacedName{Namespace: "ns", Name: "bs-1"}
+	obj := &conditioned{}
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	at := func(c schemav1.StatusCondition, seconds int) schemav1.StatusCondition {
+		c.LastTransitionAt = metav1.NewTime(start.Add(time.Duration(seconds) * time.Second))
+		return c
+	}
+
+	obj.PushCondition(at(stateCondition(schemav1.ResourceStatePending), 0))
+	r.observeConditions(key, obj)
+	obj.PushCondition(at(stateCondition(schemav1.ResourceStateCreating), 10))
+	obj.PushCondition(at(schemav1.StatusCondition{Type: "DependencyWait", State: schemav1.ResourceStateCreating, Reason: "WaitingForDependency"}, 30))
+	r.observeConditions(key, obj)
+	obj.PushCondition(at(stateCondition(schemav1.ResourceStateActive), 100))
+	r.observeConditions(key, obj)
+	r.observeConditions(key, obj)
+
+	// Pending for 10s; creating for 90s, from the condition that entered it.
+	require.NoError(t, testutil.CollectAndCompare(stateDuration, strings.NewReader(`
+# HELP ecp_resource_state_duration_seconds Time a resource spent in a state before moving to another.
+# TYPE ecp_resource_state_duration_seconds histogram
+ecp_resource_state_duration_seconds_bucket{gvr="test/v1/things",state="creating",le="60"} 0
+ecp_resource_state_duration_seconds_bucket{gvr="test/v1/things",state="creating",le="+Inf"} 1
+ecp_resource_state_duration_seconds_sum{gvr="test/v1/things",state="creating"} 90
+ecp_resource_state_duration_seconds_count{gvr="test/v1/things",state="creating"} 1
+ecp_resource_state_duration_seconds_bucket{gvr="test/v1/things",state="pending",le="60"} 1
+ecp_resource_state_duration_seconds_bucket{gvr="test/v1/things",state="pending",le="+Inf"} 1
+ecp_resource_state_duration_seconds_sum{gvr="test/v1/things",state="pending"} 10
+ecp_resource_state_duration_seconds_count{gvr="test/v1/things",state="pending"} 1
+`)))
+}
@@ -0,0 +1,338 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+)
+
+// DefaultMaxConcurrentReconciles is the number of reconciles a controller runs in parallel
+// unless QueueOptions says otherwise.
+const DefaultMaxConcurrentReconciles = 10
+
+// QueueOptions configures how a GenericController queues and runs its reconciles.
+type QueueOptions struct {
+	// MaxConcurrentReconciles is the number of reconciles run in parallel; zero means
+	// DefaultMaxConcurrentReconciles.
+	MaxConcurrentReconciles int
+	// NewRateLimiter returns the rate limiter pacing the retries of failed reconciles; nil
+	// keeps the controller-runtime default. It is called once per controller: a limiter
+	// tracks the failures of the requests it paces, and one shared between controllers would
+	// mix up requests of different kinds with the same name.
+	NewRateLimiter func() workqueue.TypedRateLimiter[reconcile.Request]
+	// FairQueue replaces the shared work queue with one serving tenants round-robin, so that
+	// a tenant with a large backlog does not starve the others.
+	FairQueue bool
+	// MaxInFlightPerTenant caps the reconciles of a single tenant running at once when
+	// FairQueue is set; zero caps nothing.
+	MaxInFlightPerTenant int
+}
+
+// ConfigureQueue sets the concurrency and queueing of the controller. Call it before
+// SetupWithManager.
+func (r *GenericController[D]) ConfigureQueue(opts QueueOptions) {
+	r.queue = opts
+}
+
+// tenantResolver returns the tenant of a request from the internal tenant label of the
+// reconciled resource, read from the cache. Namespaces are derived from the tenant, so the
+// answer is memoised per namespace; a request whose resource is gone, or unlabelled, is
+// accounted to its namespace.
+func (r *GenericController[D]) tenantResolver(c client.Reader) func(reconcile.Request) string {
+	var tenants sync.Map
+	return func(req reconcile.Request) string {
+		if tenant, ok := tenants.Load(req.Namespace); ok {
+			return tenant.(string)
+		}
+		obj := r.prototype.DeepCopyObject().(schemav1.ConditionedObject)
+		if err := c.Get(context.Background(), req.NamespacedName, obj); err != nil {
+			return req.Namespace
+		}
+		tenant := obj.GetLabels()[k8slabels.InternalTenantLabel]
+		if tenant == "" {
+			return req.Namespace
+		}
+		tenants.Store(req.Namespace, tenant)
+		return tenant
+	}
+}
+
+// fairQueue is a rate-limited work queue serving its items round-robin between tenants.
+// Each tenant has its own FIFO; Get takes the head of the next tenant in turn that is below
+// its in-flight cap. Like the client-go queue, an item is queued at most once, and an item
+// added while it is processed is queued again when it is done.
+type fairQueue struct {
+	name        string
+	tenantOf    func(reconcile.Request) string
+	maxInFlight int
+	limiter     workqueue.TypedRateLimiter[reconcile.Request]
+
+	mu   sync.Mutex
+	cond *sync.Cond
+	// tenants lists the tenants with queued items, in serving order; next indexes the
+	// tenant served next.
+	tenants []string
+	next    int
+	queues  map[string][]reconcile.Request
+	// dirty holds the items waiting to be processed, processing the items being processed;
+	// an item can be in both.
+	dirty      map[reconcile.Request]queued
+	processing map[reconcile.Request]string
+	inFlight   map[string]int
+	waiting    map[reconcile.Request]*delayed
+
+	shuttingDown bool
+	drain        bool
+}
+
+// queued records the tenant of a dirty item and when it was added.
+type queued struct {
+	tenant string
+	since  time.Time
+}
+
+// delayed is an item added with a delay, pending its timer.
+type delayed struct {
+	at    time.Time
+	timer *time.Timer
+}
+
+var _ workqueue.TypedRateLimitingInterface[reconcile.Request] = (*fairQueue)(nil)
+
+// newFairQueue returns a fair queue named after the controller it feeds. maxInFlight caps
+// the items of a tenant processed at once; zero caps nothing.
+func newFairQueue(
+	name string,
+	tenantOf func(reconcile.Request) string,
+	maxInFlight int,
+	limiter workqueue.TypedRateLimiter[reconcile.Request],
+) *fairQueue {
+	q := &fairQueue{
+		name:        name,
+		tenantOf:    tenantOf,
+		maxInFlight: maxInFlight,
+		limiter:     limiter,
+		queues:      map[string][]reconcile.Request{},
+		dirty:       map[reconcile.Request]queued{},
+		processing:  map[reconcile.Request]string{},
+		inFlight:    map[string]int{},
+		waiting:     map[reconcile.Request]*delayed{},
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// Add queues item behind the other items of its tenant, unless it is queued already.
+func (q *fairQueue) Add(item reconcile.Request) {
+	tenant := q.tenantOf(item)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	if _, ok := q.dirty[item]; ok {
+		return
+	}
+	q.dirty[item] = queued{tenant: tenant, since: time.Now()}
+	if _, ok := q.processing[item]; ok {
+		return
+	}
+	q.push(tenant, item)
+}
+
+// push appends item to the FIFO of tenant, putting the tenant in turn if it had none queued.
+func (q *fairQueue) push(tenant string, item reconcile.Request) {
+	if len(q.queues[tenant]) == 0 {
+		// Join the ring just before the tenant served next, i.e. last in the current round.
+		q.tenants = append(q.tenants, "")
+		copy(q.tenants[q.next+1:], q.tenants[q.next:])
+		q.tenants[q.next] = tenant
+		q.next = (q.next + 1) % len(q.tenants)
+	}
+	q.queues[tenant] = append(q.queues[tenant], item)
+	queueDepth.WithLabelValues(q.name, tenant).Inc()
+	q.cond.Signal()
+}
+
+// Len returns the number of queued items.
+func (q *fairQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := 0
+	for _, items := range q.queues {
+		n += len(items)
+	}
+	return n
+}
+
+// Get blocks until an item of a tenant below its in-flight cap is queued and returns it, or
+// returns shutdown once the queue is shut down and holds nothing it can serve.
+func (q *fairQueue) Get() (item reconcile.Request, shutdown bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		if i, ok := q.eligible(); ok {
+			return q.pop(i), false
+		}
+		if q.shuttingDown {
+			return reconcile.Request{}, true
+		}
+		q.cond.Wait()
+	}
+}
+
+// eligible returns the index in tenants of the tenant to serve, the first in turn below its
+// in-flight cap.
+func (q *fairQueue) eligible() (int, bool) {
+	for n := range len(q.tenants) {
+		i := (q.next + n) % len(q.tenants)
+		if q.maxInFlight <= 0 || q.inFlight[q.tenants[i]] < q.maxInFlight {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// pop takes the head of the FIFO of tenants[i], marks it processing and hands the turn to
+// the tenant after it.
+func (q *fairQueue) pop(i int) reconcile.Request {
+	tenant := q.tenants[i]
+	item := q.queues[tenant][0]
+	q.queues[tenant] = q.queues[tenant][1:]
+	queueDepth.WithLabelValues(q.name, tenant).Dec()
+
+	if len(q.queues[tenant]) == 0 {
+		delete(q.queues, tenant)
+		q.tenants = append(q.tenants[:i], q.tenants[i+1:]...)
+		q.next = i
+	} else {
+		q.next = i + 1
+	}
+	if len(q.tenants) > 0 {
+		q.next %= len(q.tenants)
+	} else {
+		q.next = 0
+	}
+
+	queueWait.WithLabelValues(q.name, tenant).Observe(time.Since(q.dirty[item].since).Seconds())
+	delete(q.dirty, item)
+	q.processing[item] = tenant
+	q.inFlight[tenant]++
+	inFlight.WithLabelValues(q.name, tenant).Inc()
+	return item
+}
+
+// Done marks item processed, queueing it again if it was added meanwhile.
+func (q *fairQueue) Done(item reconcile.Request) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	tenant, ok := q.processing[item]
+	if !ok {
+		return
+	}
+	delete(q.processing, item)
+	if q.inFlight[tenant]--; q.inFlight[tenant] == 0 {
+		delete(q.inFlight, tenant)
+	}
+	inFlight.WithLabelValues(q.name, tenant).Dec()
+
+	if d, ok := q.dirty[item]; ok {
+		q.push(d.tenant, item)
+	}
+	// A slot of the tenant freed up, and a draining shutdown may be waiting on it.
+	q.cond.Broadcast()
+}
+
+// ShutDown makes the queue refuse new items, and Get return shutdown once it holds nothing
+// it can serve.
+func (q *fairQueue) ShutDown() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.drain = false
+	q.shutDown()
+}
+
+// ShutDownWithDrain shuts the queue down and waits until the items being processed are
+// done, or ShutDown is called.
+func (q *fairQueue) ShutDownWithDrain() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.drain = true
+	q.shutDown()
+	for q.drain && len(q.processing) > 0 {
+		q.cond.Wait()
+	}
+}
+
+func (q *fairQueue) shutDown() {
+	q.shuttingDown = true
+	for item, d := range q.waiting {
+		d.timer.Stop()
+		delete(q.waiting, item)
+	}
+	q.cond.Broadcast()
+}
+
+// ShuttingDown reports whether the queue is shutting down.
+func (q *fairQueue) ShuttingDown() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.shuttingDown
+}
+
+// AddAfter adds item once duration has passed. An item waiting already keeps the earlier of
+// its two deadlines.
+func (q *fairQueue) AddAfter(item reconcile.Request, duration time.Duration) {
+	if duration <= 0 {
+		q.Add(item)
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	at := time.Now().Add(duration)
+	if d, ok := q.waiting[item]; ok {
+		if !d.at.After(at) {
+			return
+		}
+		d.timer.Stop()
+	}
+	d := &delayed{at: at}
+	d.timer = time.AfterFunc(duration, func() {
+		q.mu.Lock()
+		if q.waiting[item] != d {
+			q.mu.Unlock()
+			return
+		}
+		delete(q.waiting, item)
+		q.mu.Unlock()
+		q.Add(item)
+	})
+	q.waiting[item] = d
+}
+
+// AddRateLimited adds item once the rate limiter allows it.
+func (q *fairQueue) AddRateLimited(item reconcile.Request) {
+	q.AddAfter(item, q.limiter.When(item))
+}
+
+// Forget makes the rate limiter forget the failures of item.
+func (q *fairQueue) Forget(item reconcile.Request) {
+	q.limiter.Forget(item)
+}
+
+// NumRequeues returns the failures of item the rate limiter counts.
+func (q *fairQueue) NumRequeues(item reconcile.Request) int {
+	return q.limiter.NumRequeues(item)
+}
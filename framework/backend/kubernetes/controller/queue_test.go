@@ -0,0 +1,142 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// request returns a request whose namespace names its tenant.
+func request(tenant, name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: tenant, Name: name}}
+}
+
+func newTestQueue(maxInFlight int) *fairQueue {
+	return newFairQueue("test", func(req reconcile.Request) string { return req.Namespace }, maxInFlight,
+		workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+}
+
+func get(t *testing.T, q *fairQueue) reconcile.Request {
+	t.Helper()
+	item, shutdown := q.Get()
+	require.False(t, shutdown)
+	return item
+}
+
+func TestFairQueue_RoundRobin(t *testing.T) {
+	q := newTestQueue(0)
+	for _, name := range []string{"v1", "v2", "v3"} {
+		q.Add(request("bulk", name))
+	}
+	q.Add(request("small", "v1"))
+	q.Add(request("other", "v1"))
+
+	var got []reconcile.Request
+	for range 5 {
+		got = append(got, get(t, q))
+	}
+
+	assert.Equal(t, []reconcile.Request{
+		request("bulk", "v1"),
+		request("small", "v1"),
+		request("other", "v1"),
+		request("bulk", "v2"),
+		request("bulk", "v3"),
+	}, got)
+	assert.Zero(t, q.Len())
+}
+
+func TestFairQueue_NewTenantJoinsLast(t *testing.T) {
+	q := newTestQueue(0)
+	q.Add(request("a", "1"))
+	q.Add(request("a", "2"))
+	q.Add(request("b", "1"))
+
+	assert.Equal(t, request("a", "1"), get(t, q))
+	q.Add(request("c", "1"))
+
+	assert.Equal(t, request("b", "1"), get(t, q))
+	assert.Equal(t, request("a", "2"), get(t, q))
+	assert.Equal(t, request("c", "1"), get(t, q))
+}
+
+func TestFairQueue_Dedupe(t *testing.T) {
+	q := newTestQueue(0)
+	q.Add(request("a", "1"))
+	q.Add(request("a", "1"))
+	require.Equal(t, 1, q.Len())
+
+	item := get(t, q)
+	// Added while processing: queued again once done, not before.
+	q.Add(item)
+	assert.Zero(t, q.Len())
+
+	q.Done(item)
+	assert.Equal(t, 1, q.Len())
+	assert.Equal(t, item, get(t, q))
+}
+
+func TestFairQueue_MaxInFlightPerTenant(t *testing.T) {
+	q := newTestQueue(1)
+	q.Add(request("a", "1"))
+	q.Add(request("a", "2"))
+
+	first := get(t, q)
+
+	got := make(chan reconcile.Request)
+	go func() {
+		item, _ := q.Get()
+		got <- item
+	}()
+
+	select {
+	case item := <-got:
+		t.Fatalf("got %v while the tenant is at its cap", item)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	q.Done(first)
+	select {
+	case item := <-got:
+		assert.Equal(t, request("a", "2"), item)
+	case <-time.After(time.Second):
+		t.Fatal("capped item not served once a slot freed up")
+	}
+}
+
+func TestFairQueue_CappedTenantDoesNotBlockOthers(t *testing.T) {
+	q := newTestQueue(1)
+	q.Add(request("a", "1"))
+	q.Add(request("a", "2"))
+	q.Add(request("b", "1"))
+
+	assert.Equal(t, request("a", "1"), get(t, q))
+	assert.Equal(t, request("b", "1"), get(t, q))
+}
+
+func TestFairQueue_AddAfter(t *testing.T) {
+	q := newTestQueue(0)
+	q.AddAfter(request("a", "1"), time.Hour)
+	q.AddAfter(request("a", "1"), 10*time.Millisecond)
+
+	assert.Equal(t, request("a", "1"), get(t, q))
+	assert.Empty(t, q.waiting)
+}
+
+func TestFairQueue_ShutDown(t *testing.T) {
+	q := newTestQueue(0)
+	q.Add(request("a", "1"))
+	q.ShutDown()
+
+	q.Add(request("a", "2"))
+	assert.True(t, q.ShuttingDown())
+	assert.Equal(t, request("a", "1"), get(t, q))
+
+	_, shutdown := q.Get()
+	assert.True(t, shutdown)
+}
@@ -0,0 +1,245 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/refgraph"
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+)
+
+// referenceIndex is the cache field index mapping a resource to the resources it references,
+// each as a refgraph.Key string.
+const referenceIndex = k8slabels.InternalLabelPrefix + "references"
+
+// WatchReferences declares the reference fields of the reconciled resource, so that the
+// controller reconciles the resource again as soon as a resource it references changes
+// state, instead of waiting for its next requeue. Call it before SetupWithManager.
+func (r *GenericController[D]) WatchReferences(refs ...refgraph.Reference) {
+	r.references = append(r.references, refs...)
+}
+
+// BlockReferencedDeletion makes the controller hold the deletion of a resource while graph
+// finds resources referencing it: the resource keeps its state, and its finalizer, with a
+// DeletionBlocked condition naming them, and proceeds once they are gone. A nil graph blocks
+// nothing. SetupWithManager adds graph to the manager, which starts its informers. Call it
+// before SetupWithManager.
+func (r *GenericController[D]) BlockReferencedDeletion(graph *refgraph.Graph) {
+	r.graph = graph
+}
+
+// watchReferences indexes the reconciled resources by the resources they reference, and
+// watches every referenced kind: a referenced resource created, deleted or changing state
+// enqueues the resources referencing it, found through the index.
+func (r *GenericController[D]) watchReferences(mgr ctrl.Manager, b *ctrlbuilder.Builder, gvk schema.GroupVersionKind) error {
+	refs := r.references
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), r.prototype, referenceIndex, func(obj client.Object) []string {
+		keys := refgraph.Keys(obj, refs)
+		values := make([]string, len(keys))
+		for i, key := range keys {
+			values[i] = key.String()
+		}
+		return values
+	}); err != nil {
+		return fmt.Errorf("failed to index references: %w", err)
+	}
+
+	scheme := mgr.GetScheme()
+	listGVK := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+	if !scheme.Recognizes(listGVK) {
+		return fmt.Errorf("%s is not registered in the scheme", listGVK)
+	}
+
+	watched := map[schema.GroupVersionKind]bool{}
+	for _, ref := range refs {
+		for _, target := range ref.Targets {
+			if watched[target.GVK] {
+				continue
+			}
+			watched[target.GVK] = true
+			b.Watches(
+				newObject(scheme, target.GVK),
+				handler.EnqueueRequestsFromMapFunc(r.enqueueReferrers(scheme, listGVK, target)),
+				ctrlbuilder.WithPredicates(stateChanged),
+			)
+		}
+	}
+	return nil
+}
+
+// enqueueReferrers maps a resource of the target kind to the resources referencing it, by
+// name or, through an AnyInWorkspace reference, by its workspace.
+func (r *GenericController[D]) enqueueReferrers(scheme *runtime.Scheme, listGVK schema.GroupVersionKind, target refgraph.Target) handler.MapFunc {
+	anyInWorkspace := slices.ContainsFunc(r.references, func(ref refgraph.Reference) bool {
+		return ref.Enclosing == refgraph.AnyInWorkspace && slices.Contains(ref.Targets, target)
+	})
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		key := refgraph.KeyOf(target.Collection, obj)
+		keys := []string{key.String()}
+		if anyInWorkspace {
+			keys = append(keys, key.AnyInWorkspace().String())
+		}
+
+		var requests []reconcile.Request
+		for _, key := range keys {
+			list, err := scheme.New(listGVK)
+			if err != nil {
+				r.logger.Error("failed to create referrer list", "kind", listGVK, "error", err)
+				return nil
+			}
+			if err := r.client.List(ctx, list.(client.ObjectList), client.MatchingFields{referenceIndex: key}); err != nil {
+				r.logger.Error("failed to list referrers", "reference", key, "error", err)
+				return nil
+			}
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				return nil
+			}
+			for _, item := range items {
+				if o, ok := item.(client.Object); ok {
+					requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+				}
+			}
+		}
+		return requests
+	}
+}
+
+// watchReferrers watches the kinds referencing the reconciled one: a referrer going away,
+// changing state or dropping a reference enqueues the resources it references that are
+// being deleted, whose deletion it may have been holding. Its other updates, such as a new
+// condition, enqueue nothing.
+//
+// The graph is added to the manager by every controller sharing it; starting its informers
+// again is a no-op.
+func (r *GenericController[D]) watchReferrers(mgr ctrl.Manager, b *ctrlbuilder.Builder, gvk schema.GroupVersionKind) error {
+	target, ok := r.graph.Target(gvk)
+	if !ok {
+		return nil
+	}
+	if err := mgr.Add(r.graph); err != nil {
+		return fmt.Errorf("failed to add reference graph: %w", err)
+	}
+	r.collection = target.Collection
+	for _, referrer := range r.graph.ReferrersOf(target.Collection) {
+		b.Watches(
+			newObject(mgr.GetScheme(), referrer.GVK()),
+			handler.EnqueueRequestsFromMapFunc(r.enqueueHeld(referrer)),
+			ctrlbuilder.WithPredicates(referrerChanged(referrer)),
+		)
+	}
+	return nil
+}
+
+// enqueueHeld maps a referrer to the resources of the reconciled kind it references and
+// that are being deleted.
+func (r *GenericController[D]) enqueueHeld(referrer refgraph.Referrer) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		var requests []reconcile.Request
+		for _, key := range refgraph.Keys(obj, referrer.References) {
+			if key.Collection != r.collection {
+				continue
+			}
+			name := types.NamespacedName{Namespace: key.Namespace(), Name: key.Name}
+			held := r.prototype.DeepCopyObject().(schemav1.ConditionedObject)
+			if err := r.client.Get(ctx, name, held); err != nil || held.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+			requests = append(requests, reconcile.Request{NamespacedName: name})
+		}
+		return requests
+	}
+}
+
+// blockReferencedDeletion reports whether the deletion of obj, requested but not started,
+// is held by resources referencing it, recording a DeletionBlocked condition naming them.
+func (r *GenericController[D]) blockReferencedDeletion(ctx context.Context, obj schemav1.ConditionedObject) (bool, error) {
+	if r.graph == nil || r.collection == "" || obj.GetDeletionTimestamp().IsZero() || getStateFromObject(obj) == stateDeleting {
+		return false, nil
+	}
+
+	referrers, err := r.graph.ReferrersTo(ctx, refgraph.KeyOf(r.collection, obj))
+	if err != nil {
+		return false, err
+	}
+	if len(referrers) == 0 {
+		return false, nil
+	}
+
+	c := schemav1.StatusCondition{
+		State:            schemav1.ResourceState(getStateFromObject(obj)),
+		Type:             "DeletionBlocked",
+		Reason:           "ReferencedByDependent",
+		Message:          "deletion blocked: still referenced by " + strings.Join(referrers, ", "),
+		LastTransitionAt: metav1.Now(),
+	}
+	if prev := obj.PeekConditions(); prev != nil && schemav1.EqualConditions(*prev, c) {
+		return true, nil
+	}
+	obj.PushCondition(c)
+	for r.maxStatusConditions > 0 && obj.LenConditions() > r.maxStatusConditions {
+		obj.PopCondition()
+	}
+	return true, client.IgnoreNotFound(r.client.Status().Update(ctx, obj))
+}
+
+// stateChanged passes creations, deletions, and updates changing status.state: the events
+// that can unblock, or block, a resource waiting on a dependency.
+var stateChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return getStateFromObject(e.ObjectOld) != getStateFromObject(e.ObjectNew)
+	},
+}
+
+// referrerChanged passes creations, deletions, and the updates of a resource of kind
+// referrer that can release a deletion it holds: a change of status.state, the start of its
+// own deletion, or a change of the resources it references.
+func referrerChanged(referrer refgraph.Referrer) predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if getStateFromObject(e.ObjectOld) != getStateFromObject(e.ObjectNew) {
+				return true
+			}
+			if e.ObjectOld.GetDeletionTimestamp().IsZero() != e.ObjectNew.GetDeletionTimestamp().IsZero() {
+				return true
+			}
+			return !slices.Equal(refgraph.Keys(e.ObjectOld, referrer.References), refgraph.Keys(e.ObjectNew, referrer.References))
+		},
+	}
+}
+
+// newObject returns an empty object of kind gvk, typed when the scheme knows the kind so that
+// it shares the informer of the controllers reconciling it.
+func newObject(scheme *runtime.Scheme, gvk schema.GroupVersionKind) client.Object {
+	if obj, err := scheme.New(gvk); err == nil {
+		if o, ok := obj.(client.Object); ok {
+			return o
+		}
+	}
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	return u
+}
+
+// prototypeGVK returns the GroupVersionKind of the reconciled resource.
+func (r *GenericController[D]) prototypeGVK(mgr ctrl.Manager) (schema.GroupVersionKind, error) {
+	return apiutil.GVKForObject(r.prototype, mgr.GetScheme())
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/refgraph"
+)
+
+var imageReferrer = refgraph.Referrer{
+	Kind:       "BlockStorage",
+	Collection: "block-storages",
+	References: []refgraph.Reference{{
+		Path: []string{"spec", "sourceImageRef"},
+		Targets: []refgraph.Target{{
+			GVK:        schema.GroupVersionKind{Group: "storage.v1.secapi.cloud", Version: "v1", Kind: "Image"},
+			Collection: "images",
+		}},
+	}},
+}
+
+func newReferrerObject(image string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "storage.v1.secapi.cloud/v1",
+		"kind":       "BlockStorage",
+		"metadata":   map[string]any{"namespace": "ns", "name": "bs-1"},
+		"spec":       map[string]any{"sourceImageRef": map[string]any{"resource": "images/" + image}},
+		"status":     map[string]any{"state": stateActive},
+	}}
+	obj.SetLabels(map[string]string{k8slabels.InternalTenantLabel: "t1"})
+	return obj
+}
+
+func TestReferrerChanged(t *testing.T) {
+	pred := referrerChanged(imageReferrer)
+	old := newReferrerObject("ubuntu")
+
+	conditioned := old.DeepCopy()
+	_ = unstructured.SetNestedSlice(conditioned.Object, []any{map[string]any{"type": "Ready"}}, "status", "conditions")
+	assert.False(t, pred.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: conditioned}),
+		"a condition-only update holds no deletion back any less")
+
+	deletingState := old.DeepCopy()
+	_ = unstructured.SetNestedField(deletingState.Object, stateDeleting, "status", "state")
+	assert.True(t, pred.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: deletingState}))
+
+	deleting := old.DeepCopy()
+	now := metav1.Now()
+	deleting.SetDeletionTimestamp(&now)
+	assert.True(t, pred.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: deleting}))
+
+	assert.True(t, pred.Update(event.UpdateEvent{ObjectOld: old, ObjectNew: newReferrerObject("debian")}),
+		"a dropped reference may release the image it pointed to")
+	assert.True(t, pred.Delete(event.DeleteEvent{Object: old}))
+}
@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"context"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/shard"
+)
+
+// Shard makes the controller reconcile only the resources of the tenants s assigns to this
+// replica, and every resource of a shard as soon as the replica takes the shard over. A nil
+// s reconciles every tenant. Call it before SetupWithManager.
+func (r *GenericController[D]) Shard(s *shard.Sharder) {
+	r.sharder = s
+}
+
+// owned reports whether this replica reconciles obj.
+func (r *GenericController[D]) owned(obj client.Object) bool {
+	return r.sharder == nil || r.sharder.Owns(obj.GetLabels()[k8slabels.InternalTenantLabel])
+}
+
+// forOptions filters the events of the reconciled kind down to the resources this replica
+// owns.
+func (r *GenericController[D]) forOptions() []ctrlbuilder.ForOption {
+	if r.sharder == nil {
+		return nil
+	}
+	return []ctrlbuilder.ForOption{ctrlbuilder.WithPredicates(predicate.NewPredicateFuncs(r.owned))}
+}
+
+// watchShards enqueues every resource of the reconciled kind in the shards the replica takes
+// over: their events, dropped while another replica held them, are not replayed.
+func (r *GenericController[D]) watchShards(mgr ctrl.Manager, b *ctrlbuilder.Builder, gvk schema.GroupVersionKind) {
+	events := make(chan event.GenericEvent)
+	b.WatchesRawSource(source.Channel(events, &handler.EnqueueRequestForObject{}))
+
+	listGVK := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+	r.sharder.OnAcquire(func(ctx context.Context, shards []int) {
+		r.enqueueShards(ctx, mgr.GetScheme(), listGVK, shards, events)
+	})
+}
+
+// enqueueShards sends every resource of the listGVK list in shards to events.
+func (r *GenericController[D]) enqueueShards(ctx context.Context, scheme *runtime.Scheme, listGVK schema.GroupVersionKind, shards []int, events chan<- event.GenericEvent) {
+	list, err := scheme.New(listGVK)
+	if err != nil {
+		r.logger.Error("failed to create shard list", "kind", listGVK, "error", err)
+		return
+	}
+	if err := r.client.List(ctx, list.(client.ObjectList)); err != nil {
+		r.logger.Error("failed to list the resources of the shards taken over", "shards", shards, "error", err)
+		return
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return
+	}
+
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok || !slices.Contains(shards, r.sharder.ShardOf(obj.GetLabels()[k8slabels.InternalTenantLabel])) {
+			continue
+		}
+		select {
+		case events <- event.GenericEvent{Object: obj}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+)
+
+// eventGVR is the core Event resource the controllers record events as.
+var eventGVR = schema.GroupVersionResource{Version: "v1", Resource: "events"}
+
+// EventReaderAdapter implements backendport.EventReader over the Kubernetes Events the controllers
+// record on the resources they reconcile.
+type EventReaderAdapter struct {
+	client dynamic.Interface
+}
+
+var _ backendport.EventReader = (*EventReaderAdapter)(nil)
+
+// NewEventReaderAdapter creates an EventReaderAdapter reading through client.
+func NewEventReaderAdapter(client dynamic.Interface) *EventReaderAdapter {
+	return &EventReaderAdapter{client: client}
+}
+
+// Events implements backendport.EventReader. kind is the Kubernetes kind of the resource, which is
+// its SECA kind.
+func (a *EventReaderAdapter) Events(ctx context.Context, kind string, ir persistence.IdentifiableResource) ([]backendport.Event, error) {
+	namespace, err := resolveNamespace(ir)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := fields.Set{"involvedObject.kind": kind, "involvedObject.name": ir.GetName()}.String()
+	list, err := a.client.Resource(eventGVR).Namespace(namespace).List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil, kubeToDomainError(fmt.Errorf("failed to list the events of %s '%s': %w", kind, ir.GetName(), err))
+	}
+
+	events := make([]backendport.Event, 0, len(list.Items))
+	for i := range list.Items {
+		var e corev1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(list.Items[i].Object, &e); err != nil {
+			return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("failed to convert event %s: %w", list.Items[i].GetName(), err))
+		}
+		events = append(events, eventFromK8s(&e))
+	}
+	slices.SortStableFunc(events, func(a, b backendport.Event) int {
+		return a.LastAt.Compare(b.LastAt)
+	})
+
+	return events, nil
+}
+
+// eventFromK8s converts e. An event recorded through the events.k8s.io API carries its
+// occurrence in EventTime and its repeats in Series rather than the legacy fields.
+func eventFromK8s(e *corev1.Event) backendport.Event {
+	event := backendport.Event{
+		Warning: e.Type == corev1.EventTypeWarning,
+		Reason:  e.Reason,
+		Message: e.Message,
+		State:   e.Annotations[labels.EventStateAnnotation],
+		Count:   int(e.Count),
+		FirstAt: e.FirstTimestamp.Time,
+		LastAt:  e.LastTimestamp.Time,
+	}
+	if event.FirstAt.IsZero() {
+		event.FirstAt = e.EventTime.Time
+	}
+	if event.LastAt.IsZero() {
+		event.LastAt = event.FirstAt
+		if e.Series != nil {
+			event.LastAt = e.Series.LastObservedTime.Time
+		}
+	}
+	if event.Count == 0 {
+		event.Count = 1
+		if e.Series != nil {
+			event.Count = int(e.Series.Count)
+		}
+	}
+	return event
+}
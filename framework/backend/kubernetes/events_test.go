@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+)
+
+func newTestEvent(t *testing.T, namespace, name string, e corev1.Event) *unstructured.Unstructured {
+	t.Helper()
+	e.ObjectMeta.Namespace = namespace
+	e.ObjectMeta.Name = name
+	e.InvolvedObject = corev1.ObjectReference{Kind: "RouteTable", Namespace: namespace, Name: "rt-1"}
+	raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&e)
+	require.NoError(t, err)
+	u := &unstructured.Unstructured{Object: raw}
+	u.SetAPIVersion("v1")
+	u.SetKind("Event")
+	return u
+}
+
+// TestEventReaderAdapter_Events pins the conversion of the events recorded on a resource, read
+// from the namespace of its scope, oldest first.
+func TestEventReaderAdapter_Events(t *testing.T) {
+	namespace := ComputeNamespace(&kernelresource.Scope{Tenant: "t1", Workspace: "w1"})
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	failed := created.Add(time.Minute)
+
+	dynFake := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{eventGVR: "EventList"},
+		newTestEvent(t, namespace, "rt-1.failed", corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Annotations: map[string]string{labels.EventStateAnnotation: "error"}},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "ReconcileError",
+			Message:        "quota exceeded",
+			Count:          3,
+			FirstTimestamp: metav1.NewTime(failed),
+			LastTimestamp:  metav1.NewTime(failed.Add(time.Minute)),
+		}),
+		newTestEvent(t, namespace, "rt-1.creating", corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{labels.EventStateAnnotation: "creating"}},
+			Type:       corev1.EventTypeNormal,
+			Reason:     "Creating",
+			Message:    "Resource is being created.",
+			EventTime:  metav1.NewMicroTime(created),
+		}),
+	)
+
+	events, err := NewEventReaderAdapter(dynFake).Events(context.Background(), "RouteTable", &testLabelled{name: "rt-1"})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	require.Equal(t, "Creating", events[0].Reason)
+	require.False(t, events[0].Warning)
+	require.Equal(t, "creating", events[0].State)
+	require.Equal(t, 1, events[0].Count, "an event recorded once has no count")
+	require.True(t, events[0].FirstAt.Equal(created))
+	require.True(t, events[0].LastAt.Equal(created))
+
+	require.Equal(t, "ReconcileError", events[1].Reason)
+	require.True(t, events[1].Warning)
+	require.Equal(t, "error", events[1].State)
+	require.Equal(t, 3, events[1].Count)
+	require.True(t, events[1].LastAt.Equal(failed.Add(time.Minute)))
+}
@@ -9,4 +9,45 @@ const (
 	InternalTenantLabel    = InternalLabelPrefix + "tenant"
 	InternalWorkspaceLabel = InternalLabelPrefix + "workspace"
 	InternalNetworkLabel   = InternalLabelPrefix + "network"
+
+	// InternalSystemManagedLabel marks objects the control plane creates and owns (e.g.
+	// built-in tenant roles); its value is "true".
+	InternalSystemManagedLabel = InternalLabelPrefix + "system-managed"
+)
+
+const (
+	// BackendPluginAnnotation names the CSP plugin reconciling a resource.
+	BackendPluginAnnotation = InternalLabelPrefix + "backend-plugin"
+	// BackendRefsAnnotation lists, as a JSON array of backend.BackendRef, the provider-side
+	// objects backing a resource.
+	//
+	// Both are recorded by the plugin's controller, never by the gateway, and the writer
+	// adapter preserves them when the gateway updates the resource.
+	BackendRefsAnnotation = InternalLabelPrefix + "backend-refs"
 )
+
+const (
+	// ObservedGenerationAnnotation is the metadata.generation of a resource whose spec the plugin
+	// last applied successfully. It stands in for the status.observedGeneration of the Kubernetes
+	// conventions, which the SECA status schema does not have: tooling waiting on that field must
+	// read this annotation instead.
+	ObservedGenerationAnnotation = InternalLabelPrefix + "observed-generation"
+	// AppliedSpecHashAnnotation is a hash of the spec, commonData, user labels and sealed spec
+	// (see SealedSpecAnnotation) of a resource the plugin last applied successfully. The
+	// controller skips the plugin's Update while it still matches.
+	AppliedSpecHashAnnotation = InternalLabelPrefix + "applied-spec-hash"
+	// AppliedAtAnnotation is when, in RFC 3339, the plugin last applied the spec successfully.
+	// The drift check interval counts from it.
+	//
+	// Like the backend annotations, the three are recorded by the plugin's controller and
+	// preserved by the writer adapter. The SECA schema the status of every resource is generated
+	// from has no room for them.
+	AppliedAtAnnotation = InternalLabelPrefix + "applied-at"
+	// SealedSpecAnnotation carries the sensitive spec fields of a resource, envelope-encrypted,
+	// in place of the spec fields themselves. It is part of the spec handed to the plugin.
+	SealedSpecAnnotation = InternalLabelPrefix + "sealed-spec"
+)
+
+// EventStateAnnotation is set on the Kubernetes Events a controller records on a resource, to
+// the state of the resource the event left it in.
+const EventStateAnnotation = InternalLabelPrefix + "state"
@@ -0,0 +1,37 @@
+package kubernetes
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// The plugin handlers register their metrics on the controller-runtime registry, so that the
+// manager's metrics endpoint serves them.
+//
+//   - ecp_plugin_operation_duration_seconds{plugin,operation} — time a CSP plugin took to
+//     return from a delegated operation, whether it succeeded or not.
+//   - ecp_plugin_operation_errors_total{plugin,operation} — delegated operations the plugin
+//     failed. An operation still in progress (backendport.ErrStillProcessing) is not a failure.
+//   - ecp_plugin_operation_timeouts_total{plugin,operation} — delegated operations cut off
+//     at their deadline.
+var (
+	pluginDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ecp_plugin_operation_duration_seconds",
+		Help:    "Time a CSP plugin took to return from a delegated operation.",
+		Buckets: prometheus.ExponentialBuckets(1e-2, 2, 16),
+	}, []string{"plugin", "operation"})
+
+	pluginErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ecp_plugin_operation_errors_total",
+		Help: "Operations delegated to a CSP plugin that failed.",
+	}, []string{"plugin", "operation"})
+
+	pluginTimeouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ecp_plugin_operation_timeouts_total",
+		Help: "Operations delegated to a CSP plugin cut off at their deadline.",
+	}, []string{"plugin", "operation"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(pluginDuration, pluginErrors, pluginTimeouts)
+}
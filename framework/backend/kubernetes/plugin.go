@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
@@ -14,6 +17,12 @@ import (
 type GenericPluginHandler[T persistence.IdentifiableResource] struct {
 	rejectionConditions []backendport.RejectionConditionFunc[T]
 	MaxConditions       int
+	// MaxAttempts bounds the attempts of a failing operation before the resource is left in
+	// error for a manual retry. Zero or less retries forever.
+	MaxAttempts int
+	plugin      string
+	timeouts    backendport.Timeouts
+	backoff     *timeoutBackoff
 }
 
 // NewGenericPluginHandler creates a new GenericPluginHandler with the provided rejection conditions.
@@ -48,6 +57,138 @@ func (h *GenericPluginHandler[T]) HandleAdmission(ctx context.Context, resource
 	return nil
 }
 
+// SetTimeouts bounds the operations of plugin by timeouts. An operation cut off at its
+// deadline fails with backendport.ErrTimeout, and is retried with backoff.
+func (h *GenericPluginHandler[T]) SetTimeouts(plugin string, timeouts backendport.Timeouts) {
+	h.plugin = plugin
+	h.timeouts = timeouts
+	h.backoff = &timeoutBackoff{attempts: map[string]timeoutAttempt{}, abandoned: map[string]bool{}}
+}
+
+// Delegate returns fn bounded by the timeout set for op, if any. The context fn receives is
+// cancelled at the deadline, and the call returns then even if fn does not: a plugin must not
+// touch the resource once its context is done. A resource whose op timed out is not handed
+// to fn again before its backoff elapses, nor while the call cut off has not returned yet;
+// meanwhile the call returns ErrStillProcessing.
+//
+// Every call of fn is timed, and its failure counted, under op.
+func (h *GenericPluginHandler[T]) Delegate(op backendport.Operation, fn backendport.DelegatedFunc[T]) backendport.DelegatedFunc[T] {
+	fn = h.measured(op, fn)
+	timeout := h.timeouts[op]
+	if timeout <= 0 {
+		return fn
+	}
+	return func(ctx context.Context, resource T) error {
+		key := timeoutKey(op, resource)
+		if h.backoff.waiting(key) {
+			return backendport.ErrStillProcessing
+		}
+
+		opCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		done := make(chan error, 1)
+		go func() { done <- fn(opCtx, resource) }()
+
+		var err error
+		select {
+		case err = <-done:
+		case <-opCtx.Done():
+			err = opCtx.Err()
+			h.backoff.abandon(key, done)
+		}
+		if ctx.Err() == nil && errors.Is(opCtx.Err(), context.DeadlineExceeded) {
+			pluginTimeouts.WithLabelValues(h.plugin, string(op)).Inc()
+			h.backoff.failed(key)
+			return fmt.Errorf("%w: %s did not complete within %s", backendport.ErrTimeout, op, timeout)
+		}
+		h.backoff.forget(key)
+		return err
+	}
+}
+
+// measured returns fn observing how long each call takes and whether it fails. A call cut
+// off at its deadline is observed once fn returns, so that the plugin's latency is not
+// capped at the timeout.
+func (h *GenericPluginHandler[T]) measured(op backendport.Operation, fn backendport.DelegatedFunc[T]) backendport.DelegatedFunc[T] {
+	return func(ctx context.Context, resource T) error {
+		start := time.Now()
+		err := fn(ctx, resource)
+		pluginDuration.WithLabelValues(h.plugin, string(op)).Observe(time.Since(start).Seconds())
+		if err != nil && !errors.Is(err, backendport.ErrStillProcessing) {
+			pluginErrors.WithLabelValues(h.plugin, string(op)).Inc()
+		}
+		return err
+	}
+}
+
+// Timeout backoff bounds: a resource waits timeoutBackoffBase after its first timed-out
+// attempt, twice as long after each further one, and at most timeoutBackoffMax.
+const (
+	timeoutBackoffBase = 5 * time.Second
+	timeoutBackoffMax  = 5 * time.Minute
+)
+
+// timeoutBackoff tracks the consecutive timed-out attempts of each resource operation, and
+// the attempts cut off that have not returned yet.
+type timeoutBackoff struct {
+	mu        sync.Mutex
+	attempts  map[string]timeoutAttempt
+	abandoned map[string]bool
+}
+
+type timeoutAttempt struct {
+	count int
+	until time.Time
+}
+
+func (b *timeoutBackoff) waiting(key string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.abandoned[key] || time.Now().Before(b.attempts[key].until)
+}
+
+// abandon records that the attempt of key, returning on done, was cut off before it
+// returned, and forgets it once it does.
+func (b *timeoutBackoff) abandon(key string, done <-chan error) {
+	b.mu.Lock()
+	b.abandoned[key] = true
+	b.mu.Unlock()
+	go func() {
+		<-done
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.abandoned, key)
+	}()
+}
+
+func (b *timeoutBackoff) failed(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	a := b.attempts[key]
+	a.count++
+	delay := timeoutBackoffMax
+	if a.count <= 7 {
+		delay = min(timeoutBackoffBase<<(a.count-1), timeoutBackoffMax)
+	}
+	a.until = time.Now().Add(delay)
+	b.attempts[key] = a
+}
+
+func (b *timeoutBackoff) forget(key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.attempts, key)
+}
+
+// timeoutKey identifies the operation op on resource.
+func timeoutKey[T persistence.IdentifiableResource](op backendport.Operation, resource T) string {
+	parts := []string{string(op), resource.GetTenant(), resource.GetWorkspace()}
+	if n, ok := any(resource).(persistence.NetworkScope); ok {
+		parts = append(parts, n.GetNetwork())
+	}
+	return strings.Join(append(parts, resource.GetName()), "/")
+}
+
 // BypassDelegated is a no-op DelegatedFunc for state transitions that need no external call.
 func BypassDelegated[T persistence.IdentifiableResource](_ context.Context, _ T) error {
 	return nil
@@ -0,0 +1,144 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+)
+
+func hang(ctx context.Context, _ *testNetworkIdentifiable) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func newTimedHandler(timeout time.Duration) *GenericPluginHandler[*testNetworkIdentifiable] {
+	h := &GenericPluginHandler[*testNetworkIdentifiable]{}
+	h.SetTimeouts("test", backendport.Timeouts{backendport.OperationCreate: timeout})
+	return h
+}
+
+func TestDelegate_NoTimeout(t *testing.T) {
+	h := &GenericPluginHandler[*testNetworkIdentifiable]{}
+	want := errors.New("boom")
+
+	err := h.Delegate(backendport.OperationCreate, func(context.Context, *testNetworkIdentifiable) error { return want })(
+		context.Background(), &testNetworkIdentifiable{name: "r"})
+
+	assert.ErrorIs(t, err, want)
+}
+
+func TestDelegate_TimesOut(t *testing.T) {
+	h := newTimedHandler(10 * time.Millisecond)
+	resource := &testNetworkIdentifiable{name: "r", tenant: "t", workspace: "w", network: "n"}
+
+	err := h.Delegate(backendport.OperationCreate, hang)(context.Background(), resource)
+	require.ErrorIs(t, err, backendport.ErrTimeout)
+
+	// Backing off: the plugin is not called again until the backoff elapses.
+	called := false
+	err = h.Delegate(backendport.OperationCreate, func(context.Context, *testNetworkIdentifiable) error {
+		called = true
+		return nil
+	})(context.Background(), resource)
+	assert.ErrorIs(t, err, backendport.ErrStillProcessing)
+	assert.False(t, called)
+
+	// Other resources and operations are not held back.
+	other := &testNetworkIdentifiable{name: "r", tenant: "t", workspace: "w", network: "other"}
+	assert.NoError(t, h.Delegate(backendport.OperationCreate, func(context.Context, *testNetworkIdentifiable) error { return nil })(
+		context.Background(), other))
+}
+
+func TestDelegate_ReturnsWithoutAPluginIgnoringItsContext(t *testing.T) {
+	h := newTimedHandler(10 * time.Millisecond)
+	release := make(chan struct{})
+	defer close(release)
+
+	start := time.Now()
+	err := h.Delegate(backendport.OperationCreate, func(context.Context, *testNetworkIdentifiable) error {
+		<-release
+		return nil
+	})(context.Background(), &testNetworkIdentifiable{name: "r"})
+
+	assert.ErrorIs(t, err, backendport.ErrTimeout)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestDelegate_WaitsForAPluginIgnoringItsContext(t *testing.T) {
+	h := newTimedHandler(10 * time.Millisecond)
+	resource := &testNetworkIdentifiable{name: "r"}
+	key := timeoutKey(backendport.OperationCreate, resource)
+	release := make(chan struct{})
+	returned := make(chan struct{})
+
+	err := h.Delegate(backendport.OperationCreate, func(context.Context, *testNetworkIdentifiable) error {
+		<-release
+		close(returned)
+		return nil
+	})(context.Background(), resource)
+	require.ErrorIs(t, err, backendport.ErrTimeout)
+
+	// The backoff elapses, but the first call still runs: the plugin is not called again.
+	h.backoff.mu.Lock()
+	h.backoff.attempts[key] = timeoutAttempt{count: 1, until: time.Now().Add(-time.Second)}
+	h.backoff.mu.Unlock()
+	called := false
+	second := h.Delegate(backendport.OperationCreate, func(context.Context, *testNetworkIdentifiable) error {
+		called = true
+		return nil
+	})
+	assert.ErrorIs(t, second(context.Background(), resource), backendport.ErrStillProcessing)
+	assert.False(t, called)
+
+	// Once the first call returns, the next attempt goes through.
+	close(release)
+	<-returned
+	assert.Eventually(t, func() bool { return second(context.Background(), resource) == nil }, time.Second, 10*time.Millisecond)
+	assert.True(t, called)
+}
+
+func TestDelegate_CancelledIsNotATimeout(t *testing.T) {
+	h := newTimedHandler(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := h.Delegate(backendport.OperationCreate, hang)(ctx, &testNetworkIdentifiable{name: "r"})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, backendport.ErrTimeout)
+}
+
+func TestDelegate_CountsFailures(t *testing.T) {
+	h := &GenericPluginHandler[*testNetworkIdentifiable]{}
+	h.SetTimeouts("measured", nil)
+	resource := &testNetworkIdentifiable{name: "r"}
+
+	_ = h.Delegate(backendport.OperationUpdate, func(context.Context, *testNetworkIdentifiable) error {
+		return errors.New("boom")
+	})(context.Background(), resource)
+	_ = h.Delegate(backendport.OperationUpdate, func(context.Context, *testNetworkIdentifiable) error {
+		return backendport.ErrStillProcessing
+	})(context.Background(), resource)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(pluginErrors.WithLabelValues("measured", "Update")),
+		"an operation still in progress has not failed")
+}
+
+func TestTimeoutBackoff(t *testing.T) {
+	b := &timeoutBackoff{attempts: map[string]timeoutAttempt{}}
+	for range 10 {
+		b.failed("k")
+	}
+	assert.True(t, b.waiting("k"))
+	assert.WithinDuration(t, time.Now().Add(timeoutBackoffMax), b.attempts["k"].until, time.Second)
+
+	b.forget("k")
+	assert.False(t, b.waiting("k"))
+}
@@ -0,0 +1,224 @@
+package refgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/integrity"
+)
+
+var _ integrity.Checker = (*Graph)(nil)
+
+const (
+	// resync is the period after which the informers re-list the referrer kinds.
+	resync = 10 * time.Minute
+	// referenceIndex indexes the cached referrers by the resources they reference, each as a
+	// Key string.
+	referenceIndex = "references"
+)
+
+// Graph answers which resources reference a given one, from the Referrers declared by the
+// resource slices. It watches the referrer kinds, and answers from their informer caches,
+// indexed by the resources they reference.
+//
+// Only references within a tenant are followed: a resource is never held by a resource of
+// another tenant.
+//
+// Lifecycle: call Start once, or add the Graph to a manager. Until the informers have
+// synced, ReferrersTo fails.
+type Graph struct {
+	referrers []Referrer
+	factory   dynamicinformer.DynamicSharedInformerFactory
+	informers map[schema.GroupVersionResource]informers.GenericInformer
+	synced    atomic.Bool
+}
+
+// New returns the Graph of the given referrer kinds, watching them through client.
+func New(client dynamic.Interface, referrers ...Referrer) *Graph {
+	g := &Graph{
+		referrers: referrers,
+		factory:   dynamicinformer.NewDynamicSharedInformerFactory(client, resync),
+		informers: map[schema.GroupVersionResource]informers.GenericInformer{},
+	}
+	byGVR := map[schema.GroupVersionResource][]Reference{}
+	for _, r := range referrers {
+		byGVR[r.GVR] = append(byGVR[r.GVR], r.References...)
+	}
+	for gvr, refs := range byGVR {
+		informer := g.factory.ForResource(gvr)
+		// Adding an indexer fails only once the informer has started.
+		_ = informer.Informer().AddIndexers(cache.Indexers{referenceIndex: func(obj any) ([]string, error) {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return nil, nil
+			}
+			keys := Keys(u, refs)
+			values := make([]string, len(keys))
+			for i, key := range keys {
+				values[i] = key.String()
+			}
+			return values, nil
+		}})
+		g.informers[gvr] = informer
+	}
+	return g
+}
+
+// Start starts the informers and blocks until their caches are synced. Returns an error if
+// the context is cancelled before sync completes. The informers run until ctx is done.
+func (g *Graph) Start(ctx context.Context) error {
+	g.factory.Start(ctx.Done())
+	for gvr, synced := range g.factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("informer cache sync timed out for %s", gvr.Resource)
+		}
+	}
+	g.synced.Store(true)
+	return nil
+}
+
+// NeedLeaderElection makes a manager run the Graph on every replica.
+func (g *Graph) NeedLeaderElection() bool {
+	return false
+}
+
+// Target returns the declared Target of the kind gvk, and whether any Referrer references it.
+func (g *Graph) Target(gvk schema.GroupVersionKind) (Target, bool) {
+	for _, r := range g.referrers {
+		for _, ref := range r.References {
+			for _, target := range ref.Targets {
+				if target.GVK == gvk {
+					return target, true
+				}
+			}
+		}
+	}
+	return Target{}, false
+}
+
+// ReferrersOf returns the referrer kinds having a reference to the kind named collection,
+// each with only those references.
+func (g *Graph) ReferrersOf(collection string) []Referrer {
+	var referrers []Referrer
+	for _, r := range g.referrers {
+		var refs []Reference
+		for _, ref := range r.References {
+			if ref.targets(collection) {
+				refs = append(refs, ref)
+			}
+		}
+		if len(refs) > 0 {
+			r.References = refs
+			referrers = append(referrers, r)
+		}
+	}
+	return referrers
+}
+
+// Referrers implements integrity.Checker.
+func (g *Graph) Referrers(ctx context.Context, target integrity.Target) ([]string, error) {
+	return g.ReferrersTo(ctx, Key{
+		Collection: target.Resource,
+		Tenant:     target.Scope.Tenant,
+		Workspace:  target.Scope.Workspace,
+		Network:    target.Network,
+		Name:       target.Name,
+	})
+}
+
+// ReferrersTo returns the resources referencing the resource key identifies, as sorted
+// resource paths relative to its tenant (e.g. "workspaces/ws-1/nics/nic-1"). A referrer
+// being deleted still holds the resource until it is gone, e.g. an instance torn down its
+// NICs and volumes, unless the resource references it in turn: two resources referencing
+// each other, such as a block storage and the image taken from it, could otherwise never
+// both be deleted.
+func (g *Graph) ReferrersTo(_ context.Context, key Key) ([]string, error) {
+	if !g.synced.Load() {
+		return nil, errors.New("reference graph not synced yet")
+	}
+	referenced, err := g.referencedBy(key)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[schema.GroupVersionResource]bool{}
+	var paths []string
+	for _, r := range g.ReferrersOf(key.Collection) {
+		if seen[r.GVR] {
+			continue // indexed once for all its references
+		}
+		seen[r.GVR] = true
+		items, err := g.informers[r.GVR].Informer().GetIndexer().ByIndex(referenceIndex, key.String())
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to look up referrers: %w", r.GVR.Resource, err)
+		}
+		for _, obj := range items {
+			item, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			if KeyOf(r.Collection, item) == key {
+				continue // a resource never holds itself
+			}
+			if item.GetDeletionTimestamp() != nil && slices.Contains(referenced, KeyOf(r.Collection, item)) {
+				continue // a cycle, broken by the referrer being deleted
+			}
+			paths = append(paths, resourcePath(KeyOf(r.Collection, item)))
+		}
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
+
+// referencedBy returns the keys of the resources the resource key identifies references, or
+// none when it is gone or its kind references nothing.
+func (g *Graph) referencedBy(key Key) ([]Key, error) {
+	var refs []Reference
+	var gvr schema.GroupVersionResource
+	for _, r := range g.referrers {
+		if r.Collection == key.Collection {
+			refs, gvr = append(refs, r.References...), r.GVR
+		}
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	obj, err := g.informers[gvr].Lister().ByNamespace(key.Namespace()).Get(key.Name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to read the referenced resource: %w", gvr.Resource, err)
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil
+	}
+	return Keys(u, refs), nil
+}
+
+// resourcePath returns the resource path of k relative to its tenant.
+func resourcePath(k Key) string {
+	var b strings.Builder
+	if k.Workspace != "" {
+		b.WriteString("workspaces/" + k.Workspace + "/")
+	}
+	if k.Network != "" {
+		b.WriteString("networks/" + k.Network + "/")
+	}
+	b.WriteString(k.Collection + "/" + k.Name)
+	return b.String()
+}
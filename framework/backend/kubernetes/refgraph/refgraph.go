@@ -0,0 +1,255 @@
+// Package refgraph models the references between resources, declared per kind by the
+// resource slices: which fields of a kind reference which other kinds.
+//
+// The controllers use it to reconcile a resource as soon as a resource it references
+// changes state, and to hold the deletion of a resource others still reference; the
+// gateway uses it to refuse that deletion up front.
+package refgraph
+
+import (
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+)
+
+// Reference declares a reference field of a kind.
+type Reference struct {
+	// Path locates the schemav1.Reference in the CR, e.g. {"spec", "sourceImageRef"}. A "*"
+	// step descends into every element of a list, e.g. {"spec", "dataVolumes", "*", "deviceRef"}.
+	Path []string
+	// Enclosing, set instead of Path, declares the reference a resource makes through its
+	// scope to the workspace or network holding it. Targets then lists that one kind.
+	Enclosing Enclosure
+	// Targets lists the kinds the reference may point to.
+	Targets []Target
+}
+
+// Enclosure names the scope through which a resource references the one holding it.
+type Enclosure string
+
+const (
+	// InWorkspace references the workspace of the resource.
+	InWorkspace Enclosure = "workspace"
+	// InNetwork references the network of the resource.
+	InNetwork Enclosure = "network"
+	// AnyInWorkspace references every resource of the target kind in the workspace of the
+	// resource, e.g. the internet gateways a network may egress through. Its key names the
+	// workspace but no resource; see Key.AnyInWorkspace.
+	AnyInWorkspace Enclosure = "any-in-workspace"
+)
+
+// Target is a kind a Reference may point to.
+type Target struct {
+	// GVK is the GroupVersionKind of the referenced CR.
+	GVK schema.GroupVersionKind
+	// Collection is the segment naming the kind in the reference's resource path, e.g.
+	// "images" in "images/ubuntu".
+	Collection string
+	// Workspaced reports whether the kind is workspace-scoped. A reference to it that omits
+	// its workspace points into the workspace of the referencing resource.
+	Workspaced bool
+	// Networked reports whether the kind is network-scoped, e.g. subnets. A reference to it
+	// names its network in its resource path ("networks/<network>/subnets/<name>") or, when
+	// it does not, points into the network of the referencing resource.
+	Networked bool
+}
+
+// Referrer declares a kind whose resources reference others.
+type Referrer struct {
+	// GVR is the GroupVersionResource of the referencing CR.
+	GVR schema.GroupVersionResource
+	// Kind is the Kind of the referencing CR.
+	Kind string
+	// Collection is the segment naming the kind in resource paths, e.g. "instances".
+	Collection string
+	// References lists its reference fields.
+	References []Reference
+}
+
+// GVK returns the GroupVersionKind of the referencing CR.
+func (r Referrer) GVK() schema.GroupVersionKind {
+	return r.GVR.GroupVersion().WithKind(r.Kind)
+}
+
+// Key identifies a referenced resource.
+type Key struct {
+	Collection string
+	Tenant     string
+	Workspace  string
+	Network    string
+	Name       string
+}
+
+// String returns the key as "collection/tenant/workspace/network/name", its form in the
+// controllers' reference index.
+func (k Key) String() string {
+	return strings.Join([]string{k.Collection, k.Tenant, k.Workspace, k.Network, k.Name}, "/")
+}
+
+// AnyInWorkspace returns the key an AnyInWorkspace reference to the kind of k, from the
+// workspace of k, has.
+func (k Key) AnyInWorkspace() Key {
+	return Key{Collection: k.Collection, Tenant: k.Tenant, Workspace: k.Workspace}
+}
+
+// Namespace returns the namespace of the CR the key identifies.
+func (k Key) Namespace() string {
+	if k.Network != "" {
+		return k8sadapter.ComputeNetworkNamespace(networkScope{Scope: kernelresource.Scope{Tenant: k.Tenant, Workspace: k.Workspace}, network: k.Network})
+	}
+	return k8sadapter.ComputeNamespace(&kernelresource.Scope{Tenant: k.Tenant, Workspace: k.Workspace})
+}
+
+type networkScope struct {
+	kernelresource.Scope
+	network string
+}
+
+func (s networkScope) GetNetwork() string { return s.network }
+
+// KeyOf returns the key of obj, a resource of the kind named collection, read from its
+// scope labels.
+func KeyOf(collection string, obj metav1.Object) Key {
+	labels := obj.GetLabels()
+	return Key{
+		Collection: collection,
+		Tenant:     labels[k8slabels.InternalTenantLabel],
+		Workspace:  labels[k8slabels.InternalWorkspaceLabel],
+		Network:    labels[k8slabels.InternalNetworkLabel],
+		Name:       obj.GetName(),
+	}
+}
+
+// Keys returns the keys of the resources obj references through refs, sorted and without
+// duplicates. The scope a reference omits defaults to that of obj.
+func Keys(obj client.Object, refs []Reference) []Key {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil
+	}
+	from := KeyOf("", obj)
+
+	seen := map[Key]bool{}
+	var keys []Key
+	for _, ref := range refs {
+		if ref.Enclosing != "" {
+			if key, ok := ref.enclosingKey(from); ok && !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+			continue
+		}
+		for _, value := range lookup(content, ref.Path) {
+			raw, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			var crRef schemav1.Reference
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &crRef); err != nil {
+				continue
+			}
+			if key, ok := ref.key(crRef, from); ok && !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.SortFunc(keys, func(a, b Key) int { return strings.Compare(a.String(), b.String()) })
+	return keys
+}
+
+// key returns the key of the resource crRef points to, if it is one of ref's targets.
+func (ref Reference) key(crRef schemav1.Reference, from Key) (Key, bool) {
+	segments := strings.Split(strings.Trim(crRef.Resource, "/"), "/")
+	if len(segments) < 2 {
+		return Key{}, false
+	}
+	collection, name := segments[len(segments)-2], segments[len(segments)-1]
+
+	for _, target := range ref.Targets {
+		if target.Collection != collection {
+			continue
+		}
+		key := Key{Collection: collection, Tenant: from.Tenant, Name: name}
+		if crRef.Tenant != "" {
+			key.Tenant = crRef.Tenant
+		}
+		if target.Workspaced {
+			key.Workspace = from.Workspace
+			if crRef.Workspace != "" {
+				key.Workspace = crRef.Workspace
+			}
+		}
+		if target.Networked {
+			key.Network = from.Network
+			if len(segments) >= 4 && segments[len(segments)-4] == "networks" {
+				key.Network = segments[len(segments)-3]
+			}
+		}
+		return key, true
+	}
+	return Key{}, false
+}
+
+// enclosingKey returns the key of the workspace or network holding from, if it has one, or
+// the AnyInWorkspace key of its workspace.
+func (ref Reference) enclosingKey(from Key) (Key, bool) {
+	if len(ref.Targets) == 0 {
+		return Key{}, false
+	}
+	key := Key{Collection: ref.Targets[0].Collection, Tenant: from.Tenant}
+	switch ref.Enclosing {
+	case InWorkspace:
+		key.Name = from.Workspace
+	case InNetwork:
+		key.Workspace, key.Name = from.Workspace, from.Network
+	case AnyInWorkspace:
+		key.Workspace = from.Workspace
+		return key, key.Workspace != ""
+	}
+	return key, key.Name != ""
+}
+
+// targets reports whether ref may point to a resource of the kind named collection.
+func (ref Reference) targets(collection string) bool {
+	for _, target := range ref.Targets {
+		if target.Collection == collection {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the values at path in content, a "*" step descending into every element of
+// a list.
+func lookup(content any, path []string) []any {
+	if len(path) == 0 {
+		return []any{content}
+	}
+	if path[0] == "*" {
+		items, _ := content.([]any)
+		var values []any
+		for _, item := range items {
+			values = append(values, lookup(item, path[1:])...)
+		}
+		return values
+	}
+	fields, ok := content.(map[string]any)
+	if !ok {
+		return nil
+	}
+	value, ok := fields[path[0]]
+	if !ok {
+		return nil
+	}
+	return lookup(value, path[1:])
+}
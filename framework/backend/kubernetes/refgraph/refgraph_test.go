@@ -0,0 +1,259 @@
+package refgraph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/integrity"
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+)
+
+var (
+	nics    = Target{GVK: schema.GroupVersionKind{Group: "network.v1.secapi.cloud", Version: "v1", Kind: "NIC"}, Collection: "nics", Workspaced: true}
+	subnets = Target{GVK: schema.GroupVersionKind{Group: "network.v1.secapi.cloud", Version: "v1", Kind: "Subnet"}, Collection: "subnets", Workspaced: true, Networked: true}
+	volumes = Target{GVK: schema.GroupVersionKind{Group: "storage.v1.secapi.cloud", Version: "v1", Kind: "BlockStorage"}, Collection: "block-storages", Workspaced: true}
+	images  = Target{GVK: schema.GroupVersionKind{Group: "storage.v1.secapi.cloud", Version: "v1", Kind: "Image"}, Collection: "images"}
+
+	instanceReferrer = Referrer{
+		GVR:        schema.GroupVersionResource{Group: "compute.v1.secapi.cloud", Version: "v1", Resource: "instances"},
+		Kind:       "Instance",
+		Collection: "instances",
+		References: []Reference{
+			{Path: []string{"spec", "primaryNicRef"}, Targets: []Target{nics}},
+			{Path: []string{"spec", "dataVolumes", "*", "deviceRef"}, Targets: []Target{volumes}},
+		},
+	}
+	nicReferrer = Referrer{
+		GVR:        schema.GroupVersionResource{Group: "network.v1.secapi.cloud", Version: "v1", Resource: "nics"},
+		Kind:       "NIC",
+		Collection: "nics",
+		References: []Reference{{Path: []string{"spec", "subnetRef"}, Targets: []Target{subnets}}},
+	}
+)
+
+func newCR(gvr schema.GroupVersionResource, kind, namespace, name, tenant, workspace string, spec map[string]any) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{"spec": spec}}
+	obj.SetGroupVersionKind(gvr.GroupVersion().WithKind(kind))
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{
+		k8slabels.InternalTenantLabel:    tenant,
+		k8slabels.InternalWorkspaceLabel: workspace,
+	})
+	return obj
+}
+
+func deleting(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	now := metav1.Now()
+	obj.SetDeletionTimestamp(&now)
+	obj.SetFinalizers([]string{"test"})
+	return obj
+}
+
+func TestKeys(t *testing.T) {
+	t.Parallel()
+
+	refs := []Reference{
+		{Path: []string{"spec", "primaryNicRef"}, Targets: []Target{nics}},
+		{Path: []string{"spec", "dataVolumes", "*", "deviceRef"}, Targets: []Target{volumes}},
+		{Path: []string{"spec", "sourceImageRef"}, Targets: []Target{images}},
+		{Path: []string{"spec", "subnetRef"}, Targets: []Target{subnets}},
+	}
+	obj := newCR(instanceReferrer.GVR, "Instance", "ns", "vm", "t1", "ws-1", map[string]any{
+		"primaryNicRef": map[string]any{"resource": "nics/nic-1"},
+		"dataVolumes": []any{
+			map[string]any{"deviceRef": map[string]any{"resource": "block-storages/data-1"}},
+			map[string]any{"deviceRef": map[string]any{"resource": "block-storages/data-2", "workspace": "ws-2"}},
+			map[string]any{"deviceRef": map[string]any{"resource": "block-storages/data-1"}},
+		},
+		"sourceImageRef": map[string]any{"resource": "images/ubuntu", "tenant": "public"},
+		"subnetRef":      map[string]any{"resource": "networks/net-1/subnets/sn-1"},
+	})
+
+	assert.Equal(t, []Key{
+		{Collection: "block-storages", Tenant: "t1", Workspace: "ws-1", Name: "data-1"},
+		{Collection: "block-storages", Tenant: "t1", Workspace: "ws-2", Name: "data-2"},
+		{Collection: "images", Tenant: "public", Name: "ubuntu"},
+		{Collection: "nics", Tenant: "t1", Workspace: "ws-1", Name: "nic-1"},
+		{Collection: "subnets", Tenant: "t1", Workspace: "ws-1", Network: "net-1", Name: "sn-1"},
+	}, Keys(obj, refs))
+}
+
+func TestKeys_IgnoresOtherCollections(t *testing.T) {
+	t.Parallel()
+
+	refs := []Reference{{
+		Path:    []string{"spec", "routes", "*", "targetRef"},
+		Targets: []Target{{Collection: "instances", Workspaced: true}},
+	}}
+	obj := newCR(instanceReferrer.GVR, "RouteTable", "ns", "rt", "t1", "ws", map[string]any{
+		"routes": []any{
+			map[string]any{"targetRef": map[string]any{"resource": "internet-gateways/igw"}},
+			map[string]any{"targetRef": map[string]any{"resource": "instances/vm"}},
+			map[string]any{"targetRef": map[string]any{"resource": "vm"}},
+		},
+	})
+
+	assert.Equal(t, []Key{{Collection: "instances", Tenant: "t1", Workspace: "ws", Name: "vm"}}, Keys(obj, refs))
+}
+
+func TestKeys_Enclosing(t *testing.T) {
+	t.Parallel()
+
+	workspaces := Target{GVK: schema.GroupVersionKind{Group: "workspace.v1.secapi.cloud", Version: "v1", Kind: "Workspace"}, Collection: "workspaces"}
+	networks := Target{GVK: schema.GroupVersionKind{Group: "network.v1.secapi.cloud", Version: "v1", Kind: "Network"}, Collection: "networks", Workspaced: true}
+	refs := []Reference{
+		{Enclosing: InWorkspace, Targets: []Target{workspaces}},
+		{Enclosing: InNetwork, Targets: []Target{networks}},
+	}
+
+	subnet := newCR(nicReferrer.GVR, "Subnet", "ns", "sn-1", "t1", "ws-1", nil)
+	labels := subnet.GetLabels()
+	labels[k8slabels.InternalNetworkLabel] = "net-1"
+	subnet.SetLabels(labels)
+	assert.Equal(t, []Key{
+		{Collection: "networks", Tenant: "t1", Workspace: "ws-1", Name: "net-1"},
+		{Collection: "workspaces", Tenant: "t1", Name: "ws-1"},
+	}, Keys(subnet, refs))
+
+	nic := newCR(nicReferrer.GVR, "NIC", "ns", "nic-1", "t1", "ws-1", nil)
+	assert.Equal(t, []Key{{Collection: "workspaces", Tenant: "t1", Name: "ws-1"}}, Keys(nic, refs),
+		"a resource outside any network references none")
+
+	network := newCR(nicReferrer.GVR, "Network", "ns", "net-1", "t1", "ws-1", nil)
+	gateway := Key{Collection: "internet-gateways", Tenant: "t1", Workspace: "ws-1", Name: "igw-1"}
+	assert.Equal(t, []Key{gateway.AnyInWorkspace()}, Keys(network, []Reference{
+		{Enclosing: AnyInWorkspace, Targets: []Target{{Collection: "internet-gateways", Workspaced: true}}},
+	}), "a reference to any resource of a kind in the workspace names none")
+}
+
+func TestGraph_Referrers(t *testing.T) {
+	t.Parallel()
+
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			instanceReferrer.GVR: "InstanceList",
+			nicReferrer.GVR:      "NICList",
+		},
+		newCR(instanceReferrer.GVR, "Instance", "ns-1", "vm-1", "t1", "ws-1", map[string]any{
+			"primaryNicRef": map[string]any{"resource": "nics/nic-1"},
+			"dataVolumes":   []any{map[string]any{"deviceRef": map[string]any{"resource": "block-storages/data-1"}}},
+		}),
+		newCR(instanceReferrer.GVR, "Instance", "ns-1", "vm-2", "t1", "ws-1", map[string]any{
+			"primaryNicRef": map[string]any{"resource": "nics/nic-2"},
+		}),
+		newCR(instanceReferrer.GVR, "Instance", "ns-2", "vm-3", "t2", "ws-1", map[string]any{
+			"primaryNicRef": map[string]any{"resource": "nics/nic-1"},
+		}),
+		newCR(nicReferrer.GVR, "NIC", "ns-1", "nic-1", "t1", "ws-1", map[string]any{
+			"subnetRef": map[string]any{"resource": "networks/net-1/subnets/sn-1"},
+		}),
+		deleting(newCR(instanceReferrer.GVR, "Instance", "ns-1", "vm-4", "t1", "ws-1", map[string]any{
+			"dataVolumes": []any{map[string]any{"deviceRef": map[string]any{"resource": "block-storages/data-1"}}},
+		})),
+	)
+	graph := New(client, instanceReferrer, nicReferrer)
+	require.NoError(t, graph.Start(t.Context()))
+
+	referrers, err := graph.Referrers(t.Context(), integrity.Target{
+		Resource: "nics",
+		Scope:    kernelresource.Scope{Tenant: "t1", Workspace: "ws-1"},
+		Name:     "nic-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"workspaces/ws-1/instances/vm-1"}, referrers, "only the tenant's referrers hold the NIC")
+
+	referrers, err = graph.Referrers(t.Context(), integrity.Target{
+		Resource: "subnets",
+		Scope:    kernelresource.Scope{Tenant: "t1", Workspace: "ws-1"},
+		Network:  "net-1",
+		Name:     "sn-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"workspaces/ws-1/nics/nic-1"}, referrers)
+
+	referrers, err = graph.Referrers(t.Context(), integrity.Target{
+		Resource: "block-storages",
+		Scope:    kernelresource.Scope{Tenant: "t1", Workspace: "ws-1"},
+		Name:     "data-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"workspaces/ws-1/instances/vm-1", "workspaces/ws-1/instances/vm-4"}, referrers,
+		"a referrer being deleted holds until it is gone")
+
+	referrers, err = graph.Referrers(t.Context(), integrity.Target{
+		Resource: "block-storages",
+		Scope:    kernelresource.Scope{Tenant: "t1", Workspace: "ws-1"},
+		Name:     "data-2",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, referrers)
+
+	target, ok := graph.Target(subnets.GVK)
+	require.True(t, ok)
+	assert.Equal(t, subnets, target)
+	assert.Len(t, graph.ReferrersOf("subnets"), 1)
+	assert.Empty(t, graph.ReferrersOf("images"))
+}
+
+func TestGraph_ReferrersInACycle(t *testing.T) {
+	t.Parallel()
+
+	blockStorageReferrer := Referrer{
+		GVR:        schema.GroupVersionResource{Group: "storage.v1.secapi.cloud", Version: "v1", Resource: "block-storages"},
+		Kind:       "BlockStorage",
+		Collection: "block-storages",
+		References: []Reference{{Path: []string{"spec", "sourceImageRef"}, Targets: []Target{images}}},
+	}
+	imageReferrer := Referrer{
+		GVR:        schema.GroupVersionResource{Group: "storage.v1.secapi.cloud", Version: "v1", Resource: "images"},
+		Kind:       "Image",
+		Collection: "images",
+		References: []Reference{{Path: []string{"spec", "blockStorageRef"}, Targets: []Target{volumes}}},
+	}
+	volume := Key{Collection: "block-storages", Tenant: "t1", Workspace: "ws-1", Name: "data-1"}
+	image := Key{Collection: "images", Tenant: "t1", Name: "ubuntu"}
+	newImage := func(name string) *unstructured.Unstructured {
+		return newCR(imageReferrer.GVR, "Image", image.Namespace(), name, "t1", "", map[string]any{
+			"blockStorageRef": map[string]any{"resource": "block-storages/data-1", "workspace": "ws-1"},
+		})
+	}
+
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			blockStorageReferrer.GVR: "BlockStorageList",
+			imageReferrer.GVR:        "ImageList",
+		},
+		// The volume was restored from the image taken from it.
+		newCR(blockStorageReferrer.GVR, "BlockStorage", volume.Namespace(), "data-1", "t1", "ws-1", map[string]any{
+			"sourceImageRef": map[string]any{"resource": "images/ubuntu"},
+		}),
+		deleting(newImage("ubuntu")),
+		deleting(newImage("debian")),
+	)
+	graph := New(client, blockStorageReferrer, imageReferrer)
+	require.NoError(t, graph.Start(t.Context()))
+
+	referrers, err := graph.ReferrersTo(t.Context(), volume)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"images/debian"}, referrers,
+		"an image being deleted holds the volume unless the volume references it")
+}
+
+func TestGraph_NotSynced(t *testing.T) {
+	t.Parallel()
+
+	graph := New(fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{nicReferrer.GVR: "NICList"}), nicReferrer)
+
+	_, err := graph.ReferrersTo(t.Context(), Key{Collection: "subnets", Tenant: "t1", Workspace: "ws-1", Network: "net-1", Name: "sn-1"})
+	assert.Error(t, err, "an unsynced graph cannot tell a resource is free")
+}
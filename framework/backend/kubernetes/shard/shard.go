@@ -0,0 +1,397 @@
+// Package shard splits the tenants of a region between the replicas of a delegator.
+//
+// Tenants hash into a fixed number of shards, each guarded by a coordination.k8s.io Lease.
+// Every replica announces itself with a member Lease, and holds an even share of the shard
+// Leases: a replica joining makes the others stop renewing their extra shards, a replica
+// disappearing lets its shard Leases expire, and the others take the expired Leases over. A replica
+// reconciles the resources of the tenants in the shards it holds, and only those.
+//
+// A shard changes hands only once its Lease expired, never by release: the replica giving it
+// up stops reconciling its tenants at the renew deadline, and the Lease outlasts that deadline
+// by the longest operation, so that the reconciles already in flight finish before another
+// replica starts its own.
+package shard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log/slog"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+)
+
+const (
+	DefaultLeaseDuration = 15 * time.Second
+	DefaultRenewDeadline = 10 * time.Second
+	DefaultRetryPeriod   = 2 * time.Second
+)
+
+const (
+	// setLabel names the shard set a Lease belongs to.
+	setLabel = k8slabels.InternalLabelPrefix + "shard-set"
+	// roleLabel tells member Leases, one per replica, from shard Leases, one per shard.
+	roleLabel = k8slabels.InternalLabelPrefix + "shard-role"
+	// indexLabel holds the index of the shard a shard Lease guards.
+	indexLabel = k8slabels.InternalLabelPrefix + "shard-index"
+
+	roleMember = "member"
+	roleShard  = "shard"
+)
+
+// Sharder holds the shards of one replica. It is a manager Runnable that runs on every
+// replica, leader or not.
+type Sharder struct {
+	leases   coordinationclient.LeaseInterface
+	name     string
+	identity string
+	shards   int
+	logger   *slog.Logger
+
+	leaseDuration time.Duration
+	renewDeadline time.Duration
+	retryPeriod   time.Duration
+	now           func() time.Time
+
+	mu sync.RWMutex
+	// held maps the shards this replica holds to their last renewal.
+	held map[int]time.Time
+	// draining holds the shards this replica gave up but whose Leases have not expired yet.
+	// Only balance reads and writes it.
+	draining  map[int]bool
+	onAcquire []func(ctx context.Context, shards []int)
+}
+
+// New returns the Sharder of replica identity in the shard set name, splitting tenants into
+// shards, with its Leases in namespace. identity must be unique among the replicas, e.g. the
+// pod name.
+func New(clientset kubernetes.Interface, namespace, name, identity string, shards int, logger *slog.Logger) (*Sharder, error) {
+	if shards < 1 {
+		return nil, fmt.Errorf("shard count must be positive, got %d", shards)
+	}
+	if namespace == "" || name == "" || identity == "" {
+		return nil, errors.New("shard set namespace, name and identity are required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Sharder{
+		leases:        clientset.CoordinationV1().Leases(namespace),
+		name:          name,
+		identity:      identity,
+		shards:        shards,
+		logger:        logger.With("shard-set", name, "identity", identity),
+		leaseDuration: DefaultLeaseDuration,
+		renewDeadline: DefaultRenewDeadline,
+		retryPeriod:   DefaultRetryPeriod,
+		now:           time.Now,
+		held:          map[int]time.Time{},
+		draining:      map[int]bool{},
+	}, nil
+}
+
+// ShardOf returns the shard of tenant.
+func (s *Sharder) ShardOf(tenant string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(tenant))
+	return int(h.Sum32() % uint32(s.shards))
+}
+
+// Owns reports whether this replica holds the shard of tenant. A shard whose Lease has not
+// been renewed within the renew deadline is no longer owned, well before another replica
+// can take the expired Lease over.
+func (s *Sharder) Owns(tenant string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	renewed, ok := s.held[s.ShardOf(tenant)]
+	return ok && s.now().Sub(renewed) <= s.renewDeadline
+}
+
+// Held returns the shards this replica holds, in order.
+func (s *Sharder) Held() []int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	shards := make([]int, 0, len(s.held))
+	for i := range s.held {
+		shards = append(shards, i)
+	}
+	slices.Sort(shards)
+	return shards
+}
+
+// OnAcquire registers fn to be called, in its own goroutine, with the shards this replica
+// takes over, so that it can reconcile their resources. Call it before the manager starts.
+func (s *Sharder) OnAcquire(fn func(ctx context.Context, shards []int)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onAcquire = append(s.onAcquire, fn)
+}
+
+// NeedLeaderElection makes the manager run the Sharder on every replica.
+func (s *Sharder) NeedLeaderElection() bool {
+	return false
+}
+
+// Outlast makes the shard Leases expire at least d after the renew deadline past which this
+// replica stops reconciling their tenants, so that an operation bounded by d, started just
+// before the deadline, ends before another replica takes the shard over. The longest d passed
+// wins. Call it before the manager starts.
+func (s *Sharder) Outlast(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Lease durations are whole seconds.
+	s.leaseDuration = max(s.leaseDuration, (s.renewDeadline + d + time.Second - 1).Truncate(time.Second))
+}
+
+// Start balances the shards every retry period until ctx is done, then stops reconciling
+// them and withdraws the member Lease of this replica. The shard Leases are left to expire:
+// the manager may still be draining the reconciles of their tenants.
+func (s *Sharder) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.retryPeriod)
+	defer ticker.Stop()
+	for {
+		if err := s.balance(ctx); err != nil && ctx.Err() == nil {
+			s.logger.Warn("failed to balance shards", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			leaveCtx, cancel := context.WithTimeout(context.Background(), s.retryPeriod)
+			s.leave(leaveCtx)
+			cancel()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// balance renews the member Lease of this replica, then renews the shards it holds up to an
+// even share of the live replicas, stops renewing those above it, and takes free or expired
+// shards below it.
+//
+// A shard above the share is not released: its Lease is left to expire, so that the
+// reconciles of its tenants already in flight here finish before another replica takes it.
+func (s *Sharder) balance(ctx context.Context) error {
+	now := s.now()
+	if err := s.announce(ctx, now); err != nil {
+		return err
+	}
+
+	list, err := s.leases.List(ctx, metav1.ListOptions{LabelSelector: setLabel + "=" + s.name})
+	if err != nil {
+		return fmt.Errorf("failed to list shard leases: %w", err)
+	}
+	members := 0
+	shardLeases := make([]*coordinationv1.Lease, s.shards)
+	for i := range list.Items {
+		lease := &list.Items[i]
+		switch lease.Labels[roleLabel] {
+		case roleMember:
+			if s.live(lease, now) {
+				members++
+				continue
+			}
+			// The member Lease of a replica gone for good; forget it, best effort.
+			_ = s.leases.Delete(ctx, lease.Name, metav1.DeleteOptions{})
+		case roleShard:
+			if index, err := strconv.Atoi(lease.Labels[indexLabel]); err == nil && index >= 0 && index < s.shards {
+				shardLeases[index] = lease
+			}
+		}
+	}
+	share := (s.shards + max(members, 1) - 1) / max(members, 1)
+
+	mine := 0
+	for index, lease := range shardLeases {
+		if lease == nil || holder(lease) != s.identity || !s.live(lease, now) {
+			delete(s.draining, index)
+			continue
+		}
+		if !s.draining[index] {
+			mine++
+		}
+	}
+
+	var acquired []int
+	// Start from a shard of our own, so that replicas taking shards at once rarely collide.
+	first := s.ShardOf(s.identity)
+	for n := range s.shards {
+		index := (first + n) % s.shards
+		lease := shardLeases[index]
+		switch {
+		case s.draining[index]:
+			// Given up; its Lease expires on its own.
+		case lease != nil && holder(lease) == s.identity && s.live(lease, now):
+			if mine > share {
+				s.drop(index)
+				s.draining[index] = true
+				mine--
+				continue
+			}
+			if err := s.renew(ctx, lease, now, false); err != nil {
+				s.logger.Warn("failed to renew shard", "shard", index, "error", err)
+				continue
+			}
+			s.keep(index, now)
+		case lease == nil || holder(lease) == "" || !s.live(lease, now):
+			s.drop(index)
+			if mine >= share {
+				continue
+			}
+			ok, err := s.take(ctx, index, lease, now)
+			if err != nil {
+				s.logger.Warn("failed to take shard", "shard", index, "error", err)
+				continue
+			}
+			if ok {
+				mine++
+				s.keep(index, now)
+				acquired = append(acquired, index)
+			}
+		default:
+			// Another replica holds the shard.
+			s.drop(index)
+		}
+	}
+
+	if len(acquired) > 0 {
+		slices.Sort(acquired)
+		s.logger.Info("took over shards", "shards", acquired, "share", share, "members", members)
+		s.mu.RLock()
+		for _, fn := range s.onAcquire {
+			go fn(ctx, acquired)
+		}
+		s.mu.RUnlock()
+	}
+	return nil
+}
+
+// announce creates or renews the member Lease of this replica.
+func (s *Sharder) announce(ctx context.Context, now time.Time) error {
+	lease, err := s.leases.Get(ctx, s.memberName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = s.leases.Create(ctx, s.newLease(s.memberName(), roleMember, "", now), metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	return s.renew(ctx, lease, now, false)
+}
+
+// take acquires the shard index, guarded by lease, or by no Lease yet. It reports false when
+// another replica was faster.
+func (s *Sharder) take(ctx context.Context, index int, lease *coordinationv1.Lease, now time.Time) (bool, error) {
+	var err error
+	if lease == nil {
+		_, err = s.leases.Create(ctx, s.newLease(s.shardName(index), roleShard, strconv.Itoa(index), now), metav1.CreateOptions{})
+	} else {
+		err = s.renew(ctx, lease, now, true)
+	}
+	if apierrors.IsAlreadyExists(err) || apierrors.IsConflict(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// renew records this replica as the holder of lease at now. The update carries the resource
+// version lease was read at, so it fails if another replica wrote the Lease meanwhile.
+func (s *Sharder) renew(ctx context.Context, lease *coordinationv1.Lease, now time.Time, acquire bool) error {
+	lease = lease.DeepCopy()
+	renewTime := metav1.NewMicroTime(now)
+	if acquire {
+		transitions := ptr(int32(0))
+		if lease.Spec.LeaseTransitions != nil {
+			*transitions = *lease.Spec.LeaseTransitions + 1
+		}
+		lease.Spec.LeaseTransitions = transitions
+		lease.Spec.AcquireTime = &renewTime
+	}
+	lease.Spec.HolderIdentity = ptr(s.identity)
+	lease.Spec.LeaseDurationSeconds = ptr(int32(s.leaseDuration / time.Second))
+	lease.Spec.RenewTime = &renewTime
+	_, err := s.leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+// leave gives up every shard this replica holds, leaving their Leases to expire, and deletes
+// its member Lease, so that the other replicas count it out of the share at once.
+func (s *Sharder) leave(ctx context.Context) {
+	for _, index := range s.Held() {
+		s.drop(index)
+	}
+	if err := s.leases.Delete(ctx, s.memberName(), metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		s.logger.Warn("failed to delete member lease", "error", err)
+	}
+}
+
+func (s *Sharder) keep(index int, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.held[index] = now
+}
+
+func (s *Sharder) drop(index int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.held, index)
+}
+
+// live reports whether lease was renewed within its duration.
+func (s *Sharder) live(lease *coordinationv1.Lease, now time.Time) bool {
+	if lease.Spec.RenewTime == nil {
+		return false
+	}
+	duration := s.leaseDuration
+	if lease.Spec.LeaseDurationSeconds != nil {
+		duration = time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	}
+	return now.Before(lease.Spec.RenewTime.Add(duration))
+}
+
+func (s *Sharder) newLease(name, role, index string, now time.Time) *coordinationv1.Lease {
+	renewTime := metav1.NewMicroTime(now)
+	labels := map[string]string{setLabel: s.name, roleLabel: role}
+	if index != "" {
+		labels[indexLabel] = index
+	}
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr(s.identity),
+			LeaseDurationSeconds: ptr(int32(s.leaseDuration / time.Second)),
+			AcquireTime:          &renewTime,
+			RenewTime:            &renewTime,
+		},
+	}
+}
+
+func (s *Sharder) memberName() string {
+	return s.name + "-member-" + s.identity
+}
+
+func (s *Sharder) shardName(index int) string {
+	return s.name + "-shard-" + strconv.Itoa(index)
+}
+
+// holder returns the identity holding lease, or "" when none does.
+func holder(lease *coordinationv1.Lease) string {
+	if lease.Spec.HolderIdentity == nil {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,167 @@
+package shard
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// clock is a settable time source shared by the replicas of a test.
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time { return c.t }
+
+func newReplica(t *testing.T, clientset kubernetes.Interface, c *clock, identity string, shards int) *Sharder {
+	t.Helper()
+	s, err := New(clientset, "ecp", "delegator", identity, shards, nil)
+	require.NoError(t, err)
+	s.now = c.now
+	return s
+}
+
+func balance(t *testing.T, replicas ...*Sharder) {
+	t.Helper()
+	for _, s := range replicas {
+		require.NoError(t, s.balance(context.Background()))
+	}
+}
+
+// owners maps every tenant of a sample to the replicas owning it.
+func owners(replicas ...*Sharder) map[string][]string {
+	m := map[string][]string{}
+	for i := range 64 {
+		tenant := fmt.Sprintf("tenant-%d", i)
+		for _, s := range replicas {
+			if s.Owns(tenant) {
+				m[tenant] = append(m[tenant], s.identity)
+			}
+		}
+	}
+	return m
+}
+
+func TestNew_Validates(t *testing.T) {
+	clientset := fake.NewClientset()
+	_, err := New(clientset, "ecp", "delegator", "a", 0, nil)
+	assert.Error(t, err)
+	_, err = New(clientset, "", "delegator", "a", 4, nil)
+	assert.Error(t, err)
+}
+
+func TestSharder_SingleReplicaHoldsEveryShard(t *testing.T) {
+	c := &clock{t: time.Now()}
+	a := newReplica(t, fake.NewClientset(), c, "a", 4)
+
+	balance(t, a)
+
+	assert.Equal(t, []int{0, 1, 2, 3}, a.Held())
+	assert.True(t, a.Owns("tenant-1"))
+}
+
+func TestSharder_SplitsBetweenReplicas(t *testing.T) {
+	clientset := fake.NewClientset()
+	c := &clock{t: time.Now()}
+	a := newReplica(t, clientset, c, "a", 4)
+	b := newReplica(t, clientset, c, "b", 4)
+
+	balance(t, a)
+	// b joins: a gives up half of its shards, but b waits for their Leases to expire, so
+	// that the reconciles a still runs for them finish first.
+	balance(t, b, a, b)
+	assert.Len(t, a.Held(), 2)
+	assert.Empty(t, b.Held())
+
+	for range DefaultLeaseDuration/DefaultRetryPeriod + 1 {
+		c.t = c.t.Add(DefaultRetryPeriod)
+		balance(t, a, b)
+	}
+
+	assert.Len(t, a.Held(), 2)
+	assert.Len(t, b.Held(), 2)
+	for tenant, owner := range owners(a, b) {
+		assert.Len(t, owner, 1, tenant)
+	}
+	assert.Len(t, owners(a, b), 64)
+}
+
+func TestSharder_TakesOverFromAGoneReplica(t *testing.T) {
+	clientset := fake.NewClientset()
+	c := &clock{t: time.Now()}
+	a := newReplica(t, clientset, c, "a", 4)
+	b := newReplica(t, clientset, c, "b", 4)
+	balance(t, a, b, a, b)
+	require.Len(t, b.Held(), 2)
+
+	// b stops renewing: it stops reconciling after the renew deadline, and a takes its
+	// shards over once their Leases expire.
+	c.t = c.t.Add(DefaultRenewDeadline + time.Second)
+	assert.Empty(t, owners(b)["tenant-1"])
+
+	c.t = c.t.Add(DefaultLeaseDuration)
+	balance(t, a)
+
+	assert.Equal(t, []int{0, 1, 2, 3}, a.Held())
+}
+
+func TestSharder_AcquireCallback(t *testing.T) {
+	c := &clock{t: time.Now()}
+	a := newReplica(t, fake.NewClientset(), c, "a", 2)
+	got := make(chan []int, 1)
+	a.OnAcquire(func(_ context.Context, shards []int) { got <- shards })
+
+	balance(t, a)
+
+	select {
+	case shards := <-got:
+		assert.Equal(t, []int{0, 1}, shards)
+	case <-time.After(time.Second):
+		t.Fatal("acquire callback not called")
+	}
+}
+
+func TestSharder_LeaveLetsLeasesExpire(t *testing.T) {
+	clientset := fake.NewClientset()
+	c := &clock{t: time.Now()}
+	a := newReplica(t, clientset, c, "a", 2)
+	b := newReplica(t, clientset, c, "b", 2)
+	balance(t, a)
+
+	a.leave(context.Background())
+	assert.Empty(t, a.Held())
+
+	// The shards of a replica leaving are taken over only once their Leases expire, so that
+	// the reconciles it still drains finish first.
+	balance(t, b)
+	assert.Empty(t, b.Held())
+
+	c.t = c.t.Add(DefaultLeaseDuration + time.Second)
+	balance(t, b)
+	assert.Equal(t, []int{0, 1}, b.Held())
+}
+
+func TestSharder_OutlastsOperations(t *testing.T) {
+	clientset := fake.NewClientset()
+	c := &clock{t: time.Now()}
+	a := newReplica(t, clientset, c, "a", 2)
+	b := newReplica(t, clientset, c, "b", 2)
+	a.Outlast(2 * time.Minute)
+	b.Outlast(2 * time.Minute)
+	balance(t, a)
+
+	// a stops renewing. It stops reconciling at the renew deadline, but an operation started
+	// just before may run 2 minutes more: b waits for it.
+	c.t = c.t.Add(DefaultRenewDeadline + 2*time.Minute - time.Second)
+	assert.Empty(t, owners(a)["tenant-1"])
+	balance(t, b)
+	assert.Empty(t, b.Held())
+
+	c.t = c.t.Add(2 * time.Second)
+	balance(t, b)
+	assert.Equal(t, []int{0, 1}, b.Held())
+}
@@ -0,0 +1,75 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	kerrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+)
+
+// LabelReaderAdapter implements authzport.LabelReader over the custom resources holding the
+// SECA resources.
+type LabelReaderAdapter struct {
+	client dynamic.Interface
+	gvrs   map[string]schema.GroupVersionResource
+}
+
+var _ authzport.LabelReader = (*LabelReaderAdapter)(nil)
+
+// LabelReaderKey returns the key of gvrs in NewLabelReaderAdapter for the resource kind path
+// resource of provider, as they appear in an authorization claim.
+func LabelReaderKey(provider, resource string) string {
+	return provider + " " + resource
+}
+
+// NewLabelReaderAdapter creates a LabelReaderAdapter reading through client the resources of
+// gvrs, keyed by LabelReaderKey.
+func NewLabelReaderAdapter(client dynamic.Interface, gvrs map[string]schema.GroupVersionResource) *LabelReaderAdapter {
+	return &LabelReaderAdapter{client: client, gvrs: gvrs}
+}
+
+// StoredLabels implements authzport.LabelReader. A kind missing from gvrs reads as stored
+// without labels, so a grant conditioned on labels never covers it.
+func (a *LabelReaderAdapter) StoredLabels(ctx context.Context, claim authzport.AuthorizationClaim) (map[string]string, bool, error) {
+	gvr, ok := a.gvrs[LabelReaderKey(claim.Provider, claim.Resource)]
+	if !ok {
+		return nil, true, nil
+	}
+
+	scope := resource.Scope{Tenant: claim.Tenant, Workspace: claim.Workspace}
+	namespace := ComputeNamespace(scope)
+	if claim.Network != "" {
+		namespace = ComputeNetworkNamespace(networkScope{Scope: scope, network: claim.Network})
+	}
+
+	obj, err := a.client.Resource(gvr).Namespace(namespace).Get(ctx, claim.Name, metav1.GetOptions{})
+	if kerrs.IsNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, kubeToDomainError(fmt.Errorf("failed to retrieve %s '%s': %w", gvr.Resource, claim.Name, err))
+	}
+
+	// metadata.labels holds the values under hashed keys; commonData.labels lists the keys.
+	keys, _, err := unstructured.NestedStringSlice(obj.Object, "commonData", "labels")
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to read the label keys of %s '%s': %w", gvr.Resource, claim.Name, err)
+	}
+	return labels.KeyedToOriginal(labels.GetKeyedLabels(obj.GetLabels()), keys), true, nil
+}
+
+// networkScope is the scope of a network-scoped resource an authorization claim names.
+type networkScope struct {
+	resource.Scope
+	network string
+}
+
+func (s networkScope) GetNetwork() string { return s.network }
@@ -0,0 +1,61 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+)
+
+// TestLabelReaderAdapter_StoredLabels pins the lookup of a network-scoped resource in the
+// namespace of its network, and the rebuilding of its labels from the hashed keys.
+func TestLabelReaderAdapter_StoredLabels(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "network.test", Version: "v1", Resource: "subnets"}
+	scope := kernelresource.Scope{Tenant: "t1", Workspace: "w1"}
+
+	subnet := &unstructured.Unstructured{Object: map[string]any{
+		"commonData": map[string]any{"labels": []any{"team"}},
+	}}
+	subnet.SetAPIVersion("network.test/v1")
+	subnet.SetKind("Subnet")
+	subnet.SetNamespace(ComputeNetworkNamespace(networkScope{Scope: scope, network: "n1"}))
+	subnet.SetName("sn-1")
+	subnet.SetLabels(map[string]string{
+		labels.ComputeKeyedLabelKey("team"): "blue",
+		labels.InternalTenantLabel:          "t1",
+	})
+
+	dynFake := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{gvr: "SubnetList"}, subnet)
+	reader := NewLabelReaderAdapter(dynFake, map[string]schema.GroupVersionResource{
+		LabelReaderKey("seca.network", "networks/subnets"): gvr,
+	})
+	claim := authzport.AuthorizationClaim{
+		Provider: "seca.network", Resource: "networks/subnets",
+		Tenant: "t1", Workspace: "w1", Network: "n1", Name: "sn-1",
+	}
+
+	got, found, err := reader.StoredLabels(context.Background(), claim)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, map[string]string{"team": "blue"}, got)
+
+	claim.Name = "sn-2"
+	_, found, err = reader.StoredLabels(context.Background(), claim)
+	require.NoError(t, err)
+	require.False(t, found, "a resource not stored yet is being created")
+
+	claim.Resource = "networks/unknown"
+	got, found, err = reader.StoredLabels(context.Background(), claim)
+	require.NoError(t, err)
+	require.True(t, found, "an unknown kind must not fall back to the body")
+	require.Nil(t, got)
+}
@@ -57,7 +57,7 @@ func NewAuthentication(a authnport.Authenticator, log *slog.Logger) func(http.Ha
 				return
 			}
 
-			next.ServeHTTP(w, r.WithContext(contextWithIdentity(r.Context(), identity)))
+			next.ServeHTTP(w, r.WithContext(ContextWithIdentity(r.Context(), identity)))
 		})
 	}
 }
@@ -97,7 +97,7 @@ func TestNewAuthorization(t *testing.T) {
 			r := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
 
 			if tc.identity != nil {
-				r = r.WithContext(contextWithIdentity(r.Context(), tc.identity))
+				r = r.WithContext(ContextWithIdentity(r.Context(), tc.identity))
 			}
 
 			mw(okHandler).ServeHTTP(w, r)
@@ -131,7 +131,7 @@ func TestNewAuthorization_TokenScopeFromIdentity(t *testing.T) {
 
 	w := httptest.NewRecorder()
 	r := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
-	r = r.WithContext(contextWithIdentity(r.Context(), alice))
+	r = r.WithContext(ContextWithIdentity(r.Context(), alice))
 	mw(okHandler).ServeHTTP(w, r)
 
 	want := resource.TokenScope{
@@ -1,11 +1,16 @@
 package middleware
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"net/netip"
 	"strings"
 
 	"github.com/eu-sovereign-cloud/ecp/framework/frontend/config"
+	rest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
 	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
 )
 
@@ -16,21 +21,27 @@ import (
 //   - Provider: baked-in constant passed to this constructor (e.g. "seca.compute").
 //   - Tenant: r.PathValue("tenant").
 //   - Workspace: r.PathValue("workspace"); empty for tenant-scoped resources.
+//   - Network: r.PathValue("network"); empty for resources not scoped to a network.
 //   - Region: config.Singleton().Region(); empty on the global server.
 //   - Name: r.PathValue("name"); empty for collection (list) operations.
 //   - Resource: the resource kind path derived from r.Pattern (see resourceAndVerb).
 //     Examples: "instances", "networks/subnets", "roles".
 //   - Verb: derived from r.Method and the matched route pattern:
 //     GET collection → "list", GET item → "get", PUT → "put", DELETE → "delete",
-//     POST /{name}/{action} → "post.<action>".
+//     POST /{name}/{action} → "post.<action>", GET /{name}/{action} → "get.<action>".
+//   - SourceIP: the peer address from r.RemoteAddr. Forwarding headers are not trusted;
+//     behind a proxy this is the proxy's address.
+//   - ResourceLabels: for a PUT or DELETE of a stored resource, its stored labels as read by
+//     stored; for a PUT creating a resource, the top-level "labels" object of the body; nil
+//     otherwise. Without stored, no request carries labels.
+//   - RequestLabels: for a PUT, the top-level "labels" object of the body, empty when it has
+//     none; nil otherwise. Without stored, no request carries labels.
 //
 // SECAClaimExtractor reads r.Pattern (available after mux routing in Go 1.22+),
 // so it MUST be used after the request has been matched by the mux — which is
 // guaranteed when the extractor runs inside oapi-codegen's per-route middleware chain.
-func SECAClaimExtractor(provider, baseURL string) authzport.ClaimExtractor {
+func SECAClaimExtractor(provider, baseURL string, stored authzport.LabelReader) authzport.ClaimExtractor {
 	return func(r *http.Request) (authzport.AuthorizationClaim, error) {
-		tenant := r.PathValue("tenant")
-		workspace := r.PathValue("workspace")
 		name := r.PathValue("name")
 
 		resource, verb, err := resourceAndVerb(r, baseURL, name)
@@ -38,16 +49,86 @@ func SECAClaimExtractor(provider, baseURL string) authzport.ClaimExtractor {
 			return authzport.AuthorizationClaim{}, fmt.Errorf("extract authorization claim: %w", err)
 		}
 
-		return authzport.AuthorizationClaim{
+		claim := authzport.AuthorizationClaim{
 			Provider:  provider,
 			Resource:  resource,
 			Name:      name,
 			Verb:      verb,
-			Tenant:    tenant,
+			Tenant:    r.PathValue("tenant"),
 			Region:    config.Singleton().Region(),
-			Workspace: workspace,
-		}, nil
+			Workspace: r.PathValue("workspace"),
+			Network:   r.PathValue("network"),
+			SourceIP:  sourceIP(r),
+		}
+		if claim.ResourceLabels, claim.RequestLabels, err = resourceLabels(r, claim, stored); err != nil {
+			return authzport.AuthorizationClaim{}, fmt.Errorf("extract authorization claim: %w", err)
+		}
+		return claim, nil
+	}
+}
+
+// resourceLabels returns the labels r is authorized against: those of the resource and, for a
+// PUT, those the body sets. An update or a delete is judged by the labels the resource already
+// has: judging it by the body alone would let a caller take over any resource by claiming
+// matching labels. An update is judged by the labels it sets as well, so that it cannot move
+// the resource out of the caller's scope. Only a create has nothing stored, and is judged by
+// the labels it sets.
+func resourceLabels(r *http.Request, claim authzport.AuthorizationClaim, stored authzport.LabelReader) (resource, request map[string]string, err error) {
+	if stored == nil || claim.Name == "" || (r.Method != http.MethodPut && r.Method != http.MethodDelete) {
+		return nil, nil, nil
 	}
+	labels, found, err := stored.StoredLabels(r.Context(), claim)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read stored labels: %w", err)
+	}
+	if r.Method == http.MethodDelete {
+		return labels, nil, nil
+	}
+	if request, err = bodyLabels(r); err != nil {
+		return nil, nil, err
+	}
+	if request == nil {
+		// A PUT replaces the labels: one setting none clears them.
+		request = map[string]string{}
+	}
+	if !found {
+		return request, request, nil
+	}
+	return labels, request, nil
+}
+
+// sourceIP returns the peer address of r, or the zero Addr when RemoteAddr is not an
+// ip:port pair (e.g. in tests that build requests by hand).
+func sourceIP(r *http.Request) netip.Addr {
+	addrPort, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addrPort.Addr().Unmap()
+}
+
+// bodyLabels reads the top-level "labels" object from the request body and restores
+// the body for the handler. A body that is not a JSON object yields no labels: the
+// handler rejects it with a proper 400 later, which is a better answer than a 500 here.
+// Only a failure to read the body at all is returned as an error.
+func bodyLabels(r *http.Request) (map[string]string, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	data, err := io.ReadAll(io.LimitReader(r.Body, rest.MaxRequestBodyBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	var body struct {
+		Labels map[string]string `json:"labels"`
+	}
+	if json.Unmarshal(data, &body) != nil {
+		return nil, nil
+	}
+	return body.Labels, nil
 }
 
 // resourceAndVerb derives the resource kind path and RBAC verb from the matched
@@ -112,10 +193,16 @@ func resourceAndVerb(r *http.Request, baseURL, name string) (resource, verb stri
 		}
 
 	case http.MethodGet:
-		if name == "" {
+		switch {
+		case name == "":
 			verb = "list"
 			// Last segment is the collection name; keep it.
-		} else {
+		case len(segs) >= 2 && !isWildcard(segs[len(segs)-1]) && isWildcard(segs[len(segs)-2]):
+			// GET action routes, such as the events of a resource, end with /{name}/<action>
+			// like the POST ones, and are read under the get verb of the resource.
+			verb = "get." + segs[len(segs)-1]
+			segs = segs[:len(segs)-2] // drop {name} and action
+		default:
 			verb = "get"
 			if len(segs) > 0 && isWildcard(segs[len(segs)-1]) {
 				segs = segs[:len(segs)-1] // drop {name}
@@ -2,9 +2,15 @@ package middleware
 
 import (
 	"context"
+	"io"
+	"maps"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
+	"strings"
 	"testing"
+
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
 )
 
 // TestResourceAndVerb verifies the route-pattern parser that derives (resource,verb)
@@ -70,6 +76,15 @@ func TestResourceAndVerb(t *testing.T) {
 			wantResource: "instances",
 			wantVerb:     "post.restart",
 		},
+		{
+			name:         "GET action → get.<action>",
+			base:         "/providers/seca.network",
+			pattern:      "GET /providers/seca.network/v1/tenants/{tenant}/workspaces/{workspace}/networks/{network}/subnets/{name}/events",
+			pathValues:   map[string]string{"tenant": "t1", "workspace": "w1", "network": "net1", "name": "sub1"},
+			method:       http.MethodGet,
+			wantResource: "networks/subnets",
+			wantVerb:     "get.events",
+		},
 		{
 			name:         "GET tenant-scoped collection (no workspace) → list",
 			base:         "/providers/seca.compute",
@@ -145,6 +160,125 @@ func TestResourceAndVerb_NetworkBase(t *testing.T) {
 	}
 }
 
+func TestBodyLabels(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		want map[string]string
+	}{
+		{name: "labels object", body: `{"labels":{"env":"dev"},"spec":{}}`, want: map[string]string{"env": "dev"}},
+		{name: "no labels", body: `{"spec":{}}`, want: nil},
+		{name: "not JSON", body: `not json`, want: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			r := httptest.NewRequestWithContext(context.Background(), http.MethodPut, "/", strings.NewReader(tc.body))
+
+			got, err := bodyLabels(r)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !maps.Equal(got, tc.want) {
+				t.Errorf("labels = %v, want %v", got, tc.want)
+			}
+			// The handler must still see the full body.
+			rest, _ := io.ReadAll(r.Body)
+			if string(rest) != tc.body {
+				t.Errorf("body after extraction = %q, want %q", rest, tc.body)
+			}
+		})
+	}
+}
+
+// storedLabels is a LabelReader over a fixed set of stored resources, by name.
+type storedLabels map[string]map[string]string
+
+func (s storedLabels) StoredLabels(_ context.Context, claim authzport.AuthorizationClaim) (map[string]string, bool, error) {
+	labels, ok := s[claim.Name]
+	return labels, ok, nil
+}
+
+// TestSECAClaimExtractor_ResourceLabels pins where the labels of a request come from: a
+// create is judged by its body, an update by what is stored and its body, a delete by what
+// is stored.
+func TestSECAClaimExtractor_ResourceLabels(t *testing.T) {
+	t.Parallel()
+
+	const base = "/providers/seca.network"
+	const pattern = base + "/v1/tenants/{tenant}/workspaces/{workspace}/networks/{network}/subnets/{name}"
+	stored := storedLabels{"existing": {"team": "blue"}}
+	body := `{"labels":{"team":"red"}}`
+
+	red := map[string]string{"team": "red"}
+	blue := map[string]string{"team": "blue"}
+
+	tests := []struct {
+		name        string
+		method      string
+		target      string
+		body        string
+		stored      authzport.LabelReader
+		want        map[string]string
+		wantRequest map[string]string
+	}{
+		{name: "create uses the body", method: http.MethodPut, target: "new", stored: stored, want: red, wantRequest: red},
+		{name: "update uses the stored labels and the body", method: http.MethodPut, target: "existing", stored: stored, want: blue, wantRequest: red},
+		{name: "update clearing the labels sets none", method: http.MethodPut, target: "existing", body: `{}`, stored: stored, want: blue, wantRequest: map[string]string{}},
+		{name: "delete uses the stored labels", method: http.MethodDelete, target: "existing", stored: stored, want: blue},
+		{name: "delete of a missing resource has none", method: http.MethodDelete, target: "new", stored: stored, want: nil},
+		{name: "read has none", method: http.MethodGet, target: "existing", stored: stored, want: nil},
+		{name: "without a reader nothing carries labels", method: http.MethodPut, target: "new", want: nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			reqBody := body
+			if tc.body != "" {
+				reqBody = tc.body
+			}
+			r := httptest.NewRequestWithContext(context.Background(), tc.method, "/", strings.NewReader(reqBody))
+			r.Pattern = tc.method + " " + pattern
+			for k, v := range map[string]string{"tenant": "t1", "workspace": "w1", "network": "n1", "name": tc.target} {
+				r.SetPathValue(k, v)
+			}
+
+			claim, err := SECAClaimExtractor("seca.network", base, tc.stored)(r)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claim.Network != "n1" {
+				t.Errorf("network = %q, want n1", claim.Network)
+			}
+			if !maps.Equal(claim.ResourceLabels, tc.want) {
+				t.Errorf("labels = %v, want %v", claim.ResourceLabels, tc.want)
+			}
+			if !maps.Equal(claim.RequestLabels, tc.wantRequest) || (claim.RequestLabels == nil) != (tc.wantRequest == nil) {
+				t.Errorf("request labels = %v, want %v", claim.RequestLabels, tc.wantRequest)
+			}
+		})
+	}
+}
+
+func TestSourceIP(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+	r.RemoteAddr = "[::ffff:10.1.2.3]:4711"
+	if got := sourceIP(r); got != netip.MustParseAddr("10.1.2.3") {
+		t.Errorf("sourceIP = %v, want 10.1.2.3", got)
+	}
+
+	r.RemoteAddr = "pipe"
+	if got := sourceIP(r); got.IsValid() {
+		t.Errorf("sourceIP = %v, want the zero Addr", got)
+	}
+}
+
 // newPatternRequest creates an *http.Request with r.Pattern and path values set,
 // mimicking what the Go 1.22+ mux does after a successful route match.
 func newPatternRequest(method, url, pattern string, pathValues map[string]string) *http.Request {
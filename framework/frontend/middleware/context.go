@@ -20,8 +20,9 @@ import (
 // third-party packages.
 type identityContextKey struct{}
 
-// contextWithIdentity stores the resolved Identity in the request context.
-func contextWithIdentity(ctx context.Context, id *authnport.Identity) context.Context {
+// ContextWithIdentity stores the resolved Identity in the request context. The
+// authentication middleware calls it; a handler test stands in for the middleware with it.
+func ContextWithIdentity(ctx context.Context, id *authnport.Identity) context.Context {
 	return context.WithValue(ctx, identityContextKey{}, id)
 }
 
@@ -2,10 +2,15 @@ package rest
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
 
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/integrity"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 )
 
 // Deleter defines the interface for controller Delete operations.
@@ -30,3 +35,41 @@ func HandleDelete(
 
 	w.WriteHeader(http.StatusAccepted)
 }
+
+// DeleterWithIntegrity returns a Deleter that refuses, with a conflict naming them, to
+// delete a resource of the kind path resourceKind (e.g. "subnets") while checker finds
+// resources referencing it, and delegates to next otherwise. A nil checker returns next.
+func DeleterWithIntegrity(checker integrity.Checker, resourceKind string, next Deleter) Deleter {
+	if checker == nil {
+		return next
+	}
+	return &integrityDeleter{checker: checker, resource: resourceKind, next: next}
+}
+
+type integrityDeleter struct {
+	checker  integrity.Checker
+	resource string
+	next     Deleter
+}
+
+func (d *integrityDeleter) Do(ctx context.Context, ir persistence.IdentifiableResource) error {
+	target := integrity.Target{
+		Resource: d.resource,
+		Scope:    resource.Scope{Tenant: ir.GetTenant(), Workspace: ir.GetWorkspace()},
+		Name:     ir.GetName(),
+	}
+	if ns, ok := ir.(persistence.NetworkScope); ok {
+		target.Network = ns.GetNetwork()
+	}
+
+	referrers, err := d.checker.Referrers(ctx, target)
+	if err != nil {
+		return err
+	}
+	if len(referrers) > 0 {
+		return kernel.NewError(kernel.KindConflict,
+			fmt.Errorf("%s %q is still referenced by %s", d.resource, ir.GetName(), strings.Join(referrers, ", ")),
+			kernel.ErrorSource{Name: "name", Value: ir.GetName()})
+	}
+	return d.next.Do(ctx, ir)
+}
@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/integrity"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+)
+
+// mockDeleter records whether it was called and returns the preset error.
+type mockDeleter struct {
+	called bool
+	err    error
+}
+
+func (m *mockDeleter) Do(_ context.Context, _ persistence.IdentifiableResource) error {
+	m.called = true
+	return m.err
+}
+
+// mockChecker returns the preset referrers or error, recording the target it was asked about.
+type mockChecker struct {
+	target    integrity.Target
+	referrers []string
+	err       error
+}
+
+func (m *mockChecker) Referrers(_ context.Context, target integrity.Target) ([]string, error) {
+	m.target = target
+	return m.referrers, m.err
+}
+
+// networkResource is a testResource scoped under a network.
+type networkResource struct {
+	testResource
+	network string
+}
+
+func (r *networkResource) GetNetwork() string { return r.network }
+
+func serveDelete(ir persistence.IdentifiableResource, deleter Deleter) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodDelete, "/test", nil)
+	HandleDelete(rec, req, slog.New(slog.NewTextHandler(io.Discard, nil)), ir, deleter)
+	return rec
+}
+
+func TestHandleDelete_WithIntegrity(t *testing.T) {
+	t.Run("deletes an unreferenced resource", func(t *testing.T) {
+		next := &mockDeleter{}
+		checker := &mockChecker{}
+		res := &networkResource{testResource: testResource{name: "sn-1", tenant: "t1", workspace: "ws-1"}, network: "net-1"}
+
+		rec := serveDelete(res, DeleterWithIntegrity(checker, "subnets", next))
+
+		if rec.Code != http.StatusAccepted {
+			t.Fatalf("expected 202, got %d", rec.Code)
+		}
+		if !next.called {
+			t.Fatal("expected the delete to go through")
+		}
+		if checker.target.Resource != "subnets" || checker.target.Network != "net-1" || checker.target.Scope.Workspace != "ws-1" {
+			t.Fatalf("unexpected target %+v", checker.target)
+		}
+	})
+
+	t.Run("refuses to delete a referenced resource", func(t *testing.T) {
+		next := &mockDeleter{}
+		checker := &mockChecker{referrers: []string{"workspaces/ws-1/nics/nic-1", "workspaces/ws-1/nics/nic-2"}}
+		res := &testResource{name: "sn-1", tenant: "t1", workspace: "ws-1"}
+
+		rec := serveDelete(res, DeleterWithIntegrity(checker, "subnets", next))
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected 409, got %d", rec.Code)
+		}
+		if next.called {
+			t.Fatal("expected the delete to be refused")
+		}
+		if !strings.Contains(rec.Body.String(), "workspaces/ws-1/nics/nic-1, workspaces/ws-1/nics/nic-2") {
+			t.Fatalf("expected the referrers in the body, got %s", rec.Body.String())
+		}
+	})
+
+	t.Run("fails when the referrers cannot be listed", func(t *testing.T) {
+		next := &mockDeleter{}
+		checker := &mockChecker{err: errors.New("boom")}
+
+		rec := serveDelete(&testResource{name: "sn-1"}, DeleterWithIntegrity(checker, "subnets", next))
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("expected 500, got %d", rec.Code)
+		}
+		if next.called {
+			t.Fatal("expected the delete not to go through")
+		}
+	})
+
+	t.Run("a nil checker deletes directly", func(t *testing.T) {
+		next := &mockDeleter{}
+		if DeleterWithIntegrity(nil, "subnets", next) != Deleter(next) {
+			t.Fatal("expected next to be returned")
+		}
+	})
+}
@@ -13,7 +13,9 @@ import (
 	"github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 
 	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 )
 
 // Creator defines the interface for controller Create operations.
@@ -36,15 +38,21 @@ type UpsertOptions[In any, D any, Out any] struct {
 	Updater     Updater[D]
 	APIToDomain APIToDomain[In, D]
 	DomainToAPI DomainToAPI[D, Out]
+	// Admission reviews the domain object before it is created or updated. Nil admits
+	// every write.
+	Admission admission.Reviewer
+	// Resource is the resource kind path passed to Admission (e.g. "instances").
+	Resource string
 }
 
 // HandleUpsert is a generic helper for PUT endpoints that:
 // 1. Decodes the JSON request body.
 // 2. Maps SDK to domain.
-// 3. Calls the creator or updater to create or update the resource.
-// 4. Handles errors appropriately.
-// 5. Maps domain to SDK.
-// 6. Encodes and writes the JSON response.
+// 3. Reviews the domain object through the admission reviewer, when one is set.
+// 4. Calls the creator or updater to create or update the resource.
+// 5. Handles errors appropriately.
+// 6. Maps domain to SDK.
+// 7. Encodes and writes the JSON response.
 func HandleUpsert[In any, D any, Out any](
 	w http.ResponseWriter,
 	r *http.Request,
@@ -87,6 +95,23 @@ func HandleUpsert[In any, D any, Out any](
 
 	domainObj := options.APIToDomain(apiObj, options.Params)
 
+	if options.Admission != nil {
+		if err := options.Admission.Review(r.Context(), admission.Request{
+			Resource: options.Resource,
+			Scope:    resource.Scope{Tenant: options.Params.GetTenant(), Workspace: options.Params.GetWorkspace()},
+			Name:     options.Params.GetName(),
+			Object:   domainObj,
+		}); err != nil {
+			if errors.Is(err, kernel.ErrValidation) || errors.Is(err, kernel.ErrForbidden) {
+				logger.InfoContext(r.Context(), "write rejected by admission", slog.Any("error", err))
+			} else {
+				logger.ErrorContext(r.Context(), "failed to review resource", slog.Any("error", err))
+			}
+			WriteErrorResponse(w, r, logger, err)
+			return
+		}
+	}
+
 	// Determine whether to create or update based on the presence of a resource version.
 	shouldUpdate := options.Params.GetVersion() != ""
 
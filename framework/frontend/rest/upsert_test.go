@@ -15,6 +15,7 @@ import (
 
 	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
 )
 
@@ -92,6 +93,17 @@ func (m *MockUpdater[T]) Do(ctx context.Context, resource T) (T, error) {
 	return zero, args.Error(1)
 }
 
+// stubReviewer records the admission request it is given and returns err.
+type stubReviewer struct {
+	got *admission.Request
+	err error
+}
+
+func (s *stubReviewer) Review(_ context.Context, req admission.Request) error {
+	s.got = &req
+	return s.err
+}
+
 // ---------------------------------------------------------------------------
 // Shared test helpers
 // ---------------------------------------------------------------------------
@@ -478,3 +490,112 @@ func TestHandleUpsert_UpdateFailsValidation(t *testing.T) {
 	creator.AssertNotCalled(t, "Do")
 	updater.AssertExpectations(t)
 }
+
+func TestHandleUpsert_AdmissionRejects(t *testing.T) {
+	creator := &MockCreator[TestDomain]{}
+	updater := &MockUpdater[TestDomain]{}
+	reviewer := &stubReviewer{err: kernel.NewError(kernel.KindValidation, errors.New("data must not be empty"),
+		kernel.ErrorSource{Name: "/data"})}
+
+	recorder := httptest.NewRecorder()
+	frest.HandleUpsert(recorder, newUpsertRequest(`{"data":""}`), discardLogger(),
+		frest.UpsertOptions[TestIn, TestDomain, TestOut]{
+			Params:      upsertParams,
+			Creator:     creator,
+			Updater:     updater,
+			APIToDomain: apiToTestDomain,
+			DomainToAPI: domainToTestOut,
+			Admission:   reviewer,
+			Resource:    "resources",
+		},
+	)
+
+	resp := recorder.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), "\"/data\"")
+	creator.AssertNotCalled(t, "Do")
+	updater.AssertNotCalled(t, "Do")
+}
+
+func TestHandleUpsert_AdmissionAdmits(t *testing.T) {
+	creator := &MockCreator[TestDomain]{}
+	updater := &MockUpdater[TestDomain]{}
+	reviewer := &stubReviewer{}
+	creator.On("Do", mock.Anything, mock.Anything).Return(TestDomain{ID: "test-resource", Data: "hello"}, nil)
+
+	recorder := httptest.NewRecorder()
+	frest.HandleUpsert(recorder, newUpsertRequest(`{"data":"hello"}`), discardLogger(),
+		frest.UpsertOptions[TestIn, TestDomain, TestOut]{
+			Params:      upsertParams,
+			Creator:     creator,
+			Updater:     updater,
+			APIToDomain: apiToTestDomain,
+			DomainToAPI: domainToTestOut,
+			Admission:   reviewer,
+			Resource:    "resources",
+		},
+	)
+
+	resp := recorder.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	creator.AssertExpectations(t)
+	if assert.NotNil(t, reviewer.got) {
+		assert.Equal(t, "resources", reviewer.got.Resource)
+		assert.Equal(t, "test-tenant", reviewer.got.Scope.Tenant)
+		assert.Equal(t, "test-workspace", reviewer.got.Scope.Workspace)
+		assert.Equal(t, "test-resource", reviewer.got.Name)
+		assert.Equal(t, TestDomain{ID: "test-resource", Data: "hello"}, reviewer.got.Object)
+	}
+}
+
+func TestHandleUpsert_AdmissionFails(t *testing.T) {
+	creator := &MockCreator[TestDomain]{}
+	updater := &MockUpdater[TestDomain]{}
+	reviewer := &stubReviewer{err: kernel.NewError(kernel.KindInternal, errors.New("policies not synced"))}
+
+	recorder := httptest.NewRecorder()
+	frest.HandleUpsert(recorder, newUpsertRequest(`{"data":"hello"}`), discardLogger(),
+		frest.UpsertOptions[TestIn, TestDomain, TestOut]{
+			Params:      upsertParams,
+			Creator:     creator,
+			Updater:     updater,
+			APIToDomain: apiToTestDomain,
+			DomainToAPI: domainToTestOut,
+			Admission:   reviewer,
+		},
+	)
+
+	resp := recorder.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	creator.AssertNotCalled(t, "Do")
+}
+
+func TestHandleUpsert_AdmissionForbids(t *testing.T) {
+	creator := &MockCreator[TestDomain]{}
+	updater := &MockUpdater[TestDomain]{}
+	reviewer := &stubReviewer{err: kernel.NewError(kernel.KindForbidden, errors.New("quota default exceeded"))}
+
+	recorder := httptest.NewRecorder()
+	frest.HandleUpsert(recorder, newUpsertRequest(`{"data":"hello"}`), discardLogger(),
+		frest.UpsertOptions[TestIn, TestDomain, TestOut]{
+			Params:      upsertParams,
+			Creator:     creator,
+			Updater:     updater,
+			APIToDomain: apiToTestDomain,
+			DomainToAPI: domainToTestOut,
+			Admission:   reviewer,
+			Resource:    "resources",
+		},
+	)
+
+	resp := recorder.Result()
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), "quota default exceeded")
+	creator.AssertNotCalled(t, "Do")
+}
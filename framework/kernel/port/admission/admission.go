@@ -0,0 +1,39 @@
+// Package admission defines the admission port consulted by the REST layer before a
+// resource is created or updated.
+//
+// Authorization decides who may act; admission governs what they write. Implementations
+// live in the gateway module (e.g. the tenant admission policy engine) and are injected
+// via constructor arguments so the framework layer stays resource-agnostic.
+package admission
+
+import (
+	"context"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+)
+
+// Request describes one write under review.
+type Request struct {
+	// Resource is the resource kind path being written (e.g. "instances").
+	Resource string
+	// Scope is the tenant and, for workspace-scoped resources, the workspace written to.
+	Scope resource.Scope
+	// Name is the name of the resource being written.
+	Name string
+	// Object is the domain object about to be created or updated, as mapped from the
+	// request body. Reviewers MUST NOT modify it.
+	Object any
+}
+
+// Reviewer decides whether a write may proceed.
+//
+// Implementations return:
+//   - nil: the write is admitted;
+//   - an error of kind kernel.KindValidation: the write is rejected with HTTP 422. Its
+//     sources point at the offending parts of the request body;
+//   - an error of kind kernel.KindForbidden: the write is well-formed but not allowed,
+//     e.g. because it would exceed a quota, and is rejected with HTTP 403;
+//   - any other error: the review could not be completed; the write is not performed.
+type Reviewer interface {
+	Review(ctx context.Context, req Request) error
+}
@@ -7,6 +7,7 @@ package authz
 import (
 	"context"
 	"net/http"
+	"net/netip"
 
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 )
@@ -67,6 +68,32 @@ type AuthorizationClaim struct {
 	// Workspace is the workspace identifier extracted from the request path, or
 	// empty for tenant-scoped (non-workspace) resources.
 	Workspace string
+	// Network is the parent network extracted from the request path for network-scoped
+	// resources (e.g. "networks/subnets"), or empty otherwise.
+	Network string
+
+	// SourceIP is the address of the peer that sent the request. It is the zero Addr
+	// when the peer address cannot be parsed. Conditional role assignments match it
+	// against their source CIDRs.
+	SourceIP netip.Addr
+	// ResourceLabels are the labels of the target resource: those the request sets when it
+	// creates the resource, and those already stored when it updates or deletes it. For any
+	// other request it is nil, so a role assignment conditioned on resource labels never
+	// grants reads.
+	ResourceLabels map[string]string
+	// RequestLabels are the labels a PUT body sets on the resource, empty but not nil when it
+	// sets none; nil for any other request. On an update they differ from ResourceLabels, and
+	// a label condition must hold for both: otherwise a caller could relabel a resource out of
+	// the scope it was granted.
+	RequestLabels map[string]string
+}
+
+// LabelReader reads the labels of stored resources, so that updates and deletes are
+// authorized against the labels a resource has rather than those a request claims.
+type LabelReader interface {
+	// StoredLabels returns the labels of the resource claim names, and false when no such
+	// resource is stored.
+	StoredLabels(ctx context.Context, claim AuthorizationClaim) (map[string]string, bool, error)
 }
 
 // Checker evaluates whether an AuthorizationClaim is permitted.
@@ -0,0 +1,29 @@
+package backend
+
+import "context"
+
+// AppliedSpec is what the controller knows, for one reconcile, of the spec a plugin last applied
+// to the provider. It travels in the context handed to PluginHandler.HandleReconcile, so that the
+// update path can skip a plugin call that would change nothing.
+type AppliedSpec struct {
+	// Current is set by the controller when the spec and labels of the resource are those the
+	// plugin last applied successfully, and no drift check is due.
+	Current bool
+	// Applied is set by the handler once the plugin applied the spec successfully.
+	Applied bool
+}
+
+// appliedSpecContextKey is the unexported type used as the context key for the AppliedSpec.
+type appliedSpecContextKey struct{}
+
+// ContextWithAppliedSpec returns a copy of ctx carrying applied.
+func ContextWithAppliedSpec(ctx context.Context, applied *AppliedSpec) context.Context {
+	return context.WithValue(ctx, appliedSpecContextKey{}, applied)
+}
+
+// AppliedSpecFromContext returns the AppliedSpec ctx carries, or nil when the caller of the
+// handler tracks nothing: every update is then handed to the plugin.
+func AppliedSpecFromContext(ctx context.Context) *AppliedSpec {
+	applied, _ := ctx.Value(appliedSpecContextKey{}).(*AppliedSpec)
+	return applied
+}
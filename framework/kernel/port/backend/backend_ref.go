@@ -0,0 +1,21 @@
+package backend
+
+import "context"
+
+// BackendRef names one provider-side object backing a SECA resource, such as the Aruba CR
+// a plugin writes or the external name of a Crossplane managed resource.
+type BackendRef struct {
+	// Kind is the provider's kind of the object, e.g. "VPC" or "Lan".
+	Kind string `json:"kind"`
+	// ID identifies the object at the provider, e.g. "<namespace>/<name>" of a CR.
+	ID string `json:"id"`
+}
+
+// BackendReferencer is optionally implemented by a CSP plugin to name the provider-side
+// objects backing a resource. The controller records them on the resource once it is
+// active, where the sovereignty report reads them; see doc/SOVEREIGNTY.md.
+type BackendReferencer[T any] interface {
+	// BackendRefs returns the objects backing resource. It must not write, and should
+	// return the objects the plugin would create even when some are not yet ready.
+	BackendRefs(ctx context.Context, resource T) ([]BackendRef, error)
+}
@@ -0,0 +1,33 @@
+package backend
+
+import (
+	"context"
+	"time"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+)
+
+// Event is one occurrence in the life of a resource, recorded by its controller: a state
+// transition, a failure, a wait on a dependency or a blocked deletion.
+type Event struct {
+	// Warning marks a failure, or anything else holding the resource back.
+	Warning bool
+	// Reason is why the event occurred, in CamelCase.
+	Reason string
+	// Message is a human-readable description of the event.
+	Message string
+	// State is the state of the resource the event left it in.
+	State string
+	// Count is how many times the event occurred; repeats are folded into one Event.
+	Count int
+	// FirstAt and LastAt are when the event first and last occurred.
+	FirstAt time.Time
+	LastAt  time.Time
+}
+
+// EventReader reads the events recorded for resources.
+type EventReader interface {
+	// Events returns the events of the resource of kind identified by ir, oldest first. A
+	// resource without events, or whose events have expired, has none.
+	Events(ctx context.Context, kind string, ir persistence.IdentifiableResource) ([]Event, error)
+}
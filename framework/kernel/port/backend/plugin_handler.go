@@ -3,6 +3,7 @@ package backend
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
 )
@@ -24,6 +25,11 @@ var (
 	//
 	//	fmt.Errorf("%w: an Aruba VPC's region cannot be changed after creation", backend.ErrNotSupported)
 	ErrNotSupported = errors.New("operation not supported by the provider")
+
+	// ErrTimeout is returned, wrapped, by an operation cut off at its deadline. The handler
+	// records it like any other failure, with its own condition reason, and the operation is
+	// retried with backoff.
+	ErrTimeout = errors.New("operation timed out")
 )
 
 // PluginHandler defines the contract for handling resource-specific logic.
@@ -48,6 +54,27 @@ type PluginHandler[T persistence.IdentifiableResource] interface {
 // CSP plugin. It receives a context and the resource to operate on.
 type DelegatedFunc[T persistence.IdentifiableResource] func(ctx context.Context, resource T) error
 
+// Operation names an operation delegated to a CSP plugin, for its deadline and metrics.
+type Operation string
+
+const (
+	OperationCreate       Operation = "Create"
+	OperationUpdate       Operation = "Update"
+	OperationDelete       Operation = "Delete"
+	OperationIncreaseSize Operation = "IncreaseSize"
+	OperationPowerOn      Operation = "PowerOn"
+	OperationPowerOff     Operation = "PowerOff"
+)
+
+// Operations lists every delegated operation.
+var Operations = []Operation{
+	OperationCreate, OperationUpdate, OperationDelete, OperationIncreaseSize, OperationPowerOn, OperationPowerOff,
+}
+
+// Timeouts maps delegated operations to the time they may take. An operation without a
+// positive timeout runs until it returns.
+type Timeouts map[Operation]time.Duration
+
 // RejectionConditionFunc is a function type that defines a condition for
 // rejecting a resource. It should return an error if an unwanted condition is
 // detected (e.g., decreasing the size of a block storage).
@@ -0,0 +1,33 @@
+// Package integrity defines the referential-integrity port consulted by the REST layer
+// before a resource is deleted.
+//
+// A resource other resources still reference, such as a subnet a NIC is attached to, must
+// not be deleted: the provider would be left to fail on the referrers in confusing ways.
+// The implementation lives in framework/backend/kubernetes/refgraph and is injected via
+// constructor arguments so the framework layer stays resource-agnostic.
+package integrity
+
+import (
+	"context"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+)
+
+// Target identifies the resource about to be deleted.
+type Target struct {
+	// Resource is the resource kind path (e.g. "subnets").
+	Resource string
+	// Scope is the tenant and, for workspace-scoped resources, the workspace.
+	Scope resource.Scope
+	// Network is the network of network-scoped resources (e.g. subnets), or "".
+	Network string
+	// Name is the name of the resource.
+	Name string
+}
+
+// Checker finds the resources referencing a target.
+type Checker interface {
+	// Referrers returns the resources referencing target, as resource paths relative to
+	// its tenant (e.g. "workspaces/ws-1/nics/nic-1"). None means target may be deleted.
+	Referrers(ctx context.Context, target Target) ([]string, error)
+}
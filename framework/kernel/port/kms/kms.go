@@ -0,0 +1,24 @@
+// Package kms defines the key management port used to encrypt sensitive resource fields
+// at rest.
+//
+// Fields are envelope-encrypted: each value is encrypted under a fresh data encryption key
+// (DEK), and only the DEK goes to the KMS, to be wrapped under a key encryption key (KEK)
+// the KMS holds and never releases. The local key-file implementation lives in
+// framework/backend/envelope; a cloud or HSM-backed KMS implements the same interface.
+package kms
+
+// KMS wraps and unwraps data encryption keys under its key encryption keys.
+//
+// A KMS may hold several KEKs: the current one wraps new DEKs, and the previous ones still
+// unwrap the DEKs they wrapped, so that rotating the KEK does not require re-encrypting
+// every stored value at once. The methods take no context because they are called from
+// the synchronous CR conversion path; a remote implementation bounds its own calls.
+type KMS interface {
+	// Wrap encrypts dek under the current KEK and returns the ID of that KEK with the
+	// wrapped key. The ID is stored in clear next to the ciphertext.
+	Wrap(dek []byte) (keyID string, wrapped []byte, err error)
+	// Unwrap decrypts a DEK wrapped under the KEK keyID, current or not.
+	Unwrap(keyID string, wrapped []byte) ([]byte, error)
+	// CurrentKeyID returns the ID of the KEK Wrap uses.
+	CurrentKeyID() string
+}
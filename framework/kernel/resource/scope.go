@@ -15,7 +15,9 @@ func (r Scope) GetWorkspace() string { return r.Workspace }
 // It is distinct from Scope: Scope identifies the single tenant/workspace one resource
 // belongs to (a scalar identity), whereas TokenScope is a cap over the request's scope —
 // each dimension is a list of permitted values, and an empty (nil) list leaves that
-// dimension unconstrained. It also covers Region, which Scope does not.
+// dimension unconstrained. It also covers Region, which Scope does not, and what the
+// request does: its provider, verb and resource. A monitoring agent's token, for example,
+// can be capped to {"providers":["seca.compute"],"verbs":["get","list"]}.
 //
 // A TokenScope is carried verbatim by the authenticated identity and copied into the
 // authorization claim, where it can only narrow the permissions granted by RBAC — it never
@@ -23,8 +25,9 @@ func (r Scope) GetWorkspace() string { return r.Workspace }
 // has one definition.
 //
 // The json tags describe the Dummy authenticator's token wire format,
-// base64(JSON{…,"scope":{"tenants":[…],"regions":[…],"workspaces":[…]}}), so token payloads
-// unmarshal directly into it without a separate DTO.
+// base64(JSON{…,"scope":{"tenants":[…],"regions":[…],"workspaces":[…],"providers":[…],
+// "verbs":[…],"resources":[…]}}), and of the JWT "scope" claim, so token payloads unmarshal
+// directly into it without a separate DTO.
 type TokenScope struct {
 	// Tenants restricts the token to the listed tenants; empty means any tenant.
 	Tenants []string `json:"tenants,omitempty"`
@@ -32,4 +35,16 @@ type TokenScope struct {
 	Regions []string `json:"regions,omitempty"`
 	// Workspaces restricts the token to the listed workspaces; empty means any workspace.
 	Workspaces []string `json:"workspaces,omitempty"`
+	// Providers restricts the token to the listed provider IDs (e.g. "seca.compute");
+	// empty means any provider.
+	Providers []string `json:"providers,omitempty"`
+	// Verbs restricts the token to the listed verbs, matched like a Permission's verbs:
+	// "*" is any verb and a bare verb covers its actions ("post" covers "post.start").
+	// Empty means any verb.
+	Verbs []string `json:"verbs,omitempty"`
+	// Resources restricts the token to the resources matching one of the listed glob
+	// patterns, matched like a Permission's resources against "resource" for collection
+	// requests and "resource/name" for item requests (e.g. "instances", "instances/*").
+	// Empty means any resource.
+	Resources []string `json:"resources,omitempty"`
 }
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/authz/simulate"
+)
+
+var (
+	simulatePolicyPaths []string
+	simulateCasesPath   string
+	simulateAt          string
+)
+
+var authzCMD = &cobra.Command{
+	Use:   "authz",
+	Short: "Offline tools for SECA RBAC policies",
+}
+
+var authzSimulateCMD = &cobra.Command{
+	Use:   "simulate",
+	Short: "Evaluate test cases against Role and RoleAssignment YAML without a cluster",
+	Long: `Loads Role and RoleAssignment custom resources from the --policy files or
+directories, evaluates every case of the --cases file with the gateway's RBAC
+evaluator and prints each decision with its reason.
+
+Exits 1 when a case's decision differs from its expectation, and 2 when the
+input cannot be loaded.`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		failed, err := runSimulate(cmd)
+		if err != nil {
+			fmt.Fprintln(cmd.ErrOrStderr(), "authz simulate:", err)
+			os.Exit(2)
+		}
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	authzSimulateCMD.Flags().StringSliceVar(&simulatePolicyPaths, "policy", nil, "Role/RoleAssignment YAML files or directories (repeatable)")
+	authzSimulateCMD.Flags().StringVar(&simulateCasesPath, "cases", "", "YAML file listing the cases to evaluate")
+	authzSimulateCMD.Flags().StringVar(&simulateAt, "at", "", "RFC 3339 time to evaluate cases at (default: now)")
+	_ = authzSimulateCMD.MarkFlagRequired("policy")
+	_ = authzSimulateCMD.MarkFlagRequired("cases")
+	authzCMD.AddCommand(authzSimulateCMD)
+	rootCmd.AddCommand(authzCMD)
+}
+
+// runSimulate loads the inputs, evaluates the cases and reports them on stdout. It
+// returns the number of failed cases.
+func runSimulate(cmd *cobra.Command) (int, error) {
+	now := time.Now()
+	if simulateAt != "" {
+		t, err := time.Parse(time.RFC3339, simulateAt)
+		if err != nil {
+			return 0, fmt.Errorf("--at: %w", err)
+		}
+		now = t
+	}
+
+	var policy simulate.Policy
+	for _, root := range simulatePolicyPaths {
+		if err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || (path != root && !isPolicyFile(path)) {
+				return nil
+			}
+			return loadPolicyFile(&policy, path)
+		}); err != nil {
+			return 0, err
+		}
+	}
+
+	f, err := os.Open(simulateCasesPath)
+	if err != nil {
+		return 0, fmt.Errorf("open cases: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	suite, err := simulate.LoadSuite(f)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", simulateCasesPath, err)
+	}
+
+	return simulate.Report(cmd.OutOrStdout(), simulate.Run(&policy, suite, now)), nil
+}
+
+// loadPolicyFile adds the objects of one file to policy.
+func loadPolicyFile(policy *simulate.Policy, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open policy: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	if err := policy.Load(f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// isPolicyFile reports whether a file found while walking a policy directory is loaded.
+func isPolicyFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
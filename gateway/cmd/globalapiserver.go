@@ -19,17 +19,31 @@ import (
 	regionv1 "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.region.v1"
 
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	builder "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/builder"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/admission"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/auth"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/authz/bootstrap"
+	seca "github.com/eu-sovereign-cloud/ecp/gateway/internal/authz/seca"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/httpserver"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/kubeclient"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/leader"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/logger"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/metrics"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/residency"
 
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	apk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy/backend/kubernetes"
 	authrest "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/frontend/rest"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
+	rpk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy/backend/kubernetes"
 	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
 	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
 	rak8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment/backend/kubernetes"
 	rolek8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role/backend/kubernetes"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+	sak8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account/backend/kubernetes"
+	trdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation"
+	trk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation/backend/kubernetes"
 	rdom "github.com/eu-sovereign-cloud/ecp/resource/region/v1"
 	rk8s "github.com/eu-sovereign-cloud/ecp/resource/region/v1/backend/kubernetes"
 	regionrest "github.com/eu-sovereign-cloud/ecp/resource/region/v1/frontend/rest"
@@ -40,7 +54,10 @@ var (
 	port       string
 	kubeconfig string
 
-	globalAuthFlags auth.Flags
+	globalAuthFlags      auth.Flags
+	globalBootstrapFlags bootstrap.Flags
+	globalLeaderFlags    leader.Flags
+	globalResidencyFlags residency.Flags
 )
 
 var globalAPIServerCMD = &cobra.Command{
@@ -62,6 +79,9 @@ func init() {
 	globalAPIServerCMD.Flags().StringVar(&host, "host", "0.0.0.0", "Host to bind the server to")
 	globalAPIServerCMD.Flags().StringVarP(&port, "port", "p", "8080", "Port to bind the server to")
 	auth.RegisterFlags(globalAPIServerCMD, &globalAuthFlags)
+	bootstrap.RegisterFlags(globalAPIServerCMD, &globalBootstrapFlags)
+	leader.RegisterFlags(globalAPIServerCMD, &globalLeaderFlags, "ecp-gateway-global")
+	residency.RegisterFlags(globalAPIServerCMD, &globalResidencyFlags)
 	rootCmd.AddCommand(globalAPIServerCMD)
 }
 
@@ -120,12 +140,76 @@ func startGlobal(logger *slog.Logger, addr string, kubeconfigPath string) error
 		rak8s.RoleAssignmentToCR,
 		rak8s.RoleAssignmentFromCR,
 	)
+	serviceAccountReaderAdapter := k8sadapter.NewReaderAdapter[*sadom.ServiceAccount](
+		client.Client,
+		sak8s.ServiceAccountGVR,
+		logger,
+		sak8s.ServiceAccountFromCR,
+	)
+	serviceAccountWriterAdapter := k8sadapter.NewWriterAdapter[*sadom.ServiceAccount](
+		client.Client,
+		sak8s.ServiceAccountGVR,
+		logger,
+		sak8s.ServiceAccountToCR,
+		sak8s.ServiceAccountFromCR,
+	)
+	tokenRevocationReaderAdapter := k8sadapter.NewReaderAdapter[*trdom.TokenRevocation](
+		client.Client,
+		trk8s.TokenRevocationGVR,
+		logger,
+		trk8s.TokenRevocationFromCR,
+	)
+	tokenRevocationWriterAdapter := k8sadapter.NewWriterAdapter[*trdom.TokenRevocation](
+		client.Client,
+		trk8s.TokenRevocationGVR,
+		logger,
+		trk8s.TokenRevocationToCR,
+		trk8s.TokenRevocationFromCR,
+	)
+	admissionPolicyReaderAdapter := k8sadapter.NewReaderAdapter[*apdom.AdmissionPolicy](
+		client.Client,
+		apk8s.AdmissionPolicyGVR,
+		logger,
+		apk8s.AdmissionPolicyFromCR,
+	)
+	admissionPolicyWriterAdapter := k8sadapter.NewWriterAdapter[*apdom.AdmissionPolicy](
+		client.Client,
+		apk8s.AdmissionPolicyGVR,
+		logger,
+		apk8s.AdmissionPolicyToCR,
+		apk8s.AdmissionPolicyFromCR,
+	)
+	residencyPolicyReaderAdapter := k8sadapter.NewReaderAdapter[*rpdom.ResidencyPolicy](
+		client.Client,
+		rpk8s.ResidencyPolicyGVR,
+		logger,
+		rpk8s.ResidencyPolicyFromCR,
+	)
+	residencyPolicyWriterAdapter := k8sadapter.NewWriterAdapter[*rpdom.ResidencyPolicy](
+		client.Client,
+		rpk8s.ResidencyPolicyGVR,
+		logger,
+		rpk8s.ResidencyPolicyToCR,
+		rpk8s.ResidencyPolicyFromCR,
+	)
 
 	// Build the authenticator and RBAC checker (both nil when --auth-enabled is not set).
-	authenticator, checker, err := auth.Build(&globalAuthFlags, client.Client, roleReaderAdapter, roleAssignmentReaderAdapter, logger)
+	// Token revocation list (nil unless --token-revocation is set).
+	revocations, err := auth.BuildRevocationList(&globalAuthFlags, client.Client, logger)
+	if err != nil {
+		return fmt.Errorf("build token revocation list: %w", err)
+	}
+	authenticator, checker, err := auth.Build(&globalAuthFlags, client.Client, roleReaderAdapter, roleAssignmentReaderAdapter, serviceAccountReaderAdapter, revocations, logger)
 	if err != nil {
 		return fmt.Errorf("build auth chain: %w", err)
 	}
+	// Updates and deletes are authorized against the labels stored on the resource.
+	storedLabels := auth.NewLabelReader(client.Client)
+	// Service-account token issuer (nil unless --sa-token-signing-key is set).
+	tokenIssuer, err := auth.BuildTokenIssuer(&globalAuthFlags)
+	if err != nil {
+		return fmt.Errorf("build service-account token issuer: %w", err)
+	}
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
@@ -135,37 +219,119 @@ func startGlobal(logger *slog.Logger, addr string, kubeconfigPath string) error
 	if err := auth.StartChecker(ctx, checker, logger); err != nil {
 		return fmt.Errorf("start authz cache: %w", err)
 	}
+	if revocations != nil {
+		if err := revocations.Start(ctx); err != nil {
+			return fmt.Errorf("start token revocation list: %w", err)
+		}
+	}
+
+	var bootstrapRoles []bootstrap.RoleTemplate
+	if globalBootstrapFlags.Enabled {
+		if bootstrapRoles, err = globalBootstrapFlags.Roles(); err != nil {
+			return fmt.Errorf("tenant bootstrap: %w", err)
+		}
+	}
+
+	// Report missing role references and expired or not-yet-valid windows on RoleAssignment
+	// status. This runs regardless of --auth-enabled: broken policy should be visible
+	// before it is enforced. With --tenant-bootstrap, also create the built-in roles, and
+	// the bootstrap subject's assignment, in every tenant namespace the first time it
+	// appears. Only the elected replica writes; new controllers start every time this
+	// replica takes the lead.
+	err = leader.Run(ctx, &globalLeaderFlags, client.ClientSet, logger, func(ctx context.Context) error {
+		assignmentStatus := seca.NewAssignmentStatusController(client.Client, roleAssignmentWriterAdapter, builder.DefaultMaxConditions, logger)
+		if err := assignmentStatus.Start(ctx); err != nil {
+			return fmt.Errorf("start role assignment status controller: %w", err)
+		}
+		if !globalBootstrapFlags.Enabled {
+			return nil
+		}
+		bootstrapper := bootstrap.New(client.ClientSet, roleReaderAdapter, roleWriterAdapter,
+			roleAssignmentReaderAdapter, roleAssignmentWriterAdapter,
+			bootstrapRoles, globalBootstrapFlags.Subject, globalBootstrapFlags.SubjectRoles, logger)
+		if err := bootstrapper.Start(ctx); err != nil {
+			return fmt.Errorf("start tenant bootstrap: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
 
-	// Region adapters and handler.
+	// Region adapters and handler. With --residency-policies, a caller's region listing
+	// omits the regions the residency policies of its tenants rule out.
+	regionHandler := &regionrest.Handler{
+		Repo:   k8sadapter.NewReaderAdapter[*rdom.Region](client.Client, rk8s.RegionGVR, logger, rk8s.RegionFromCR),
+		Logger: logger,
+	}
+	if globalResidencyFlags.Enabled {
+		residencyPolicies := residency.NewPolicies(client.Client, "", logger)
+		if err := residencyPolicies.WatchAssignments(); err != nil {
+			return fmt.Errorf("watch role assignments: %w", err)
+		}
+		if err := residencyPolicies.Start(ctx); err != nil {
+			return fmt.Errorf("start residency policies: %w", err)
+		}
+		regionHandler.Residency = residencyPolicies
+	}
 	regionv1.HandlerWithOptions(
-		&regionrest.Handler{
-			Repo:   k8sadapter.NewReaderAdapter[*rdom.Region](client.Client, rk8s.RegionGVR, logger, rk8s.RegionFromCR),
-			Logger: logger,
-		},
+		regionHandler,
 		regionv1.StdHTTPServerOptions{
 			BaseURL:          rdom.RegionBaseURL,
 			BaseRouter:       mux,
-			Middlewares:      auth.ProviderMWs[regionv1.MiddlewareFunc](&globalAuthFlags, authenticator, checker, "seca.region", rdom.RegionBaseURL, logger),
+			Middlewares:      auth.ProviderMWs[regionv1.MiddlewareFunc](&globalAuthFlags, authenticator, checker, storedLabels, "seca.region", rdom.RegionBaseURL, logger),
 			ErrorHandlerFunc: nil,
 		},
 	)
 
-	// Authorization CRUD handler (Roles + RoleAssignments).
+	// Authorization CRUD handler (Roles + RoleAssignments, plus the service-account,
+	// admission-policy and residency-policy routes SECA does not specify).
+	authHandler := &authrest.Handler{
+		RoleReader:            roleReaderAdapter,
+		RoleWriter:            roleWriterAdapter,
+		RoleAssignmentReader:  roleAssignmentReaderAdapter,
+		RoleAssignmentWriter:  roleAssignmentWriterAdapter,
+		ServiceAccountReader:  serviceAccountReaderAdapter,
+		ServiceAccountWriter:  serviceAccountWriterAdapter,
+		TokenRevocationReader: tokenRevocationReaderAdapter,
+		TokenRevocationWriter: tokenRevocationWriterAdapter,
+		AdmissionPolicyReader: admissionPolicyReaderAdapter,
+		AdmissionPolicyWriter: admissionPolicyWriterAdapter,
+		ResidencyPolicyReader: residencyPolicyReaderAdapter,
+		ResidencyPolicyWriter: residencyPolicyWriterAdapter,
+		Logger:                logger,
+		Guard:                 auth.BuildEscalationGuard(&globalAuthFlags, roleReaderAdapter, roleAssignmentReaderAdapter, logger),
+		PolicyCompiler:        admission.Compiler{},
+	}
+	if tokenIssuer != nil {
+		// Assigned only when set: a nil *TokenIssuer in the interface would mount the route.
+		authHandler.TokenIssuer = tokenIssuer
+	}
 	authv1.HandlerWithOptions(
-		&authrest.Handler{
-			RoleReader:           roleReaderAdapter,
-			RoleWriter:           roleWriterAdapter,
-			RoleAssignmentReader: roleAssignmentReaderAdapter,
-			RoleAssignmentWriter: roleAssignmentWriterAdapter,
-			Logger:               logger,
-		},
+		authHandler,
 		authv1.StdHTTPServerOptions{
 			BaseURL:          roledom.AuthorizationBaseURL,
 			BaseRouter:       mux,
-			Middlewares:      auth.ProviderMWs[authv1.MiddlewareFunc](&globalAuthFlags, authenticator, checker, "seca.authorization", roledom.AuthorizationBaseURL, logger),
+			Middlewares:      auth.ProviderMWs[authv1.MiddlewareFunc](&globalAuthFlags, authenticator, checker, storedLabels, "seca.authorization", roledom.AuthorizationBaseURL, logger),
 			ErrorHandlerFunc: nil,
 		},
 	)
+	authHandler.RegisterServiceAccountRoutes(mux, roledom.AuthorizationBaseURL,
+		auth.ProviderMWs[func(http.Handler) http.Handler](&globalAuthFlags, authenticator, checker, storedLabels, "seca.authorization", roledom.AuthorizationBaseURL, logger)...)
+	authHandler.RegisterAdmissionPolicyRoutes(mux, roledom.AuthorizationBaseURL,
+		auth.ProviderMWs[func(http.Handler) http.Handler](&globalAuthFlags, authenticator, checker, storedLabels, "seca.authorization", roledom.AuthorizationBaseURL, logger)...)
+	authHandler.RegisterResidencyPolicyRoutes(mux, roledom.AuthorizationBaseURL,
+		auth.ProviderMWs[func(http.Handler) http.Handler](&globalAuthFlags, authenticator, checker, storedLabels, "seca.authorization", roledom.AuthorizationBaseURL, logger)...)
+	// A Role or RoleAssignment whose failed operation has exhausted its attempts is re-armed
+	// by the retry action, authorized as the post.retry verb of its kind.
+	authHandler.RegisterRetryRoutes(mux, roledom.AuthorizationBaseURL,
+		auth.ProviderMWs[func(http.Handler) http.Handler](&globalAuthFlags, authenticator, checker, storedLabels, "seca.authorization", roledom.AuthorizationBaseURL, logger)...)
+	if revocations != nil {
+		// The revocation list is global: its admin routes are restricted to
+		// --token-revocation-admins rather than governed by tenant RBAC.
+		authHandler.RegisterTokenRevocationRoutes(mux, roledom.AuthorizationBaseURL,
+			auth.AdminMWs(&globalAuthFlags, authenticator, "seca.authorization", logger)...)
+	}
 
 	httpServer := httpserver.New(httpserver.Options{
 		Addr:    addr,
@@ -22,17 +22,27 @@ import (
 	sdkstorageapi "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.storage.v1"
 	sdkworkspaceapi "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.workspace.v1"
 
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/envelope"
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/refgraph"
 	"github.com/eu-sovereign-cloud/ecp/framework/frontend/config"
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/admission"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/auth"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/httpserver"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/kubeclient"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/logger"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/metrics"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/quota"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/residency"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/sovereignty"
 	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
 	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
 	rak8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment/backend/kubernetes"
 	rolek8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role/backend/kubernetes"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+	sak8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account/backend/kubernetes"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	computerest "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/frontend/rest"
 	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
 	instancek8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance/backend/kubernetes"
@@ -67,6 +77,8 @@ import (
 	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
 	wsk8s "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/backend/kubernetes"
 	wsrest "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/frontend/rest"
+	quotadom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota"
+	quotak8s "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota/backend/kubernetes"
 )
 
 var (
@@ -74,8 +86,13 @@ var (
 	regionalHost       string
 	regionalPort       string
 	regionalKubeconfig string
+	// regionalEncryptionKeyFile is the key file sealing the sensitive instance fields.
+	regionalEncryptionKeyFile string
 
-	regionalAuthFlags auth.Flags
+	regionalAuthFlags      auth.Flags
+	regionalAdmissionFlags admission.Flags
+	regionalQuotaFlags     quota.Flags
+	regionalResidencyFlags residency.Flags
 )
 
 var regionalApiServerCMD = &cobra.Command{
@@ -106,7 +123,14 @@ func init() {
 		&regionalKubeconfig, "kubeconfig", filepath.Join(homedir.HomeDir(), ".kube", "config"),
 		"Path to regional kubeconfig",
 	)
+	regionalApiServerCMD.Flags().StringVar(
+		&regionalEncryptionKeyFile, "encryption-key-file", "",
+		"Key file sealing instance userData and sshKeys at rest (default $"+envelope.KeyFileEnv+"; empty stores them in plain text)",
+	)
 	auth.RegisterFlags(regionalApiServerCMD, &regionalAuthFlags)
+	admission.RegisterFlags(regionalApiServerCMD, &regionalAdmissionFlags)
+	quota.RegisterFlags(regionalApiServerCMD, &regionalQuotaFlags)
+	residency.RegisterFlags(regionalApiServerCMD, &regionalResidencyFlags)
 	rootCmd.AddCommand(regionalApiServerCMD)
 }
 
@@ -123,6 +147,14 @@ func startRegional(logger *slog.Logger, addr string, kubeconfigPath string) erro
 	}
 	config.Singleton().SetRegion(region)
 
+	if regionalEncryptionKeyFile == "" {
+		regionalEncryptionKeyFile = os.Getenv(envelope.KeyFileEnv)
+	}
+	sealer, err := envelope.SealerFromKeyFile(regionalEncryptionKeyFile)
+	if err != nil {
+		return fmt.Errorf("load encryption key file: %w", err)
+	}
+
 	logger.Info("Starting regional API server", slog.String("region", config.Singleton().Region()), slog.Any("addr", addr))
 
 	inClusterConfig, err := rest.InClusterConfig()
@@ -154,14 +186,14 @@ func startRegional(logger *slog.Logger, addr string, kubeconfigPath string) erro
 		client.Client,
 		instancek8s.InstanceGVR,
 		logger,
-		instancek8s.InstanceFromCR,
+		instancek8s.InstanceFromCR(sealer),
 	)
 	instanceWriterAdapter := k8sadapter.NewWriterAdapter[*instancedom.Instance](
 		client.Client,
 		instancek8s.InstanceGVR,
 		logger,
-		instancek8s.InstanceToCR,
-		instancek8s.InstanceFromCR,
+		instancek8s.InstanceToCR(sealer),
+		instancek8s.InstanceFromCR(sealer),
 	)
 	instanceSKUReaderAdapter := k8sadapter.NewReaderAdapter[*computeskudom.InstanceSKU](
 		client.Client,
@@ -185,12 +217,26 @@ func startRegional(logger *slog.Logger, addr string, kubeconfigPath string) erro
 		logger,
 		rak8s.RoleAssignmentFromCR,
 	)
+	// Service-account tokens are checked against their service account on every request.
+	serviceAccountReaderAdapter := k8sadapter.NewReaderAdapter[*sadom.ServiceAccount](
+		client.Client,
+		sak8s.ServiceAccountGVR,
+		logger,
+		sak8s.ServiceAccountFromCR,
+	)
 
 	// Build the authenticator and RBAC checker (both nil when --auth-enabled is not set).
-	authenticator, checker, err := auth.Build(&regionalAuthFlags, client.Client, roleReaderAdapter, roleAssignmentReaderAdapter, logger)
+	// Token revocation list (nil unless --token-revocation is set).
+	revocations, err := auth.BuildRevocationList(&regionalAuthFlags, client.Client, logger)
+	if err != nil {
+		return fmt.Errorf("build token revocation list: %w", err)
+	}
+	authenticator, checker, err := auth.Build(&regionalAuthFlags, client.Client, roleReaderAdapter, roleAssignmentReaderAdapter, serviceAccountReaderAdapter, revocations, logger)
 	if err != nil {
 		return fmt.Errorf("build auth chain: %w", err)
 	}
+	// Updates and deletes are authorized against the labels stored on the resource.
+	storedLabels := auth.NewLabelReader(client.Client)
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
@@ -200,18 +246,63 @@ func startRegional(logger *slog.Logger, addr string, kubeconfigPath string) erro
 	if err := auth.StartChecker(ctx, checker, logger); err != nil {
 		return fmt.Errorf("start authz cache: %w", err)
 	}
+	if revocations != nil {
+		if err := revocations.Start(ctx); err != nil {
+			return fmt.Errorf("start token revocation list: %w", err)
+		}
+	}
 
+	// Residency policies (--residency-policies), tenant admission policies
+	// (--admission-policies) and quotas (--quotas) review every create and update of the
+	// regional resources. Policies run first, so a write they reject never reserves quota.
+	// reviewer is nil when none is enabled.
+	var reviewers []admissionport.Reviewer
+	if regionalResidencyFlags.Enabled {
+		residencyPolicies := residency.NewPolicies(client.Client, config.Singleton().Region(), logger)
+		if err := residencyPolicies.Start(ctx); err != nil {
+			return fmt.Errorf("start residency policies: %w", err)
+		}
+		reviewers = append(reviewers, residencyPolicies)
+	}
+	if regionalAdmissionFlags.Enabled {
+		admissionReviewer, err := admission.NewReviewer(client.Client, logger)
+		if err != nil {
+			return fmt.Errorf("build admission reviewer: %w", err)
+		}
+		if err := admissionReviewer.Start(ctx); err != nil {
+			return fmt.Errorf("start admission reviewer: %w", err)
+		}
+		reviewers = append(reviewers, admissionReviewer)
+	}
+	var quotaTracker *quota.Tracker
+	if regionalQuotaFlags.Enabled {
+		quotaTracker = quota.NewTracker(client.Client, logger)
+		if err := quotaTracker.Start(ctx); err != nil {
+			return fmt.Errorf("start quota tracker: %w", err)
+		}
+		reviewers = append(reviewers, quotaTracker)
+	}
+	reviewer := admission.AllOf(reviewers...)
+	// Deleting a resource other resources still reference is refused with a 409.
+	references := refgraph.New(client.Client, commonbackend.Referrers...)
+	if err := references.Start(ctx); err != nil {
+		return fmt.Errorf("start reference graph: %w", err)
+	}
+
+	computeHandler := &computerest.Handler{
+		InstanceReader: instanceReaderAdapter,
+		InstanceWriter: instanceWriterAdapter,
+		SKUReader:      instanceSKUReaderAdapter,
+		Admission:      reviewer,
+		Integrity:      references,
+		Logger:         logger,
+	}
 	sdkcomputeapi.HandlerWithOptions(
-		&computerest.Handler{
-			InstanceReader: instanceReaderAdapter,
-			InstanceWriter: instanceWriterAdapter,
-			SKUReader:      instanceSKUReaderAdapter,
-			Logger:         logger,
-		},
+		computeHandler,
 		sdkcomputeapi.StdHTTPServerOptions{
 			BaseURL:    "/providers/seca.compute",
 			BaseRouter: mux,
-			Middlewares: auth.ProviderMWs[sdkcomputeapi.MiddlewareFunc](&regionalAuthFlags, authenticator, checker, "seca.compute",
+			Middlewares: auth.ProviderMWs[sdkcomputeapi.MiddlewareFunc](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.compute",
 				"/providers/seca.compute", logger),
 			ErrorHandlerFunc: nil,
 		},
@@ -335,31 +426,34 @@ func startRegional(logger *slog.Logger, addr string, kubeconfigPath string) erro
 		securitygrouprulek8s.SecurityGroupRuleFromCR,
 	)
 
+	networkHandler := &netrest.Handler{
+		NetworkReader:           netReaderAdapter,
+		NetworkWriter:           netWriterAdapter,
+		SKUReader:               netSKUReaderAdapter,
+		NicReader:               nicReaderAdapter,
+		NicWriter:               nicWriterAdapter,
+		PublicIpReader:          publicIpReaderAdapter,
+		PublicIpWriter:          publicIpWriterAdapter,
+		InternetGatewayReader:   internetGatewayReaderAdapter,
+		InternetGatewayWriter:   internetGatewayWriterAdapter,
+		RouteTableReader:        routeTableReaderAdapter,
+		RouteTableWriter:        routeTableWriterAdapter,
+		SubnetReader:            subnetReaderAdapter,
+		SubnetWriter:            subnetWriterAdapter,
+		SecurityGroupReader:     securityGroupReaderAdapter,
+		SecurityGroupWriter:     securityGroupWriterAdapter,
+		SecurityGroupRuleReader: securityGroupRuleReaderAdapter,
+		SecurityGroupRuleWriter: securityGroupRuleWriterAdapter,
+		Admission:               reviewer,
+		Integrity:               references,
+		Logger:                  logger,
+	}
 	sdknetworkapi.HandlerWithOptions(
-		&netrest.Handler{
-			NetworkReader:           netReaderAdapter,
-			NetworkWriter:           netWriterAdapter,
-			SKUReader:               netSKUReaderAdapter,
-			NicReader:               nicReaderAdapter,
-			NicWriter:               nicWriterAdapter,
-			PublicIpReader:          publicIpReaderAdapter,
-			PublicIpWriter:          publicIpWriterAdapter,
-			InternetGatewayReader:   internetGatewayReaderAdapter,
-			InternetGatewayWriter:   internetGatewayWriterAdapter,
-			RouteTableReader:        routeTableReaderAdapter,
-			RouteTableWriter:        routeTableWriterAdapter,
-			SubnetReader:            subnetReaderAdapter,
-			SubnetWriter:            subnetWriterAdapter,
-			SecurityGroupReader:     securityGroupReaderAdapter,
-			SecurityGroupWriter:     securityGroupWriterAdapter,
-			SecurityGroupRuleReader: securityGroupRuleReaderAdapter,
-			SecurityGroupRuleWriter: securityGroupRuleWriterAdapter,
-			Logger:                  logger,
-		},
+		networkHandler,
 		sdknetworkapi.StdHTTPServerOptions{
 			BaseURL:          "/providers/seca.network",
 			BaseRouter:       mux,
-			Middlewares:      auth.ProviderMWs[sdknetworkapi.MiddlewareFunc](&regionalAuthFlags, authenticator, checker, "seca.network", "/providers/seca.network", logger),
+			Middlewares:      auth.ProviderMWs[sdknetworkapi.MiddlewareFunc](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.network", "/providers/seca.network", logger),
 			ErrorHandlerFunc: nil,
 		},
 	)
@@ -398,19 +492,22 @@ func startRegional(logger *slog.Logger, addr string, kubeconfigPath string) erro
 		imgk8s.ImageFromCR,
 	)
 
+	storageHandler := &storagerest.Handler{
+		BlockStorageReader: bsReaderAdapter,
+		BlockStorageWriter: bsWriterAdapter,
+		ImageReader:        imgReaderAdapter,
+		ImageWriter:        imgWriterAdapter,
+		SKUReader:          skuReaderAdapter,
+		Admission:          reviewer,
+		Integrity:          references,
+		Logger:             logger,
+	}
 	sdkstorageapi.HandlerWithOptions(
-		&storagerest.Handler{
-			BlockStorageReader: bsReaderAdapter,
-			BlockStorageWriter: bsWriterAdapter,
-			ImageReader:        imgReaderAdapter,
-			ImageWriter:        imgWriterAdapter,
-			SKUReader:          skuReaderAdapter,
-			Logger:             logger,
-		},
+		storageHandler,
 		sdkstorageapi.StdHTTPServerOptions{
 			BaseURL:    "/providers/seca.storage",
 			BaseRouter: mux,
-			Middlewares: auth.ProviderMWs[sdkstorageapi.MiddlewareFunc](&regionalAuthFlags, authenticator, checker, "seca.storage",
+			Middlewares: auth.ProviderMWs[sdkstorageapi.MiddlewareFunc](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.storage",
 				"/providers/seca.storage", logger),
 			ErrorHandlerFunc: nil,
 		},
@@ -444,20 +541,78 @@ func startRegional(logger *slog.Logger, addr string, kubeconfigPath string) erro
 		wsk8s.WorkspaceFromCR,
 	)
 
+	wsHandler := &wsrest.Handler{
+		Reader:    wsReaderAdapter,
+		Writer:    wsWriterAdapter,
+		Admission: reviewer,
+		Integrity: references,
+		Logger:    logger,
+	}
 	sdkworkspaceapi.HandlerWithOptions(
-		&wsrest.Handler{
-			Reader: wsReaderAdapter,
-			Writer: wsWriterAdapter,
-			Logger: logger,
-		},
+		wsHandler,
 		sdkworkspaceapi.StdHTTPServerOptions{
 			BaseURL:    "/providers/seca.workspace",
 			BaseRouter: mux,
-			Middlewares: auth.ProviderMWs[sdkworkspaceapi.MiddlewareFunc](&regionalAuthFlags, authenticator, checker, "seca.workspace",
+			Middlewares: auth.ProviderMWs[sdkworkspaceapi.MiddlewareFunc](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.workspace",
 				"/providers/seca.workspace", logger),
 			ErrorHandlerFunc: nil,
 		},
 	)
+	if quotaTracker != nil {
+		// Quotas are read under tenant RBAC but written only by --quota-admins, so a tenant
+		// cannot raise its own.
+		wsHandler.QuotaReader = k8sadapter.NewReaderAdapter[*quotadom.Quota](
+			client.Client,
+			quotak8s.QuotaGVR,
+			logger,
+			quotak8s.QuotaFromCR,
+		)
+		wsHandler.QuotaWriter = k8sadapter.NewWriterAdapter[*quotadom.Quota](
+			client.Client,
+			quotak8s.QuotaGVR,
+			logger,
+			quotak8s.QuotaToCR,
+			quotak8s.QuotaFromCR,
+		)
+		wsHandler.Usage = quotaTracker
+		wsHandler.RegisterQuotaRoutes(mux, "/providers/seca.workspace",
+			auth.ProviderMWs[func(http.Handler) http.Handler](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.workspace",
+				"/providers/seca.workspace", logger),
+			auth.QuotaAdminMWs(&regionalAuthFlags, authenticator, "seca.workspace", logger))
+	}
+	// The sovereignty report is read under tenant RBAC, like the workspaces themselves.
+	wsHandler.Sovereignty = sovereignty.NewReporter(client.Client, config.Singleton().Region(), logger)
+	wsHandler.RegisterSovereigntyRoutes(mux, "/providers/seca.workspace",
+		auth.ProviderMWs[func(http.Handler) http.Handler](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.workspace",
+			"/providers/seca.workspace", logger)...)
+
+	// A resource whose failed operation has exhausted its attempts is re-armed by the retry
+	// action, authorized as the post.retry verb of its kind.
+	computeHandler.RegisterRetryRoutes(mux, "/providers/seca.compute",
+		auth.ProviderMWs[func(http.Handler) http.Handler](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.compute",
+			"/providers/seca.compute", logger)...)
+	networkHandler.RegisterRetryRoutes(mux, "/providers/seca.network",
+		auth.ProviderMWs[func(http.Handler) http.Handler](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.network",
+			"/providers/seca.network", logger)...)
+	storageHandler.RegisterRetryRoutes(mux, "/providers/seca.storage",
+		auth.ProviderMWs[func(http.Handler) http.Handler](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.storage",
+			"/providers/seca.storage", logger)...)
+
+	// The events the delegator records on a resource are read under the get.events verb of
+	// its kind, which get covers.
+	events := k8sadapter.NewEventReaderAdapter(client.Client)
+	computeHandler.Events = events
+	computeHandler.RegisterEventRoutes(mux, "/providers/seca.compute",
+		auth.ProviderMWs[func(http.Handler) http.Handler](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.compute",
+			"/providers/seca.compute", logger)...)
+	networkHandler.Events = events
+	networkHandler.RegisterEventRoutes(mux, "/providers/seca.network",
+		auth.ProviderMWs[func(http.Handler) http.Handler](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.network",
+			"/providers/seca.network", logger)...)
+	storageHandler.Events = events
+	storageHandler.RegisterEventRoutes(mux, "/providers/seca.storage",
+		auth.ProviderMWs[func(http.Handler) http.Handler](&regionalAuthFlags, authenticator, checker, storedLabels, "seca.storage",
+			"/providers/seca.storage", logger)...)
 
 	httpServer := httpserver.New(
 		httpserver.Options{
@@ -0,0 +1,38 @@
+package admission
+
+import (
+	"context"
+
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+)
+
+// AllOf returns a reviewer that admits a write only when every non-nil reviewer admits
+// it. Reviewers run in order and the first rejection is returned, so cheap or
+// side-effect-free reviewers should come first. Returns nil when no reviewer is set, which
+// the REST layer treats as admitting every write.
+func AllOf(reviewers ...admissionport.Reviewer) admissionport.Reviewer {
+	var chain allOf
+	for _, r := range reviewers {
+		if r != nil {
+			chain = append(chain, r)
+		}
+	}
+	switch len(chain) {
+	case 0:
+		return nil
+	case 1:
+		return chain[0]
+	}
+	return chain
+}
+
+type allOf []admissionport.Reviewer
+
+func (c allOf) Review(ctx context.Context, req admissionport.Request) error {
+	for _, r := range c {
+		if err := r.Review(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
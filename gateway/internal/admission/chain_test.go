@@ -0,0 +1,34 @@
+package admission
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+)
+
+// reviewFunc adapts a function to admissionport.Reviewer.
+type reviewFunc func(context.Context, admissionport.Request) error
+
+func (f reviewFunc) Review(ctx context.Context, req admissionport.Request) error { return f(ctx, req) }
+
+func TestAllOf(t *testing.T) {
+	t.Parallel()
+
+	if r := AllOf(nil, nil); r != nil {
+		t.Errorf("AllOf(nil, nil) = %v; want nil", r)
+	}
+
+	var calls []string
+	admit := reviewFunc(func(context.Context, admissionport.Request) error { calls = append(calls, "admit"); return nil })
+	errQuota := errors.New("quota exceeded")
+	reject := reviewFunc(func(context.Context, admissionport.Request) error { calls = append(calls, "reject"); return errQuota })
+
+	if err := AllOf(admit, nil, reject, admit).Review(context.Background(), admissionport.Request{}); !errors.Is(err, errQuota) {
+		t.Errorf("Review() = %v; want %v", err, errQuota)
+	}
+	if len(calls) != 2 || calls[0] != "admit" || calls[1] != "reject" {
+		t.Errorf("calls = %v; want [admit reject]: reviewers after a rejection must not run", calls)
+	}
+}
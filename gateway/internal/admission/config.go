@@ -0,0 +1,18 @@
+package admission
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Flags holds the parsed command-line values for admission policies.
+// Use RegisterFlags to bind these to a cobra command.
+type Flags struct {
+	// Enabled evaluates the tenants' AdmissionPolicy resources on every create and update.
+	Enabled bool
+}
+
+// RegisterFlags adds admission-policy flags to the given cobra command.
+func RegisterFlags(cmd *cobra.Command, f *Flags) {
+	cmd.Flags().BoolVar(&f.Enabled, "admission-policies", false,
+		"Evaluate the tenants' AdmissionPolicy resources on every create and update (disabled by default)")
+}
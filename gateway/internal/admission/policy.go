@@ -0,0 +1,135 @@
+// Package admission evaluates the tenants' AdmissionPolicy resources on every create and
+// update handled by the regional gateway.
+//
+// Authorization decides who may write; admission policies govern what is written: every
+// instance must carry a cost-center label, only small SKUs in the dev workspace, names
+// must match a pattern. A policy is a CEL expression over the domain object mapped from
+// the request body that must evaluate to true for the write to proceed. A violated policy
+// rejects the write with 422, pointing at the field the policy names, unless the policy is
+// in audit mode, where the violation is only logged.
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+)
+
+// costLimit bounds the evaluation cost of a single policy, so a pathological expression
+// cannot stall the write path. Exceeding it fails the evaluation, which rejects.
+const costLimit = 100_000
+
+// program is a compiled admission policy. A policy that does not compile keeps its error
+// and rejects every write it applies to, so a broken policy fails closed.
+type program struct {
+	policy *apdom.AdmissionPolicy
+	prg    cel.Program
+	err    error
+}
+
+// env returns the CEL environment policies are compiled in. The expression sees two
+// variables:
+//   - object: the domain object being written, with its Go field names, e.g.
+//     object.Labels, object.Name or object.Spec.SkuRef.Resource; unset fields are absent;
+//   - request: resource, tenant, workspace and name of the write, all strings ("" when
+//     not applicable).
+var env = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.OptionalTypes(),
+		cel.Variable("object", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("request", cel.MapType(cel.StringType, cel.StringType)),
+	)
+})
+
+// compile compiles ap. It never returns nil; a compile error is kept in program.err.
+func compile(ap *apdom.AdmissionPolicy) *program {
+	e, err := env()
+	if err != nil {
+		return &program{policy: ap, err: fmt.Errorf("create CEL environment: %w", err)}
+	}
+	ast, issues := e.Compile(ap.Spec.Expression)
+	if issues.Err() != nil {
+		return &program{policy: ap, err: fmt.Errorf("admission policy %s does not compile: %w", ap.Name, issues.Err())}
+	}
+	if t := ast.OutputType(); t != cel.BoolType && t != cel.DynType {
+		return &program{policy: ap, err: fmt.Errorf("admission policy %s evaluates to %s, not bool", ap.Name, t)}
+	}
+	prg, err := e.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return &program{policy: ap, err: fmt.Errorf("admission policy %s: %w", ap.Name, err)}
+	}
+	return &program{policy: ap, prg: prg}
+}
+
+// admits evaluates the policy on the write. An error means the policy could not be
+// evaluated (it does not compile, exceeded its cost, or did not yield a bool).
+func (p *program) admits(vars map[string]any) (bool, error) {
+	if p.err != nil {
+		return false, p.err
+	}
+	out, _, err := p.prg.Eval(vars)
+	if err != nil {
+		return false, fmt.Errorf("evaluate admission policy %s: %w", p.policy.Name, err)
+	}
+	admitted, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("admission policy %s evaluated to %v, not bool", p.policy.Name, out.Value())
+	}
+	return admitted, nil
+}
+
+// reason returns the violation explanation of the policy.
+func (p *program) reason() string {
+	if p.policy.Spec.Message != "" {
+		return p.policy.Spec.Message
+	}
+	return p.policy.Spec.Expression
+}
+
+// activation binds the policy variables for req. The object is round-tripped through
+// JSON, so CEL sees plain maps, lists and scalars; times become RFC 3339 strings. Unset
+// fields (nil maps, slices and pointers) are left out rather than bound to null, so
+// expressions test them with has(), e.g. has(object.Labels).
+func activation(req admissionport.Request) (map[string]any, error) {
+	data, err := json.Marshal(req.Object)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s %s: %w", req.Resource, req.Name, err)
+	}
+	object := map[string]any{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("unmarshal %s %s: %w", req.Resource, req.Name, err)
+	}
+	dropNulls(object)
+	return map[string]any{
+		"object": object,
+		"request": map[string]string{
+			"resource":  req.Resource,
+			"tenant":    req.Scope.Tenant,
+			"workspace": req.Scope.Workspace,
+			"name":      req.Name,
+		},
+	}, nil
+}
+
+// dropNulls removes the null fields of m and of the maps nested in it.
+func dropNulls(m map[string]any) {
+	for k, v := range m {
+		switch v := v.(type) {
+		case nil:
+			delete(m, k)
+		case map[string]any:
+			dropNulls(v)
+		case []any:
+			for _, item := range v {
+				if nested, ok := item.(map[string]any); ok {
+					dropNulls(nested)
+				}
+			}
+		}
+	}
+}
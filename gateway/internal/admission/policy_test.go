@@ -0,0 +1,67 @@
+package admission
+
+import (
+	"testing"
+
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+)
+
+func newPolicy(name, expression string) *apdom.AdmissionPolicy {
+	ap := &apdom.AdmissionPolicy{Spec: apdom.AdmissionPolicySpec{Expression: expression}}
+	ap.Name = name
+	return ap
+}
+
+func TestProgramAdmits(t *testing.T) {
+	t.Parallel()
+
+	labelled := &instancedom.Instance{Spec: instancedom.InstanceSpec{SkuRef: commondomain.Reference{Resource: "skus/seca.s"}}}
+	labelled.Name = "web-1"
+	labelled.Labels = map[string]string{"cost-center": "cc-42"}
+	unlabelled := &instancedom.Instance{Spec: instancedom.InstanceSpec{SkuRef: commondomain.Reference{Resource: "skus/seca.xl"}}}
+	unlabelled.Name = "Web_1"
+
+	costCenter := newPolicy("cost-center", "has(object.Labels) && 'cost-center' in object.Labels")
+	smallSKUs := newPolicy("small-skus", "object.Spec.SkuRef.Resource in ['skus/seca.s', 'skus/seca.m']")
+	naming := newPolicy("naming", "request.name.matches('^[a-z][a-z0-9-]*$')")
+
+	tests := []struct {
+		name    string
+		policy  *apdom.AdmissionPolicy
+		object  *instancedom.Instance
+		want    bool
+		wantErr bool
+	}{
+		{name: "labelled", policy: costCenter, object: labelled, want: true},
+		{name: "unlabelled", policy: costCenter, object: unlabelled},
+		{name: "allowed sku", policy: smallSKUs, object: labelled, want: true},
+		{name: "disallowed sku", policy: smallSKUs, object: unlabelled},
+		{name: "conforming name", policy: naming, object: labelled, want: true},
+		{name: "nonconforming name", policy: naming, object: unlabelled},
+		{name: "missing field fails", policy: newPolicy("strict", "object.Labels['cost-center'] == 'cc-42'"), object: unlabelled, wantErr: true},
+		{name: "compile error fails", policy: newPolicy("broken", "object.Name =="), object: labelled, wantErr: true},
+		{name: "non-bool type fails", policy: newPolicy("string", "request.name"), object: labelled, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			vars, err := activation(admissionport.Request{
+				Resource: instancedom.Resource, Scope: resource.Scope{Tenant: "acme", Workspace: "dev"}, Name: tc.object.Name, Object: tc.object,
+			})
+			if err != nil {
+				t.Fatalf("activation() error = %v", err)
+			}
+			got, err := compile(tc.policy).admits(vars)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("admits() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("admits() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package admission
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	apk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy/backend/kubernetes"
+)
+
+// policyResync is the period after which the informer re-lists all AdmissionPolicies.
+const policyResync = 5 * time.Minute
+
+// Reviewer is the CEL implementation of admissionport.Reviewer. It watches the
+// AdmissionPolicy resources of the cluster and rejects a write when a policy of its tenant
+// in enforce mode evaluates to false or cannot be evaluated.
+//
+// Policies are compiled when they change, not per write. Lifecycle: call Start once at
+// server startup. Until the informer has synced, Review returns an internal error: a
+// gateway that cannot see the policies must not skip them.
+type Reviewer struct {
+	// programs holds the compiled policies by tenant namespace, sorted by name.
+	programs atomic.Pointer[map[string][]*program]
+	factory  dynamicinformer.DynamicSharedInformerFactory
+	informer informers.GenericInformer
+	log      *slog.Logger
+}
+
+var _ admissionport.Reviewer = (*Reviewer)(nil)
+
+// NewReviewer watches the AdmissionPolicy resources through dynClient.
+func NewReviewer(dynClient dynamic.Interface, log *slog.Logger) (*Reviewer, error) {
+	r := &Reviewer{log: log}
+	r.factory = dynamicinformer.NewDynamicSharedInformerFactory(dynClient, policyResync)
+	r.informer = r.factory.ForResource(apk8s.AdmissionPolicyGVR)
+	rebuild := func(any) { r.rebuild() }
+	if _, err := r.informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    rebuild,
+		UpdateFunc: func(_, obj any) { rebuild(obj) },
+		DeleteFunc: rebuild,
+	}); err != nil {
+		return nil, fmt.Errorf("watch admission policies: %w", err)
+	}
+	return r, nil
+}
+
+// Start starts the informer and blocks until its cache is synced. Returns an error if the
+// context is cancelled before sync completes.
+func (r *Reviewer) Start(ctx context.Context) error {
+	r.log.Info("admission: starting admission policy watch")
+	r.factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), r.informer.Informer().HasSynced) {
+		return fmt.Errorf("informer cache sync timed out for %s", apk8s.AdmissionPolicyGVR.Resource)
+	}
+	r.rebuild()
+	return nil
+}
+
+// rebuild recompiles the policies from the informer cache.
+func (r *Reviewer) rebuild() {
+	objs, err := r.informer.Lister().List(labels.Everything())
+	if err != nil {
+		r.log.Error("admission: list admission policies from cache", slog.Any("error", err))
+		return
+	}
+	programs := map[string][]*program{}
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			r.log.Error("admission: unexpected object type in informer cache", slog.String("type", fmt.Sprintf("%T", obj)))
+			continue
+		}
+		var prg *program
+		if ap, err := apk8s.AdmissionPolicyFromCR(u); err != nil {
+			// Its spec is unknown, so the broken policy applies to every write of the tenant.
+			prg = &program{policy: &apdom.AdmissionPolicy{}, err: fmt.Errorf("convert admission policy %s: %w", u.GetName(), err)}
+			prg.policy.Name = u.GetName()
+		} else {
+			prg = compile(ap)
+		}
+		if prg.err != nil {
+			r.log.Error("admission: policy rejects every write it applies to", slog.String("policy", prg.policy.Name),
+				slog.String("namespace", u.GetNamespace()), slog.Any("error", prg.err))
+		}
+		programs[u.GetNamespace()] = append(programs[u.GetNamespace()], prg)
+	}
+	for _, prgs := range programs {
+		slices.SortFunc(prgs, func(a, b *program) int { return strings.Compare(a.policy.Name, b.policy.Name) })
+	}
+	r.programs.Store(&programs)
+}
+
+// Review implements admissionport.Reviewer. Every violated policy in enforce mode adds a
+// source pointing at its field, so the caller sees all of them at once.
+func (r *Reviewer) Review(ctx context.Context, req admissionport.Request) error {
+	programs := r.programs.Load()
+	if programs == nil || !r.informer.Informer().HasSynced() {
+		return kernel.NewError(kernel.KindInternal, fmt.Errorf("admission policies are not synced"))
+	}
+	tenantPrograms := (*programs)[k8sadapter.ComputeNamespace(resource.Scope{Tenant: req.Scope.Tenant})]
+	if len(tenantPrograms) == 0 {
+		return nil
+	}
+	vars, err := activation(req)
+	if err != nil {
+		return kernel.NewError(kernel.KindInternal, err)
+	}
+
+	var reasons []string
+	var sources []kernel.ErrorSource
+	for _, prg := range tenantPrograms {
+		if !prg.policy.Spec.AppliesTo(req.Resource, req.Scope.Workspace) {
+			continue
+		}
+		admitted, err := prg.admits(vars)
+		if err == nil && admitted {
+			continue
+		}
+		reason := prg.reason()
+		if err != nil {
+			reason = fmt.Sprintf("policy failed closed: %v", err)
+		}
+		if prg.policy.Spec.Audit() {
+			r.log.WarnContext(ctx, "admission: audit policy violated",
+				slog.String("policy", prg.policy.Name), slog.String("tenant", req.Scope.Tenant),
+				slog.String("workspace", req.Scope.Workspace), slog.String("resource", req.Resource),
+				slog.String("name", req.Name), slog.String("reason", reason))
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("%s: %s", prg.policy.Name, reason))
+		sources = append(sources, kernel.ErrorSource{Name: prg.policy.Spec.Field, Value: prg.policy.Name})
+	}
+	if len(reasons) > 0 {
+		return kernel.NewError(kernel.KindValidation,
+			fmt.Errorf("%s %s violates admission policies: %s", req.Resource, req.Name, strings.Join(reasons, "; ")),
+			sources...)
+	}
+	return nil
+}
+
+// Compiler checks admission policies against the environment the Reviewer evaluates them
+// in. It implements the PolicyCompiler of the authorization REST handler, so a policy that
+// does not compile is rejected when it is written.
+type Compiler struct{}
+
+// CompileAdmissionPolicy returns a validation error pointing at the expression when ap
+// does not compile.
+func (Compiler) CompileAdmissionPolicy(_ context.Context, ap *apdom.AdmissionPolicy) error {
+	if prg := compile(ap); prg.err != nil {
+		return kernel.NewError(kernel.KindValidation, prg.err,
+			kernel.ErrorSource{Name: "/spec/expression", Value: ap.Spec.Expression})
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package admission
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	apk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy/backend/kubernetes"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+)
+
+func TestReviewer(t *testing.T) {
+	t.Parallel()
+
+	newCR := func(tenant, name string, spec apk8s.AdmissionPolicySpec) *apk8s.AdmissionPolicy {
+		ap := &apk8s.AdmissionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: k8sadapter.ComputeNamespace(resource.Scope{Tenant: tenant})},
+			Spec:       spec,
+		}
+		ap.SetGroupVersionKind(apk8s.AdmissionPolicyGVK)
+		return ap
+	}
+	scheme := runtime.NewScheme()
+	_ = apk8s.AddToScheme(scheme)
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{apk8s.AdmissionPolicyGVR: "AdmissionPolicyList"},
+		newCR("acme", "cost-center", apk8s.AdmissionPolicySpec{
+			Resources: []string{instancedom.Resource}, Expression: "has(object.Labels) && 'cost-center' in object.Labels",
+			Message: "instances need a cost-center label", Field: "/labels",
+		}),
+		newCR("acme", "dev-naming", apk8s.AdmissionPolicySpec{
+			Workspaces: []string{"dev"}, Expression: "request.name.startsWith('dev-')", Mode: "audit",
+		}),
+		newCR("globex", "broken", apk8s.AdmissionPolicySpec{Expression: "object.Name =="}),
+	)
+
+	reviewer, err := NewReviewer(client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("NewReviewer() error = %v", err)
+	}
+	review := func(tenant, workspace, name string, labels map[string]string) error {
+		inst := &instancedom.Instance{}
+		inst.Name = name
+		inst.Labels = labels
+		return reviewer.Review(context.Background(), admissionport.Request{
+			Resource: instancedom.Resource, Scope: resource.Scope{Tenant: tenant, Workspace: workspace}, Name: name, Object: inst,
+		})
+	}
+	if err := review("acme", "dev", "web", nil); !errors.Is(err, kernel.ErrInternal) {
+		t.Errorf("before sync: Review() = %v; want an internal error", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := reviewer.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	err = review("acme", "dev", "web", nil)
+	if !errors.Is(err, kernel.ErrValidation) {
+		t.Fatalf("unlabelled instance: Review() = %v; want a validation error", err)
+	}
+	if sources := kernel.AsError(err).Sources; len(sources) != 1 || sources[0].Name != "/labels" || sources[0].Value != "cost-center" {
+		t.Errorf("unlabelled instance: sources = %+v; want only the cost-center policy at /labels", sources)
+	}
+	// dev-naming is violated too, but only audited.
+	if err := review("acme", "dev", "web", map[string]string{"cost-center": "cc-42"}); err != nil {
+		t.Errorf("labelled instance: Review() = %v; want admitted", err)
+	}
+	if err := review("globex", "prod", "web", nil); !errors.Is(err, kernel.ErrValidation) {
+		t.Errorf("broken policy: Review() = %v; want a validation error", err)
+	}
+	if err := review("initech", "prod", "web", nil); err != nil {
+		t.Errorf("tenant without policies: Review() = %v; want admitted", err)
+	}
+}
+
+func TestCompiler(t *testing.T) {
+	t.Parallel()
+
+	if err := (Compiler{}).CompileAdmissionPolicy(context.Background(), newPolicy("ok", "has(object.Labels) && 'cost-center' in object.Labels")); err != nil {
+		t.Errorf("CompileAdmissionPolicy(ok) = %v; want nil", err)
+	}
+	for _, expression := range []string{"object.Name ==", "request.name", "claim.verb == 'get'"} {
+		err := (Compiler{}).CompileAdmissionPolicy(context.Background(), newPolicy("bad", expression))
+		if !errors.Is(err, kernel.ErrValidation) || kernel.AsError(err).Sources[0].Name != "/spec/expression" {
+			t.Errorf("CompileAdmissionPolicy(%q) = %v; want a validation error at /spec/expression", expression, err)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+
+	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
+	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	gatewayauthn "github.com/eu-sovereign-cloud/ecp/gateway/internal/authn"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/metrics"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+)
+
+// serviceAccountEntry is the chain entry name of gateway-issued service-account tokens.
+const serviceAccountEntry = "service-accounts"
+
+// AuthenticatorSpec is one entry of the --auth-chain file: an authentication plugin and
+// the tokens routed to it. Example file content:
+//
+//	[
+//	  {"name": "corporate-idp", "plugin": "jwt", "issuer": "https://idp.example.com",
+//	   "signingMethod": "RS256", "keyFile": "/etc/ecp/idp/key.pem"},
+//	  {"name": "ci", "plugin": "jwt", "issuer": "https://ci.example.com",
+//	   "signingMethod": "ES256", "keyFile": "/etc/ecp/ci/key.pem"},
+//	  {"name": "break-glass", "plugin": "dummy", "usersFile": "/etc/ecp/users.json"}
+//	]
+type AuthenticatorSpec struct {
+	// Name identifies the entry in logs, errors and the per-authenticator metrics.
+	Name string `json:"name"`
+	// Plugin is "dummy" or "jwt".
+	Plugin string `json:"plugin"`
+	// Issuer routes the JWTs whose "iss" claim equals it to this entry, which then also
+	// requires that issuer. Only valid for the jwt plugin.
+	Issuer string `json:"issuer,omitempty"`
+	// Prefix routes the tokens starting with it to this entry.
+	Prefix string `json:"prefix,omitempty"`
+	// SigningMethod and KeyFile configure the jwt plugin, like --jwt-signing-method and
+	// --jwt-secret.
+	SigningMethod string `json:"signingMethod,omitempty"`
+	KeyFile       string `json:"keyFile,omitempty"`
+	// UsersFile configures the dummy plugin, like --dummy-auth-users.
+	UsersFile string `json:"usersFile,omitempty"`
+}
+
+// authenticatorSpecs returns the configured chain: the --auth-chain file when set, else a
+// single selector-less entry built from --auth-plugin and its flags.
+func authenticatorSpecs(flags *Flags) ([]AuthenticatorSpec, error) {
+	if flags.AuthChainFile == "" {
+		return []AuthenticatorSpec{{
+			Name:          flags.AuthPlugin,
+			Plugin:        flags.AuthPlugin,
+			SigningMethod: flags.JwtSigningMethod,
+			KeyFile:       flags.JwtSecretFile,
+			UsersFile:     flags.DummyUsersFile,
+		}}, nil
+	}
+	data, err := os.ReadFile(flags.AuthChainFile)
+	if err != nil {
+		return nil, fmt.Errorf("read auth chain file %q: %w", flags.AuthChainFile, err)
+	}
+	var specs []AuthenticatorSpec
+	if err := json.Unmarshal(data, &specs); err != nil {
+		return nil, fmt.Errorf("parse auth chain file %q: %w", flags.AuthChainFile, err)
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("auth chain file %q lists no authenticator", flags.AuthChainFile)
+	}
+	seen := map[string]bool{serviceAccountEntry: true}
+	for _, s := range specs {
+		switch {
+		case s.Name == "":
+			return nil, fmt.Errorf("auth chain file %q: every authenticator needs a name", flags.AuthChainFile)
+		case seen[s.Name]:
+			return nil, fmt.Errorf("auth chain file %q: duplicate or reserved authenticator name %q", flags.AuthChainFile, s.Name)
+		case s.Issuer != "" && s.Prefix != "":
+			return nil, fmt.Errorf("authenticator %q: issuer and prefix are mutually exclusive", s.Name)
+		case s.Issuer != "" && s.Plugin != "jwt":
+			return nil, fmt.Errorf("authenticator %q: only the jwt plugin can be routed by issuer", s.Name)
+		case s.Issuer != "" && s.Issuer == flags.SATokenIssuer:
+			return nil, fmt.Errorf("authenticator %q: issuer %q is reserved for service-account tokens (--sa-token-issuer)", s.Name, s.Issuer)
+		}
+		seen[s.Name] = true
+	}
+	return specs, nil
+}
+
+// buildChain builds the authenticator chain: the service-account entry first, when
+// service-account tokens are configured, then the configured entries in order. Every
+// entry is instrumented with its name; jwtOpts apply to every JWT authenticator.
+func buildChain(flags *Flags, saReader persistence.ReaderRepo[*sadom.ServiceAccount], jwtOpts ...gatewayauthn.JWTOption) (*gatewayauthn.Chain, error) {
+	specs, err := authenticatorSpecs(flags)
+	if err != nil {
+		return nil, err
+	}
+	var entries []gatewayauthn.ChainEntry
+	saAuthenticator, err := buildServiceAccountAuthenticator(flags, saReader, jwtOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("build service-account authenticator: %w", err)
+	}
+	if saAuthenticator != nil {
+		entries = append(entries, gatewayauthn.ChainEntry{
+			Name:          serviceAccountEntry,
+			Issuer:        flags.SATokenIssuer,
+			Authenticator: metrics.NewInstrumentedAuthenticator(saAuthenticator, serviceAccountEntry),
+		})
+	}
+	for _, s := range specs {
+		a, err := buildAuthenticator(s, jwtOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("build authenticator %q: %w", s.Name, err)
+		}
+		entries = append(entries, gatewayauthn.ChainEntry{
+			Name:          s.Name,
+			Issuer:        s.Issuer,
+			Prefix:        s.Prefix,
+			Authenticator: metrics.NewInstrumentedAuthenticator(a, s.Name),
+		})
+	}
+	return gatewayauthn.NewChain(entries...), nil
+}
+
+// buildAuthenticator loads the Dummy authenticator from the spec's users file, or the JWT
+// authenticator from its key; jwtOpts apply to the latter only, which also requires the
+// spec's issuer when it has one.
+func buildAuthenticator(s AuthenticatorSpec, jwtOpts ...gatewayauthn.JWTOption) (authnport.Authenticator, error) {
+	switch s.Plugin {
+	case "dummy":
+		if s.UsersFile == "" {
+			return nil, fmt.Errorf("the dummy plugin requires a users file (--dummy-auth-users)")
+		}
+		data, err := os.ReadFile(s.UsersFile)
+		if err != nil {
+			return nil, fmt.Errorf("read dummy users file %q: %w", s.UsersFile, err)
+		}
+		var users map[string]string
+		if err := json.Unmarshal(data, &users); err != nil {
+			return nil, fmt.Errorf("parse dummy users file %q: %w", s.UsersFile, err)
+		}
+		return gatewayauthn.NewDummyAuthenticator(users), nil
+	case "jwt":
+		if s.KeyFile == "" || s.SigningMethod == "" {
+			return nil, fmt.Errorf("the jwt plugin requires a key file and a signing method (--jwt-secret, --jwt-signing-method)")
+		}
+		data, err := os.ReadFile(s.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read JWT secret file %q: %w", s.KeyFile, err)
+		}
+		key, err := gatewayauthn.ParseVerifyKey(s.SigningMethod, data)
+		if err != nil {
+			return nil, fmt.Errorf("parse JWT key from %q: %w", s.KeyFile, err)
+		}
+		if s.Issuer != "" {
+			jwtOpts = append(slices.Clip(jwtOpts), gatewayauthn.WithIssuer(s.Issuer))
+		}
+		return gatewayauthn.NewJWTAuthenticator(key, s.SigningMethod, jwtOpts...), nil
+	}
+	return nil, fmt.Errorf("unknown auth plugin %q", s.Plugin)
+}
@@ -8,25 +8,30 @@ package auth
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"slices"
+	"time"
 
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
 
 	middleware "github.com/eu-sovereign-cloud/ecp/framework/frontend/middleware"
 	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
 	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
 	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
 	gatewayauthn "github.com/eu-sovereign-cloud/ecp/gateway/internal/authn"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/authz/admin"
+	"github.com/eu-sovereign-cloud/ecp/gateway/internal/authz/policy"
 	seca "github.com/eu-sovereign-cloud/ecp/gateway/internal/authz/seca"
 	"github.com/eu-sovereign-cloud/ecp/gateway/internal/metrics"
+	authrest "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/frontend/rest"
 	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
 	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
 )
 
 // Flags holds the parsed command-line values for the auth subsystem.
@@ -35,12 +40,16 @@ type Flags struct {
 	// Enabled turns the entire auth chain on; when false, no middlewares are installed
 	// and existing deployments are unaffected.
 	Enabled bool
-	// AuthPlugin selects the authentication plugin to use. Supports "dummy" (static username→password map) and "jwt" (standard signed JWTs). Default "dummy".
+	// AuthPlugin selects the authentication plugin to use when AuthChainFile is unset. Supports "dummy" (static username→password map) and "jwt" (standard signed JWTs). Default "dummy".
 	AuthPlugin string
 	// JwtSigningMethod is the expected JWT signing method (e.g. "ES256") when AuthPlugin is "jwt". Required when AuthPlugin is "jwt".
 	JwtSigningMethod string
 	// JwtSecretFile is the path to a file holding the verification key for JWTs when AuthPlugin is "jwt". Required when AuthPlugin is "jwt". For HS* the file content is the raw HMAC secret; for all other methods it is a PEM-encoded PKIX public key.
 	JwtSecretFile string
+	// AuthChainFile is the path to a JSON file listing the authenticators to chain, in
+	// order, each with the tokens routed to it (see AuthenticatorSpec). When set, it
+	// replaces AuthPlugin and the plugin flags.
+	AuthChainFile string
 	// DummyUsersFile is the path to a JSON file containing username→password pairs.
 	// Required when Enabled is true. Example file content: {"alice":"s3cr3t","bob":"p@ss"}
 	DummyUsersFile string
@@ -58,6 +67,45 @@ type Flags struct {
 	// resources that tenant-scoped RBAC cannot govern — by default the region catalog,
 	// which is tenant-less by spec.
 	AuthzSkipProviders []string
+	// SATokenKeyFile is the path to the verification key of gateway-issued service-account
+	// tokens (raw HMAC secret for HS*, PEM public key otherwise). When set, tokens whose
+	// "iss" is SATokenIssuer are verified against it and their service account, whatever
+	// AuthPlugin is. Optional on the global gateway when SATokenSigningKeyFile is set: the
+	// verification key is then derived from the signing key.
+	SATokenKeyFile string
+	// SATokenSigningKeyFile is the path to the private key (or HMAC secret) the global
+	// gateway signs service-account tokens with. Setting it mounts the token endpoint.
+	// Only the global gateway issues tokens; regional gateways ignore it.
+	SATokenSigningKeyFile string
+	// SATokenSigningMethod is the JWT signing method of service-account tokens.
+	SATokenSigningMethod string
+	// SATokenIssuer is the "iss" claim of service-account tokens. It must differ from the
+	// issuer of the tokens AuthPlugin accepts.
+	SATokenIssuer string
+	// SATokenTTL is the lifetime of a service-account token when the request asks for none.
+	SATokenTTL time.Duration
+	// SATokenMaxTTL caps the lifetime a token request may ask for.
+	SATokenMaxTTL time.Duration
+	// TokenRevocation makes every JWT authenticator consult the token revocation list, an
+	// informer cache of the TokenRevocation resources of the global cluster.
+	TokenRevocation bool
+	// TokenRevocationKubeconfig is the path to the kubeconfig of the global cluster, for
+	// regional gateways whose own cluster does not hold the revocation list. Empty watches
+	// the gateway's own cluster.
+	TokenRevocationKubeconfig string
+	// TokenRevocationAdmins are the subjects allowed to use the revocation admin routes.
+	// The list is global, so tenant RBAC cannot govern it. Empty denies every caller.
+	TokenRevocationAdmins []string
+	// QuotaAdmins are the subjects allowed to write quotas. Tenants must not raise their
+	// own quotas, so tenant RBAC cannot govern it. Empty denies every caller.
+	QuotaAdmins []string
+	// AuthzPolicyFiles are paths to JSON files of CEL authorization policies (see
+	// policy.Policy) evaluated after RBAC: a request is allowed only when RBAC and every
+	// policy applying to its tenant allow it. Ignored in authn-only mode.
+	AuthzPolicyFiles []string
+	// AuthzPolicyCRs additionally evaluates the cluster's AuthorizationPolicy resources,
+	// watched through an informer. When true, Build also requires a non-nil dynClient.
+	AuthzPolicyCRs bool
 }
 
 // RegisterFlags adds auth-related flags to the given cobra command.
@@ -65,6 +113,9 @@ func RegisterFlags(cmd *cobra.Command, f *Flags) {
 	cmd.Flags().BoolVar(&f.Enabled, "auth-enabled", false,
 		"Enable bearer-token authentication and SECA RBAC authorization (disabled by default)")
 	cmd.Flags().StringVar(&f.AuthPlugin, "auth-plugin", "dummy", "Authentication plugin to use (one of: dummy, jwt)")
+	cmd.Flags().StringVar(&f.AuthChainFile, "auth-chain", "",
+		"Path to a JSON file listing the authenticators to chain, each routed by token issuer or prefix "+
+			"(replaces --auth-plugin and its flags)")
 	cmd.Flags().StringVar(&f.DummyUsersFile, "dummy-auth-users", "",
 		"Path to a JSON file mapping username→password for the Dummy authenticator "+
 			"(required when --auth-enabled is set)")
@@ -80,6 +131,28 @@ func RegisterFlags(cmd *cobra.Command, f *Flags) {
 		"Comma-separated provider IDs whose routes skip the authorization middleware "+
 			"(authn-only; no RBAC check or token down-scoping); the region catalog is "+
 			"tenant-less by spec, so seca.region is skipped by default")
+	cmd.Flags().StringVar(&f.SATokenKeyFile, "sa-token-key", "",
+		"Path to the verification key of gateway-issued service-account tokens: the raw HMAC secret for HS*, "+
+			"a PEM public key otherwise (enables service-account tokens; derived from --sa-token-signing-key when unset)")
+	cmd.Flags().StringVar(&f.SATokenSigningKeyFile, "sa-token-signing-key", "",
+		"Path to the PEM private key (or HMAC secret) service-account tokens are signed with; "+
+			"enables the token endpoint (global gateway only)")
+	cmd.Flags().StringVar(&f.SATokenSigningMethod, "sa-token-signing-method", "ES256", "JWT signing method of service-account tokens")
+	cmd.Flags().StringVar(&f.SATokenIssuer, "sa-token-issuer", "ecp-gateway", "The \"iss\" claim of service-account tokens")
+	cmd.Flags().DurationVar(&f.SATokenTTL, "sa-token-ttl", 15*time.Minute, "Lifetime of a service-account token when the request asks for none")
+	cmd.Flags().DurationVar(&f.SATokenMaxTTL, "sa-token-max-ttl", time.Hour, "Longest lifetime a service-account token request may ask for")
+	cmd.Flags().BoolVar(&f.TokenRevocation, "token-revocation", false,
+		"Reject JWTs listed in the token revocation list (TokenRevocation resources of the global cluster)")
+	cmd.Flags().StringVar(&f.TokenRevocationKubeconfig, "token-revocation-kubeconfig", "",
+		"Path to the kubeconfig of the global cluster holding the token revocation list (default: the gateway's own cluster)")
+	cmd.Flags().StringSliceVar(&f.TokenRevocationAdmins, "token-revocation-admins", nil,
+		"Comma-separated subjects allowed to revoke tokens through the admin routes (global gateway only)")
+	cmd.Flags().StringSliceVar(&f.QuotaAdmins, "quota-admins", nil,
+		"Comma-separated subjects allowed to write quotas (regional gateway only)")
+	cmd.Flags().StringSliceVar(&f.AuthzPolicyFiles, "authz-policy-file", nil,
+		"Comma-separated paths to JSON files of CEL authorization policies that must all allow a request RBAC allows")
+	cmd.Flags().BoolVar(&f.AuthzPolicyCRs, "authz-policy-crs", false,
+		"Also evaluate the cluster's AuthorizationPolicy resources as CEL authorization policies")
 }
 
 // Build constructs the Authenticator and Checker from the provided flags and readers.
@@ -90,38 +163,105 @@ func RegisterFlags(cmd *cobra.Command, f *Flags) {
 // non-nil). The caller is responsible for calling CachedChecker.Start before the server
 // starts serving requests.
 //
+// The authenticator is a chain (see AuthChainFile): when service-account tokens are
+// configured (see SATokenKeyFile), tokens carrying their issuer are routed to a
+// service-account JwtAuthenticator reading saReader, and every other token to the
+// configured authenticators. Each entry records the per-authenticator metrics.
+//
+// When revocations is non-nil (see BuildRevocationList), every JWT authenticator rejects
+// the tokens it lists. The caller is responsible for starting it.
+//
+// When CEL policies are configured (see AuthzPolicyFiles), the checker is the conjunction
+// of the RBAC checker and the policy checker; StartChecker starts both.
+//
 // Returns an error if --auth-enabled is true but the users file is missing or invalid.
 func Build(
 	flags *Flags,
 	dynClient dynamic.Interface,
 	roleReader persistence.ReaderRepo[*roledom.Role],
 	assignmentReader persistence.ReaderRepo[*radom.RoleAssignment],
+	saReader persistence.ReaderRepo[*sadom.ServiceAccount],
+	revocations *gatewayauthn.CachedRevocationList,
 	log *slog.Logger,
 ) (authnport.Authenticator, authzport.Checker, error) {
 	if !flags.Enabled {
 		return nil, nil, nil
 	}
 
-	authenticator, err := buildAuthenticator(flags)
+	var jwtOpts []gatewayauthn.JWTOption
+	if revocations != nil {
+		jwtOpts = append(jwtOpts, gatewayauthn.WithRevocations(revocations))
+	}
+	authenticator, err := buildChain(flags, saReader, jwtOpts...)
 	if err != nil {
 		return nil, nil, fmt.Errorf("build authenticator: %w", err)
 	}
 
 	if !flags.AuthzEnabled {
 		// Authn-only mode: identity is verified but no RBAC check is performed.
+		if len(flags.AuthzPolicyFiles) > 0 || flags.AuthzPolicyCRs {
+			log.Warn("authorization policies are ignored in authn-only mode (--authz-enabled=false)")
+		}
 		return authenticator, nil, nil
 	}
 
+	var checker authzport.Checker
 	if flags.AuthzCache {
 		if dynClient == nil {
 			return nil, nil, fmt.Errorf("--authz-cache requires a dynamic Kubernetes client")
 		}
-		checker := seca.NewCachedChecker(dynClient, log)
-		return authenticator, metrics.NewInstrumentedChecker(checker, "cached"), nil
+		checker = metrics.NewInstrumentedChecker(seca.NewCachedChecker(dynClient, log), "cached")
+	} else {
+		checker = metrics.NewInstrumentedChecker(seca.NewChecker(roleReader, assignmentReader, log), "direct")
 	}
 
-	checker := seca.NewChecker(roleReader, assignmentReader, log)
-	return authenticator, metrics.NewInstrumentedChecker(checker, "direct"), nil
+	policyChecker, err := buildPolicyChecker(flags, dynClient, log)
+	if err != nil {
+		return nil, nil, fmt.Errorf("build policy checker: %w", err)
+	}
+	if policyChecker != nil {
+		checker = policy.AllOf(checker, metrics.NewInstrumentedChecker(policyChecker, "policy"))
+	}
+	return authenticator, checker, nil
+}
+
+// buildPolicyChecker returns the CEL policy checker, or nil when no policy is configured.
+func buildPolicyChecker(flags *Flags, dynClient dynamic.Interface, log *slog.Logger) (*policy.Checker, error) {
+	if len(flags.AuthzPolicyFiles) == 0 && !flags.AuthzPolicyCRs {
+		return nil, nil
+	}
+	var policies []policy.Policy
+	for _, path := range flags.AuthzPolicyFiles {
+		loaded, err := policy.LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, loaded...)
+	}
+	var watchClient dynamic.Interface
+	if flags.AuthzPolicyCRs {
+		if dynClient == nil {
+			return nil, fmt.Errorf("--authz-policy-crs requires a dynamic Kubernetes client")
+		}
+		watchClient = dynClient
+	}
+	return policy.NewChecker(policies, watchClient, log)
+}
+
+// BuildEscalationGuard returns the privilege-escalation guard for Role and RoleAssignment
+// writes, or nil when RBAC is not enforced on the authorization provider (auth disabled,
+// authn-only mode, or seca.authorization listed in AuthzSkipProviders). Without RBAC
+// there is no notion of a permission the caller holds, so there is nothing to escalate.
+func BuildEscalationGuard(
+	flags *Flags,
+	roleReader persistence.ReaderRepo[*roledom.Role],
+	assignmentReader persistence.ReaderRepo[*radom.RoleAssignment],
+	log *slog.Logger,
+) authrest.EscalationGuard {
+	if !flags.Enabled || !flags.AuthzEnabled || flags.authzSkipped(seca.AuthorizationProvider) {
+		return nil
+	}
+	return seca.NewEscalationGuard(roleReader, assignmentReader, log)
 }
 
 // AuthnMiddleware returns the authentication middleware for the given Authenticator,
@@ -141,13 +281,13 @@ func AuthnMiddleware(authenticator authnport.Authenticator, log *slog.Logger) fu
 //
 // Example:
 //
-//	authzMW := auth.AuthzMiddleware(checker, "seca.network", "/providers/seca.network", log)
+//	authzMW := auth.AuthzMiddleware(checker, labels, "seca.network", "/providers/seca.network", log)
 //	opts.Middlewares = middleware.Chain[sdknetworkapi.MiddlewareFunc](authnMW, authzMW)
-func AuthzMiddleware(checker authzport.Checker, provider, baseURL string, log *slog.Logger) func(http.Handler) http.Handler {
+func AuthzMiddleware(checker authzport.Checker, labels authzport.LabelReader, provider, baseURL string, log *slog.Logger) func(http.Handler) http.Handler {
 	if checker == nil {
 		return nil
 	}
-	return middleware.NewAuthorization(checker, middleware.SECAClaimExtractor(provider, baseURL), log)
+	return middleware.NewAuthorization(checker, middleware.SECAClaimExtractor(provider, baseURL, labels), log)
 }
 
 // ProviderMWs returns the typed middleware slice for a provider when auth is enabled,
@@ -157,7 +297,7 @@ func AuthzMiddleware(checker authzport.Checker, provider, baseURL string, log *s
 //
 //	authv1.HandlerWithOptions(handler, authv1.StdHTTPServerOptions{
 //	    Middlewares: auth.ProviderMWs[authv1.MiddlewareFunc](
-//	        flags, authenticator, checker,
+//	        flags, authenticator, checker, labels,
 //	        "seca.authorization", roledom.AuthorizationBaseURL,
 //	        log,
 //	    ),
@@ -172,6 +312,7 @@ func ProviderMWs[M ~func(http.Handler) http.Handler](
 	flags *Flags,
 	authenticator authnport.Authenticator,
 	checker authzport.Checker,
+	labels authzport.LabelReader,
 	provider, baseURL string,
 	log *slog.Logger,
 ) []M {
@@ -189,7 +330,7 @@ func ProviderMWs[M ~func(http.Handler) http.Handler](
 			slog.String("provider", provider))
 		return middleware.Chain[M](metricsMW, authnMW)
 	}
-	authzMW := middleware.NewAuthorization(checker, middleware.SECAClaimExtractor(provider, baseURL), log)
+	authzMW := middleware.NewAuthorization(checker, middleware.SECAClaimExtractor(provider, baseURL, labels), log)
 	// metrics.Middleware is the first argument so Chain places it outermost (Chain reverses).
 	return middleware.Chain[M](metricsMW, authnMW, authzMW)
 }
@@ -214,35 +355,117 @@ func StartChecker(ctx context.Context, checker authzport.Checker, log *slog.Logg
 	return nil
 }
 
-// buildAuthenticator loads the Dummy authenticator from the configured users file.
-func buildAuthenticator(flags *Flags) (authnport.Authenticator, error) {
-	switch flags.AuthPlugin {
-	case "dummy":
-		if flags.DummyUsersFile == "" {
-			return nil, fmt.Errorf("--dummy-auth-users must be set when --auth-enabled is true")
-		}
-		data, err := os.ReadFile(flags.DummyUsersFile)
+// BuildRevocationList returns the informer-backed token revocation list, or nil when auth
+// or --token-revocation is disabled. It watches dynClient's cluster unless
+// --token-revocation-kubeconfig names another one. The caller is responsible for calling
+// Start before the server starts serving requests.
+func BuildRevocationList(flags *Flags, dynClient dynamic.Interface, log *slog.Logger) (*gatewayauthn.CachedRevocationList, error) {
+	if !flags.Enabled || !flags.TokenRevocation {
+		return nil, nil
+	}
+	if flags.TokenRevocationKubeconfig != "" {
+		config, err := clientcmd.BuildConfigFromFlags("", flags.TokenRevocationKubeconfig)
 		if err != nil {
-			return nil, fmt.Errorf("read dummy users file %q: %w", flags.DummyUsersFile, err)
-		}
-		var users map[string]string
-		if err := json.Unmarshal(data, &users); err != nil {
-			return nil, fmt.Errorf("parse dummy users file %q: %w", flags.DummyUsersFile, err)
+			return nil, fmt.Errorf("build token revocation kubeconfig %s: %w", flags.TokenRevocationKubeconfig, err)
 		}
-		return gatewayauthn.NewDummyAuthenticator(users), nil
-	case "jwt":
-		if flags.JwtSecretFile == "" || flags.JwtSigningMethod == "" {
-			return nil, fmt.Errorf("--jwt-secret and --jwt-signing-method must be set when --auth-plugin is 'jwt'")
+		if dynClient, err = dynamic.NewForConfig(config); err != nil {
+			return nil, fmt.Errorf("create token revocation client: %w", err)
 		}
-		data, err := os.ReadFile(flags.JwtSecretFile)
+	}
+	if dynClient == nil {
+		return nil, fmt.Errorf("--token-revocation requires a dynamic Kubernetes client")
+	}
+	return gatewayauthn.NewCachedRevocationList(dynClient, log)
+}
+
+// AdminMWs returns the middleware chain of the tenant-less admin routes: authentication,
+// then a check that the caller is one of --token-revocation-admins. SECA RBAC does not
+// apply, as the routes have no tenant. Returns nil when auth is disabled, in which case
+// the caller must not mount the admin routes at all.
+func AdminMWs(flags *Flags, authenticator authnport.Authenticator, provider string, log *slog.Logger) []func(http.Handler) http.Handler {
+	return adminMWs(flags.TokenRevocationAdmins, "--token-revocation-admins", "token revocation admin routes", authenticator, provider, log)
+}
+
+// QuotaAdminMWs returns the middleware chain of the quota write routes: authentication,
+// then a check that the caller is one of --quota-admins. Tenant RBAC does not apply, so a
+// tenant cannot raise its own quota. Returns nil when auth is disabled.
+func QuotaAdminMWs(flags *Flags, authenticator authnport.Authenticator, provider string, log *slog.Logger) []func(http.Handler) http.Handler {
+	return adminMWs(flags.QuotaAdmins, "--quota-admins", "quota write routes", authenticator, provider, log)
+}
+
+// adminMWs authenticates the caller and allows only the subjects in admins, which flag
+// configures, to reach routes.
+func adminMWs(admins []string, flag, routes string, authenticator authnport.Authenticator, provider string, log *slog.Logger) []func(http.Handler) http.Handler {
+	if authenticator == nil {
+		return nil
+	}
+	if len(admins) == 0 {
+		log.Warn(fmt.Sprintf("no %s configured: the %s deny every caller", flag, routes))
+	}
+	authzMW := middleware.NewAuthorization(admin.NewChecker(admins), admin.ClaimExtractor(provider), log)
+	return middleware.Chain[func(http.Handler) http.Handler](
+		metrics.Middleware(provider), middleware.NewAuthentication(authenticator, log), authzMW)
+}
+
+// BuildTokenIssuer returns the service-account token issuer, or nil when
+// --sa-token-signing-key is not set. Tokens are only honoured when auth is enabled, so
+// the issuer is nil while it is disabled, too.
+func BuildTokenIssuer(flags *Flags) (*gatewayauthn.TokenIssuer, error) {
+	if !flags.Enabled || flags.SATokenSigningKeyFile == "" {
+		return nil, nil
+	}
+	key, err := readSigningKey(flags)
+	if err != nil {
+		return nil, err
+	}
+	return gatewayauthn.NewTokenIssuer(key, flags.SATokenSigningMethod, flags.SATokenIssuer, flags.SATokenTTL, flags.SATokenMaxTTL)
+}
+
+// buildServiceAccountAuthenticator returns the JwtAuthenticator for service-account
+// tokens, or nil when neither --sa-token-key nor --sa-token-signing-key is set.
+func buildServiceAccountAuthenticator(flags *Flags, saReader persistence.ReaderRepo[*sadom.ServiceAccount], opts ...gatewayauthn.JWTOption) (authnport.Authenticator, error) {
+	var key any
+	switch {
+	case flags.SATokenKeyFile != "":
+		data, err := os.ReadFile(flags.SATokenKeyFile)
 		if err != nil {
-			return nil, fmt.Errorf("read JWT secret file %q: %w", flags.JwtSecretFile, err)
+			return nil, fmt.Errorf("read service-account token key %q: %w", flags.SATokenKeyFile, err)
 		}
-		key, err := gatewayauthn.ParseVerifyKey(flags.JwtSigningMethod, data)
+		if key, err = gatewayauthn.ParseVerifyKey(flags.SATokenSigningMethod, data); err != nil {
+			return nil, fmt.Errorf("parse service-account token key from %q: %w", flags.SATokenKeyFile, err)
+		}
+	case flags.SATokenSigningKeyFile != "":
+		signingKey, err := readSigningKey(flags)
 		if err != nil {
-			return nil, fmt.Errorf("parse JWT key from %q: %w", flags.JwtSecretFile, err)
+			return nil, err
+		}
+		if key, err = gatewayauthn.VerifyKeyFor(signingKey); err != nil {
+			return nil, err
 		}
-		return gatewayauthn.NewJWTAuthenticator(key, flags.JwtSigningMethod), nil
+	default:
+		return nil, nil
+	}
+	if flags.SATokenIssuer == "" {
+		return nil, fmt.Errorf("--sa-token-issuer must be set when service-account tokens are enabled")
+	}
+	if saReader == nil {
+		return nil, fmt.Errorf("service-account tokens require a service-account reader")
+	}
+	opts = append(opts,
+		gatewayauthn.WithIssuer(flags.SATokenIssuer),
+		gatewayauthn.WithServiceAccounts(gatewayauthn.NewServiceAccountValidator(saReader)))
+	return gatewayauthn.NewJWTAuthenticator(key, flags.SATokenSigningMethod, opts...), nil
+}
+
+// readSigningKey reads and parses --sa-token-signing-key.
+func readSigningKey(flags *Flags) (any, error) {
+	data, err := os.ReadFile(flags.SATokenSigningKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("read service-account signing key %q: %w", flags.SATokenSigningKeyFile, err)
+	}
+	key, err := gatewayauthn.ParseSigningKey(flags.SATokenSigningMethod, data)
+	if err != nil {
+		return nil, fmt.Errorf("parse service-account signing key from %q: %w", flags.SATokenSigningKeyFile, err)
 	}
-	return nil, fmt.Errorf("unknown auth plugin %q", flags.AuthPlugin)
+	return key, nil
 }
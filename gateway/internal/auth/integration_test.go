@@ -10,8 +10,12 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	jwt "github.com/golang-jwt/jwt/v5"
 	"github.com/spf13/cobra"
 
 	"github.com/eu-sovereign-cloud/ecp/framework/frontend/middleware"
@@ -244,9 +248,9 @@ func TestIntegration_AuthzSkipProviders(t *testing.T) {
 	log := discardLog()
 
 	skippedMWs := auth.ProviderMWs[func(http.Handler) http.Handler](
-		flags, a, denyChecker, "seca.region", "/providers/seca.region", log)
+		flags, a, denyChecker, nil, "seca.region", "/providers/seca.region", log)
 	enforcedMWs := auth.ProviderMWs[func(http.Handler) http.Handler](
-		flags, a, denyChecker, "seca.compute", "/providers/seca.compute", log)
+		flags, a, denyChecker, nil, "seca.compute", "/providers/seca.compute", log)
 
 	// Route through a real mux so r.Pattern is set for the claim extractor.
 	mux := http.NewServeMux()
@@ -389,3 +393,61 @@ func TestIntegration_DownScope_ReducesAccess(t *testing.T) {
 		})
 	}
 }
+
+// TestIntegration_AuthChain builds the authenticator from an --auth-chain file: a JWT
+// issuer routed by "iss" and a catch-all dummy plugin both authenticate, and a JWT from
+// an unlisted issuer falls to the dummy plugin and is rejected with 401.
+func TestIntegration_AuthChain(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		return path
+	}
+	secret := "supersecretkey"
+	keyFile := write("ci.key", secret)
+	usersFile := write("users.json", `{"alice":"s3cr3t"}`)
+	chainFile := write("chain.json", `[
+		{"name": "ci", "plugin": "jwt", "issuer": "https://ci.example.com", "signingMethod": "HS256", "keyFile": "`+keyFile+`"},
+		{"name": "humans", "plugin": "dummy", "usersFile": "`+usersFile+`"}
+	]`)
+
+	flags := &auth.Flags{Enabled: true, AuthChainFile: chainFile}
+	a, _, err := auth.Build(flags, nil, nil, nil, nil, nil, discardLog())
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	h := buildChain(a, allowChecker)
+
+	sign := func(iss string) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": "pipeline", "iss": iss, "exp": time.Now().Add(time.Hour).Unix(),
+		}).SignedString([]byte(secret))
+		if err != nil {
+			t.Fatalf("sign token: %v", err)
+		}
+		return s
+	}
+	for token, want := range map[string]int{
+		sign("https://ci.example.com"):      http.StatusOK,
+		bearerToken("alice", "s3cr3t", nil): http.StatusOK,
+		sign("https://other.example.com"):   http.StatusUnauthorized,
+	} {
+		req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/instances", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != want {
+			t.Errorf("token %.16s...: want %d, got %d — body: %s", token, want, w.Code, w.Body.String())
+		}
+	}
+
+	bad := write("bad.json", `[{"name": "humans", "plugin": "dummy", "issuer": "x", "usersFile": "`+usersFile+`"}]`)
+	if _, _, err := auth.Build(&auth.Flags{Enabled: true, AuthChainFile: bad}, nil, nil, nil, nil, nil, discardLog()); err == nil {
+		t.Error("Build() with an issuer on the dummy plugin: expected error")
+	}
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+	rak8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment/backend/kubernetes"
+	rolek8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role/backend/kubernetes"
+	instancek8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance/backend/kubernetes"
+	internetgatewayk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway/backend/kubernetes"
+	netk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network/backend/kubernetes"
+	nick8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic/backend/kubernetes"
+	publicipk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/public-ip/backend/kubernetes"
+	routetablek8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/route-table/backend/kubernetes"
+	securitygrouprulek8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group-rule/backend/kubernetes"
+	securitygroupk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group/backend/kubernetes"
+	subnetk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/subnet/backend/kubernetes"
+	bsk8s "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage/backend/kubernetes"
+	imgk8s "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/image/backend/kubernetes"
+	wsk8s "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/backend/kubernetes"
+)
+
+// labelledGVRs maps each labelled SECA kind, by provider and resource kind path as they
+// appear in an authorization claim, to the resource holding it.
+var labelledGVRs = map[string]schema.GroupVersionResource{
+	k8sadapter.LabelReaderKey("seca.compute", "instances"):              instancek8s.InstanceGVR,
+	k8sadapter.LabelReaderKey("seca.network", "networks"):               netk8s.NetworkGVR,
+	k8sadapter.LabelReaderKey("seca.network", "networks/subnets"):       subnetk8s.SubnetGVR,
+	k8sadapter.LabelReaderKey("seca.network", "networks/route-tables"):  routetablek8s.RouteTableGVR,
+	k8sadapter.LabelReaderKey("seca.network", "internet-gateways"):      internetgatewayk8s.InternetGatewayGVR,
+	k8sadapter.LabelReaderKey("seca.network", "nics"):                   nick8s.NICGVR,
+	k8sadapter.LabelReaderKey("seca.network", "public-ips"):             publicipk8s.PublicIPGVR,
+	k8sadapter.LabelReaderKey("seca.network", "security-groups"):        securitygroupk8s.SecurityGroupGVR,
+	k8sadapter.LabelReaderKey("seca.network", "security-group-rules"):   securitygrouprulek8s.SecurityGroupRuleGVR,
+	k8sadapter.LabelReaderKey("seca.storage", "block-storages"):         bsk8s.BlockStorageGVR,
+	k8sadapter.LabelReaderKey("seca.storage", "images"):                 imgk8s.ImageGVR,
+	k8sadapter.LabelReaderKey("seca.workspace", "workspaces"):           wsk8s.WorkspaceGVR,
+	k8sadapter.LabelReaderKey("seca.authorization", "roles"):            rolek8s.RoleGVR,
+	k8sadapter.LabelReaderKey("seca.authorization", "role-assignments"): rak8s.RoleAssignmentGVR,
+}
+
+// NewLabelReader returns the reader of the stored labels that updates and deletes are
+// authorized against, for ProviderMWs.
+func NewLabelReader(client dynamic.Interface) authzport.LabelReader {
+	return k8sadapter.NewLabelReaderAdapter(client, labelledGVRs)
+}
@@ -0,0 +1,97 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// ChainEntry is one authenticator of a Chain and the tokens routed to it: those whose
+// "iss" claim equals Issuer, those starting with Prefix, or, with neither set, any token
+// no other entry claims.
+type ChainEntry struct {
+	// Name identifies the entry in errors and metrics.
+	Name          string
+	Issuer        string
+	Prefix        string
+	Authenticator authnport.Authenticator
+}
+
+// Chain runs a bearer token through an ordered list of authenticators, so that several
+// token sources (e.g. an external IdP for humans and an internal issuer for CI) are
+// accepted at once.
+//
+// A token is routed to the entries selecting it, most specific selector first: the entries
+// whose Issuer equals its unverified "iss" claim, else those whose Prefix it starts with,
+// else the entries without a selector. The claim only selects; the chosen authenticator
+// verifies the token in full (including the issuer, see [WithIssuer]).
+//
+// The selected entries are tried in order. A credential failure falls through to the next
+// one, and the last credential failure is returned when none accepts the token. A
+// technical failure (kernel.ErrInternal or kernel.ErrUnavailable) stops the chain and is
+// returned as is: an unreachable identity provider must answer 500, never a 401 from the
+// next authenticator in line.
+type Chain struct {
+	entries []ChainEntry
+}
+
+// NewChain creates a Chain of the given entries, tried in order.
+func NewChain(entries ...ChainEntry) *Chain {
+	return &Chain{entries: entries}
+}
+
+// Authenticate implements authnport.Authenticator.
+func (c *Chain) Authenticate(ctx context.Context, token string) (*authnport.Identity, error) {
+	candidates := c.route(token)
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w: no authenticator accepts this token", kernel.ErrUnauthorized)
+	}
+	var lastErr error
+	for _, e := range candidates {
+		id, err := e.Authenticator.Authenticate(ctx, token)
+		if err == nil {
+			return id, nil
+		}
+		if errors.Is(err, kernel.ErrInternal) || errors.Is(err, kernel.ErrUnavailable) {
+			return nil, fmt.Errorf("authenticator %s: %w", e.Name, err)
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// route returns the entries selecting token, most specific selector first.
+func (c *Chain) route(token string) []ChainEntry {
+	issuer := unverifiedIssuer(token)
+	selectors := []func(ChainEntry) bool{
+		func(e ChainEntry) bool { return e.Issuer != "" && e.Issuer == issuer },
+		func(e ChainEntry) bool { return e.Prefix != "" && strings.HasPrefix(token, e.Prefix) },
+		func(e ChainEntry) bool { return e.Issuer == "" && e.Prefix == "" },
+	}
+	for _, selects := range selectors {
+		var out []ChainEntry
+		for _, e := range c.entries {
+			if selects(e) {
+				out = append(out, e)
+			}
+		}
+		if len(out) > 0 {
+			return out
+		}
+	}
+	return nil
+}
+
+// unverifiedIssuer returns the "iss" claim of token, or "" when token is not a JWT.
+func unverifiedIssuer(token string) string {
+	claims := &jwt.RegisteredClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return ""
+	}
+	return claims.Issuer
+}
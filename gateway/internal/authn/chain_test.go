@@ -0,0 +1,99 @@
+package authn
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// stubAuthenticator returns a fixed outcome and counts its calls.
+type stubAuthenticator struct {
+	subject string
+	err     error
+	calls   int
+}
+
+func (s *stubAuthenticator) Authenticate(context.Context, string) (*authnport.Identity, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &authnport.Identity{Subject: s.subject}, nil
+}
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("supersecretkey")
+	sign := func(iss string) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": "bob", "iss": iss, "exp": time.Now().Add(time.Hour).Unix(),
+		}).SignedString(secret)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return s
+	}
+	dummyToken := base64.StdEncoding.EncodeToString([]byte(`{"username":"alice","password":"s3cr3t"}`))
+
+	t.Run("routes by issuer, prefix, then catch-all", func(t *testing.T) {
+		t.Parallel()
+		chain := NewChain(
+			ChainEntry{Name: "ci", Issuer: "ci-issuer", Authenticator: NewJWTAuthenticator(secret, "HS256", WithIssuer("ci-issuer"))},
+			ChainEntry{Name: "opaque", Prefix: "ecp_", Authenticator: &stubAuthenticator{subject: "robot"}},
+			ChainEntry{Name: "dummy", Authenticator: NewDummyAuthenticator(map[string]string{"alice": "s3cr3t"})},
+		)
+		for token, want := range map[string]string{sign("ci-issuer"): "bob", "ecp_123": "robot", dummyToken: "alice"} {
+			id, err := chain.Authenticate(context.Background(), token)
+			if err != nil || id.Subject != want {
+				t.Errorf("Authenticate(%.12s...) = %+v, %v; want subject %s", token, id, err, want)
+			}
+		}
+		if _, err := chain.Authenticate(context.Background(), sign("someone-else")); !isUnauthorized(err) {
+			t.Errorf("unknown issuer falling to the dummy plugin: err = %v, want ErrUnauthorized", err)
+		}
+	})
+
+	t.Run("credential failure falls through", func(t *testing.T) {
+		t.Parallel()
+		first := &stubAuthenticator{err: kernel.ErrUnauthorized}
+		second := &stubAuthenticator{subject: "alice"}
+		id, err := NewChain(ChainEntry{Name: "a", Authenticator: first}, ChainEntry{Name: "b", Authenticator: second}).
+			Authenticate(context.Background(), "token")
+		if err != nil || id.Subject != "alice" {
+			t.Errorf("Authenticate() = %+v, %v; want alice from the second authenticator", id, err)
+		}
+	})
+
+	t.Run("technical failure stops the chain", func(t *testing.T) {
+		t.Parallel()
+		first := &stubAuthenticator{err: kernel.NewError(kernel.KindUnavailable, errors.New("idp unreachable"))}
+		second := &stubAuthenticator{subject: "alice"}
+		_, err := NewChain(ChainEntry{Name: "a", Authenticator: first}, ChainEntry{Name: "b", Authenticator: second}).
+			Authenticate(context.Background(), "token")
+		if !errors.Is(err, kernel.ErrUnavailable) {
+			t.Errorf("err = %v, want ErrUnavailable", err)
+		}
+		if second.calls != 0 {
+			t.Error("the chain went on after a technical failure")
+		}
+	})
+
+	t.Run("issuer match is exclusive", func(t *testing.T) {
+		t.Parallel()
+		catchAll := &stubAuthenticator{subject: "anyone"}
+		_, err := NewChain(
+			ChainEntry{Name: "ci", Issuer: "ci-issuer", Authenticator: &stubAuthenticator{err: kernel.ErrUnauthorized}},
+			ChainEntry{Name: "any", Authenticator: catchAll},
+		).Authenticate(context.Background(), sign("ci-issuer"))
+		if !isUnauthorized(err) || catchAll.calls != 0 {
+			t.Errorf("err = %v, catch-all calls = %d; want ErrUnauthorized without reaching the catch-all", err, catchAll.calls)
+		}
+	})
+}
@@ -33,7 +33,7 @@ type tokenPayload struct {
 // There is no cryptographic signature; any caller who knows a valid username+password can
 // impersonate that subject. Do NOT use in production.
 //
-// Token format: base64(JSON{"username":"alice","password":"s3cr3t","scope":{"tenants":["t1"]}})
+// Token format: base64(JSON{"username":"alice","password":"s3cr3t","scope":{"tenants":["t1"],"verbs":["get","list"]}})
 type DummyAuthenticator struct {
 	// users maps username → expected password.
 	users map[string]string
@@ -74,9 +74,9 @@ func (d *DummyAuthenticator) Authenticate(_ context.Context, token string) (*aut
 		return nil, fmt.Errorf("%w: invalid credentials", kernel.ErrUnauthorized)
 	}
 
-	var scope resource.TokenScope
-	if payload.Scope != nil {
-		scope = *payload.Scope
+	scope, err := tokenScope(payload.Scope)
+	if err != nil {
+		return nil, err
 	}
 
 	return &authnport.Identity{
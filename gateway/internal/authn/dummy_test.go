@@ -54,6 +54,25 @@ func TestDummyAuthenticator(t *testing.T) {
 				Workspaces: []string{"w1"},
 			},
 		},
+		{
+			name: "provider, verb and resource caps, verbs lower-cased",
+			token: makeToken("bob", "p@ssw0rd", &resource.TokenScope{
+				Providers: []string{"seca.compute"},
+				Verbs:     []string{"GET", "list"},
+				Resources: []string{"instances", "instances/*"},
+			}),
+			wantSubject: "bob",
+			wantScope: resource.TokenScope{
+				Providers: []string{"seca.compute"},
+				Verbs:     []string{"get", "list"},
+				Resources: []string{"instances", "instances/*"},
+			},
+		},
+		{
+			name:    "invalid resource pattern in scope",
+			token:   makeToken("bob", "p@ssw0rd", &resource.TokenScope{Resources: []string{"instances/["}}),
+			wantErr: true,
+		},
 		{
 			// Roles are never read from the token; a stray "roles" field must be ignored.
 			name:        "roles field in token is ignored",
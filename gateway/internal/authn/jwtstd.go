@@ -7,10 +7,12 @@ import (
 	"encoding/pem"
 	"fmt"
 	"strings"
+	"time"
 
 	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
 	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
 	jwt "github.com/golang-jwt/jwt/v5"
 )
 
@@ -37,17 +39,42 @@ func ParseVerifyKey(method string, data []byte) (any, error) {
 // The key type must match the signing method: []byte for HS*,
 // *ecdsa.PublicKey for ES*, *rsa.PublicKey for RS*/PS*, ed25519.PublicKey
 // for EdDSA — see [ParseVerifyKey].
+//
+// Subjects starting with "serviceaccount:" are reserved for the tokens the gateway issues
+// itself: an authenticator built without [WithServiceAccounts] rejects them, so an external
+// issuer cannot impersonate a service account, and one built with it accepts nothing else.
 type JwtAuthenticator struct {
-	secret        any
-	signingMethod string
+	secret          any
+	signingMethod   string
+	issuer          string
+	serviceAccounts *ServiceAccountValidator
+	revocations     RevocationList
+}
+
+// JWTOption configures a JwtAuthenticator.
+type JWTOption func(*JwtAuthenticator)
+
+// WithIssuer requires the token's "iss" claim to equal issuer.
+func WithIssuer(issuer string) JWTOption {
+	return func(j *JwtAuthenticator) { j.issuer = issuer }
+}
+
+// WithServiceAccounts makes the authenticator verify gateway-issued service-account
+// tokens: the subject must name a service account, and v must still honour the token.
+func WithServiceAccounts(v *ServiceAccountValidator) JWTOption {
+	return func(j *JwtAuthenticator) { j.serviceAccounts = v }
 }
 
 // NewJWTAuthenticator creates a JwtAuthenticator.
-func NewJWTAuthenticator(secret any, signingMethod string) *JwtAuthenticator {
-	return &JwtAuthenticator{
+func NewJWTAuthenticator(secret any, signingMethod string, opts ...JWTOption) *JwtAuthenticator {
+	j := &JwtAuthenticator{
 		secret:        secret,
 		signingMethod: signingMethod,
 	}
+	for _, opt := range opts {
+		opt(j)
+	}
+	return j
 }
 
 // jwtClaims is the expected JWT payload. Embedding RegisteredClaims provides
@@ -56,15 +83,24 @@ func NewJWTAuthenticator(secret any, signingMethod string) *JwtAuthenticator {
 type jwtClaims struct {
 	jwt.RegisteredClaims
 	Scope *resource.TokenScope `json:"scope,omitempty"`
+	// ServiceAccountUID is the UID of the service account a gateway-issued token was issued
+	// for; see TokenIssuer.
+	ServiceAccountUID string `json:"sa_uid,omitempty"`
 }
 
 // Authenticate implements authnport.Authenticator, verifies the JWT token, and returns an Identity carrying the subject and any optional down-scoping asserted by the token.
-// Returns kernel.ErrUnauthorized when the token is malformed or credentials are invalid.
-func (j *JwtAuthenticator) Authenticate(_ context.Context, tokenString string) (*authnport.Identity, error) {
+// Returns kernel.ErrUnauthorized when the token is malformed or credentials are invalid,
+// or when the token was revoked, and an error of kind kernel.KindInternal when the
+// revocation state cannot be read.
+func (j *JwtAuthenticator) Authenticate(ctx context.Context, tokenString string) (*authnport.Identity, error) {
+	parserOpts := []jwt.ParserOption{jwt.WithValidMethods([]string{j.signingMethod}), jwt.WithExpirationRequired()}
+	if j.issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(j.issuer))
+	}
 	claims := &jwtClaims{}
 	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
 		return j.secret, nil
-	}, jwt.WithValidMethods([]string{j.signingMethod}), jwt.WithExpirationRequired())
+	}, parserOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: token is not valid JWT: %w", kernel.ErrUnauthorized, err)
 	}
@@ -73,9 +109,38 @@ func (j *JwtAuthenticator) Authenticate(_ context.Context, tokenString string) (
 		return nil, fmt.Errorf("%w: token subject is missing", kernel.ErrUnauthorized)
 	}
 
-	scope := resource.TokenScope{}
-	if claims.Scope != nil {
-		scope = *claims.Scope
+	if j.revocations != nil {
+		var issuedAt *time.Time
+		if claims.IssuedAt != nil {
+			issuedAt = &claims.IssuedAt.Time
+		}
+		revoked, err := j.revocations.Revoked(ctx, claims.ID, claims.Subject, issuedAt)
+		if err != nil {
+			return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("consult token revocation list: %w", err))
+		}
+		if revoked {
+			return nil, fmt.Errorf("%w: token was revoked", kernel.ErrUnauthorized)
+		}
+	}
+
+	isServiceAccount := sadom.IsSubject(claims.Subject)
+	switch {
+	case isServiceAccount && j.serviceAccounts == nil:
+		return nil, fmt.Errorf("%w: subject %q is reserved for gateway-issued service-account tokens", kernel.ErrUnauthorized, claims.Subject)
+	case !isServiceAccount && j.serviceAccounts != nil:
+		return nil, fmt.Errorf("%w: subject %q is not a service account", kernel.ErrUnauthorized, claims.Subject)
+	case isServiceAccount:
+		if claims.IssuedAt == nil {
+			return nil, fmt.Errorf("%w: service-account token has no issue time", kernel.ErrUnauthorized)
+		}
+		if err := j.serviceAccounts.Validate(ctx, claims.Subject, claims.ServiceAccountUID, claims.IssuedAt.Time); err != nil {
+			return nil, err
+		}
+	}
+
+	scope, err := tokenScope(claims.Scope)
+	if err != nil {
+		return nil, err
 	}
 	return &authnport.Identity{
 		Subject:    claims.Subject,
@@ -121,6 +121,27 @@ func TestJWTAuthenticator(t *testing.T) {
 				Workspaces: []string{"w1"},
 			},
 		},
+		{
+			name: "provider, verb and resource caps",
+			token: makeToken(keyHS, jwt.SigningMethodHS256, "monitoring", &resource.TokenScope{
+				Providers: []string{"seca.compute"},
+				Verbs:     []string{"get", "list"},
+				Resources: []string{"instances*"},
+			}, nil),
+			signingMethod: jwt.SigningMethodHS256,
+			wantSubject:   "monitoring",
+			wantScope: resource.TokenScope{
+				Providers: []string{"seca.compute"},
+				Verbs:     []string{"get", "list"},
+				Resources: []string{"instances*"},
+			},
+		},
+		{
+			name:          "invalid resource pattern in scope",
+			token:         makeToken(keyHS, jwt.SigningMethodHS256, "monitoring", &resource.TokenScope{Resources: []string{"["}}, nil),
+			signingMethod: jwt.SigningMethodHS256,
+			wantErr:       true,
+		},
 		{
 			name:          "roles field in token is ignored",
 			token:         makeToken(keyRS, jwt.SigningMethodRS512, "alice", nil, jwt.MapClaims{"roles": []string{"admin"}}),
@@ -0,0 +1,127 @@
+package authn
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	trk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation/backend/kubernetes"
+)
+
+// RevocationList tells whether a token was revoked. issuedAt is nil when the token has no
+// "iat" claim. An error means the list could not be consulted; it is never a denial.
+type RevocationList interface {
+	Revoked(ctx context.Context, jti, subject string, issuedAt *time.Time) (bool, error)
+}
+
+// WithRevocations makes the authenticator reject the tokens l reports as revoked.
+func WithRevocations(l RevocationList) JWTOption {
+	return func(j *JwtAuthenticator) { j.revocations = l }
+}
+
+const (
+	// revocationResync is the period after which the informer re-lists all revocations.
+	revocationResync = 5 * time.Minute
+
+	jtiIndex     = "jti"
+	subjectIndex = "subject"
+)
+
+// CachedRevocationList is the informer-backed RevocationList. It watches the
+// TokenRevocation resources of the global cluster and indexes them by jti and by subject,
+// so a lookup costs two index reads regardless of the size of the list.
+//
+// Lifecycle: call Start once at server startup and pass the server's context so the
+// informer is stopped on shutdown. Until the cache has synced, Revoked returns an error
+// rather than reporting an empty list: a gateway that cannot see the list must not honour
+// revoked tokens.
+type CachedRevocationList struct {
+	factory  dynamicinformer.DynamicSharedInformerFactory
+	informer informers.GenericInformer
+	log      *slog.Logger
+	now      func() time.Time
+}
+
+var _ RevocationList = (*CachedRevocationList)(nil)
+
+// NewCachedRevocationList creates a CachedRevocationList backed by the given Kubernetes
+// dynamic client, which must point at the global cluster.
+func NewCachedRevocationList(dynClient dynamic.Interface, log *slog.Logger) (*CachedRevocationList, error) {
+	factory := dynamicinformer.NewDynamicSharedInformerFactory(dynClient, revocationResync)
+	informer := factory.ForResource(trk8s.TokenRevocationGVR)
+	// Indexers must be added before the informer starts.
+	if err := informer.Informer().AddIndexers(cache.Indexers{
+		jtiIndex:     specFieldIndex("jti"),
+		subjectIndex: specFieldIndex("subject"),
+	}); err != nil {
+		return nil, fmt.Errorf("add token revocation indexers: %w", err)
+	}
+	return &CachedRevocationList{factory: factory, informer: informer, log: log, now: time.Now}, nil
+}
+
+// Start starts the informer and blocks until its cache is synced. Returns an error if the
+// context is cancelled before sync completes.
+func (c *CachedRevocationList) Start(ctx context.Context) error {
+	c.log.Info("authn: starting token revocation list")
+	c.factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.informer.Informer().HasSynced) {
+		return fmt.Errorf("informer cache sync timed out for %s", trk8s.TokenRevocationGVR.Resource)
+	}
+	return nil
+}
+
+// Revoked implements RevocationList.
+func (c *CachedRevocationList) Revoked(_ context.Context, jti, subject string, issuedAt *time.Time) (bool, error) {
+	if !c.informer.Informer().HasSynced() {
+		return false, fmt.Errorf("token revocation list is not synced")
+	}
+	now := c.now()
+	lookups := []struct{ index, value string }{{subjectIndex, subject}}
+	if jti != "" {
+		lookups = append(lookups, struct{ index, value string }{jtiIndex, jti})
+	}
+	for _, l := range lookups {
+		objs, err := c.informer.Informer().GetIndexer().ByIndex(l.index, l.value)
+		if err != nil {
+			return false, fmt.Errorf("look up token revocations by %s: %w", l.index, err)
+		}
+		for _, obj := range objs {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				return false, fmt.Errorf("unexpected object type in informer cache: %T", obj)
+			}
+			tr, err := trk8s.TokenRevocationFromCR(u)
+			if err != nil {
+				c.log.Warn("authn: skip unconvertible token revocation", slog.String("name", u.GetName()), slog.Any("error", err))
+				continue
+			}
+			if tr.Spec.Revokes(jti, subject, issuedAt, now) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// specFieldIndex indexes a TokenRevocation by a string field of its spec. Entries that
+// leave the field empty are not indexed under it.
+func specFieldIndex(field string) cache.IndexFunc {
+	return func(obj any) ([]string, error) {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T", obj)
+		}
+		v, _, err := unstructured.NestedString(u.Object, "spec", field)
+		if err != nil || v == "" {
+			return nil, err
+		}
+		return []string{v}, nil
+	}
+}
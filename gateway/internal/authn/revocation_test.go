@@ -0,0 +1,112 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	trk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation/backend/kubernetes"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// fakeRevocations revokes the listed token ids, or fails every lookup with err.
+type fakeRevocations struct {
+	jtis map[string]bool
+	err  error
+}
+
+func (f *fakeRevocations) Revoked(_ context.Context, jti, _ string, _ *time.Time) (bool, error) {
+	return f.jtis[jti], f.err
+}
+
+func TestJWTAuthenticator_Revocations(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("supersecretkey")
+	sign := func(jti string) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": "alice", "jti": jti, "exp": time.Now().Add(time.Hour).Unix(),
+		}).SignedString(secret)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return s
+	}
+
+	a := NewJWTAuthenticator(secret, "HS256", WithRevocations(&fakeRevocations{jtis: map[string]bool{"leaked": true}}))
+	if _, err := a.Authenticate(context.Background(), sign("fine")); err != nil {
+		t.Errorf("token not on the list: err = %v", err)
+	}
+	if _, err := a.Authenticate(context.Background(), sign("leaked")); !isUnauthorized(err) {
+		t.Errorf("revoked token: err = %v, want ErrUnauthorized", err)
+	}
+
+	broken := NewJWTAuthenticator(secret, "HS256", WithRevocations(&fakeRevocations{err: errors.New("not synced")}))
+	if _, err := broken.Authenticate(context.Background(), sign("fine")); !errors.Is(err, kernel.ErrInternal) {
+		t.Errorf("unreadable revocation list: err = %v, want ErrInternal", err)
+	}
+}
+
+func TestCachedRevocationList(t *testing.T) {
+	t.Parallel()
+
+	revokedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	bySubject := &trk8s.TokenRevocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "subject-alice"},
+		Spec:       trk8s.TokenRevocationSpec{Subject: "alice", IssuedBefore: &metav1.Time{Time: revokedAt}},
+	}
+	bySubject.SetGroupVersionKind(trk8s.TokenRevocationGVK)
+	byID := &trk8s.TokenRevocation{
+		ObjectMeta: metav1.ObjectMeta{Name: "jti-leaked"},
+		Spec:       trk8s.TokenRevocationSpec{JTI: "leaked"},
+	}
+	byID.SetGroupVersionKind(trk8s.TokenRevocationGVK)
+
+	scheme := runtime.NewScheme()
+	_ = trk8s.AddToScheme(scheme)
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{trk8s.TokenRevocationGVR: "TokenRevocationList"}, bySubject, byID)
+
+	list, err := NewCachedRevocationList(client, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("NewCachedRevocationList() error = %v", err)
+	}
+	if _, err := list.Revoked(context.Background(), "leaked", "bob", nil); err == nil {
+		t.Error("lookup before sync: expected error")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := list.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	before, after := revokedAt.Add(-time.Minute), revokedAt.Add(time.Minute)
+	tests := []struct {
+		name     string
+		jti      string
+		subject  string
+		issuedAt *time.Time
+		want     bool
+	}{
+		{name: "revoked token id", jti: "leaked", subject: "bob", issuedAt: &after, want: true},
+		{name: "other token id", jti: "fine", subject: "bob", issuedAt: &before},
+		{name: "subject token issued before", subject: "alice", issuedAt: &before, want: true},
+		{name: "subject token issued after", subject: "alice", issuedAt: &after},
+	}
+	for _, tc := range tests {
+		got, err := list.Revoked(context.Background(), tc.jti, tc.subject, tc.issuedAt)
+		if err != nil || got != tc.want {
+			t.Errorf("%s: Revoked() = %v, %v; want %v", tc.name, got, err, tc.want)
+		}
+	}
+}
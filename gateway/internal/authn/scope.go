@@ -0,0 +1,35 @@
+package authn
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+)
+
+// tokenScope returns the down-scoping cap a token asserts, or the zero (uncapped) scope
+// when it asserts none. Verbs are lower-cased to match the authorization claim. A resource
+// pattern that is not a valid glob rejects the token rather than being ignored later, so
+// a malformed cap cannot silently deny every request it was meant to allow.
+func tokenScope(s *resource.TokenScope) (resource.TokenScope, error) {
+	if s == nil {
+		return resource.TokenScope{}, nil
+	}
+	scope := *s
+	if len(scope.Verbs) > 0 {
+		verbs := make([]string, len(scope.Verbs))
+		for i, v := range scope.Verbs {
+			verbs[i] = strings.ToLower(v)
+		}
+		scope.Verbs = verbs
+	}
+	for _, pattern := range scope.Resources {
+		if _, err := glob.Compile(pattern); err != nil {
+			return resource.TokenScope{}, fmt.Errorf("%w: token scope resource pattern %q is invalid", kernel.ErrUnauthorized, pattern)
+		}
+	}
+	return scope, nil
+}
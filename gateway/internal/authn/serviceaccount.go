@@ -0,0 +1,187 @@
+package authn
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// ParseSigningKey turns the contents of a key file into the signing key golang-jwt
+// expects for the given signing method: the raw bytes for HS* (they are the HMAC secret),
+// or a PEM-encoded private key (PKCS#8, or the SEC 1 and PKCS#1 forms openssl writes for
+// EC and RSA keys) for every other method.
+func ParseSigningKey(method string, data []byte) (any, error) {
+	if jwt.GetSigningMethod(method) == nil {
+		return nil, fmt.Errorf("unknown JWT signing method %q", method)
+	}
+	if strings.HasPrefix(method, "HS") {
+		return data, nil
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("JWT signing key is not PEM-encoded")
+	}
+	switch block.Type {
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+}
+
+// VerifyKeyFor returns the key that verifies signatures made with signingKey: the key
+// itself for HMAC secrets, the public half otherwise.
+func VerifyKeyFor(signingKey any) (any, error) {
+	if secret, ok := signingKey.([]byte); ok {
+		return secret, nil
+	}
+	signer, ok := signingKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported signing key type %T", signingKey)
+	}
+	return signer.Public(), nil
+}
+
+// TokenIssuer signs short-lived service-account tokens. The tokens are standard JWTs that
+// a JwtAuthenticator built with the matching verification key, [WithIssuer] and
+// [WithServiceAccounts] accepts:
+//
+//	{"iss": <issuer>, "sub": "serviceaccount:<tenant>:<name>", "iat": ..., "exp": ...,
+//	 "jti": <random>, "sa_uid": <UID of the account>,
+//	 "scope": <TokenScope, tenants pinned to <tenant>>}
+type TokenIssuer struct {
+	key           any
+	signingMethod jwt.SigningMethod
+	issuer        string
+	defaultTTL    time.Duration
+	maxTTL        time.Duration
+	now           func() time.Time
+}
+
+// NewTokenIssuer creates a TokenIssuer signing with key (see [ParseSigningKey]). Tokens
+// live defaultTTL unless the request asks for another lifetime up to maxTTL.
+func NewTokenIssuer(key any, signingMethod, issuer string, defaultTTL, maxTTL time.Duration) (*TokenIssuer, error) {
+	method := jwt.GetSigningMethod(signingMethod)
+	if method == nil {
+		return nil, fmt.Errorf("unknown JWT signing method %q", signingMethod)
+	}
+	if defaultTTL <= 0 || maxTTL < defaultTTL {
+		return nil, fmt.Errorf("token lifetimes must satisfy 0 < default (%s) <= max (%s)", defaultTTL, maxTTL)
+	}
+	return &TokenIssuer{
+		key:           key,
+		signingMethod: method,
+		issuer:        issuer,
+		defaultTTL:    defaultTTL,
+		maxTTL:        maxTTL,
+		now:           time.Now,
+	}, nil
+}
+
+// serviceAccountClaims is the payload of an issued token.
+type serviceAccountClaims struct {
+	jwt.RegisteredClaims
+	Scope *resource.TokenScope `json:"scope,omitempty"`
+	// ServiceAccountUID binds the token to the account it was issued for, so that an account
+	// recreated under the same name does not honour it.
+	ServiceAccountUID string `json:"sa_uid,omitempty"`
+}
+
+// IssueToken implements authrest.TokenIssuer. A lifetime above the maximum is a
+// validation error rather than being capped silently, so callers learn the limit.
+func (i *TokenIssuer) IssueToken(_ context.Context, sa *sadom.ServiceAccount, req sadom.TokenRequest) (sadom.Token, error) {
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = i.defaultTTL
+	}
+	if ttl > i.maxTTL {
+		return sadom.Token{}, kernel.NewError(kernel.KindValidation,
+			fmt.Errorf("token lifetime %s exceeds the maximum of %s", ttl, i.maxTTL),
+			kernel.ErrorSource{Name: "/expirationSeconds", Value: strconv.FormatInt(int64(ttl/time.Second), 10)})
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return sadom.Token{}, kernel.NewError(kernel.KindInternal, fmt.Errorf("generate token id: %w", err))
+	}
+
+	// The issue time is truncated to the second JWTs carry, so a revocation at the
+	// same instant compares the same way on both sides.
+	now := i.now().UTC().Truncate(time.Second)
+	expiresAt := now.Add(ttl)
+	subject := sadom.Subject(sa.Tenant, sa.Name)
+	claims := serviceAccountClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    i.issuer,
+			Subject:   subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			ID:        base64.RawURLEncoding.EncodeToString(jti),
+		},
+		ServiceAccountUID: sa.UID,
+	}
+	// A service account only acts in its own tenant, whatever its role assignments say.
+	scope := req.Scope
+	scope.Tenants = []string{sa.Tenant}
+	claims.Scope = &scope
+
+	signed, err := jwt.NewWithClaims(i.signingMethod, claims).SignedString(i.key)
+	if err != nil {
+		return sadom.Token{}, kernel.NewError(kernel.KindInternal, fmt.Errorf("sign service-account token: %w", err))
+	}
+	return sadom.Token{Token: signed, Subject: subject, ExpiresAt: expiresAt}, nil
+}
+
+// ServiceAccountValidator decides whether a service-account token is still honoured by
+// loading its service account on every request: a deleted or disabled account, one that is
+// not the account the token was issued for, or one whose tokensNotBefore is later than the
+// token's issue time, rejects the token.
+type ServiceAccountValidator struct {
+	reader persistence.ReaderRepo[*sadom.ServiceAccount]
+}
+
+// NewServiceAccountValidator creates a ServiceAccountValidator reading from reader.
+func NewServiceAccountValidator(reader persistence.ReaderRepo[*sadom.ServiceAccount]) *ServiceAccountValidator {
+	return &ServiceAccountValidator{reader: reader}
+}
+
+// Validate returns kernel.ErrUnauthorized when the token of subject issued at issuedAt for
+// the account with uid is no longer honoured, and an error of kind kernel.KindInternal when
+// the service account cannot be read, so an unreachable store answers 500 rather than 401.
+// uid is empty for the tokens issued before they carried one.
+func (v *ServiceAccountValidator) Validate(ctx context.Context, subject, uid string, issuedAt time.Time) error {
+	tenant, name, err := sadom.ParseSubject(subject)
+	if err != nil {
+		return fmt.Errorf("%w: %w", kernel.ErrUnauthorized, err)
+	}
+	sa := &sadom.ServiceAccount{}
+	sa.Name = name
+	sa.Tenant = tenant
+	if err := v.reader.Load(ctx, &sa); err != nil {
+		if errors.Is(err, kernel.ErrNotFound) {
+			return fmt.Errorf("%w: service account %s no longer exists", kernel.ErrUnauthorized, subject)
+		}
+		return kernel.NewError(kernel.KindInternal, fmt.Errorf("load service account %s: %w", subject, err))
+	}
+	if !sa.AcceptsToken(uid, issuedAt) {
+		return fmt.Errorf("%w: token of service account %s was revoked", kernel.ErrUnauthorized, subject)
+	}
+	return nil
+}
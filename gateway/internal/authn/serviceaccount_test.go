@@ -0,0 +1,205 @@
+package authn
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// fakeServiceAccounts serves service accounts by name, or fails every load with err.
+type fakeServiceAccounts struct {
+	accounts map[string]*sadom.ServiceAccount
+	err      error
+}
+
+func (f *fakeServiceAccounts) List(context.Context, resource.ListFilter, *[]*sadom.ServiceAccount) (*string, error) {
+	return nil, nil
+}
+
+func (f *fakeServiceAccounts) Load(_ context.Context, m **sadom.ServiceAccount) error {
+	if f.err != nil {
+		return f.err
+	}
+	stored, ok := f.accounts[(*m).Name]
+	if !ok {
+		return kernel.ErrNotFound
+	}
+	*m = stored
+	return nil
+}
+
+func newServiceAccount(name string, spec sadom.ServiceAccountSpec) *sadom.ServiceAccount {
+	sa := &sadom.ServiceAccount{Spec: spec}
+	sa.Name = name
+	sa.Tenant = "t1"
+	return sa
+}
+
+func TestServiceAccountTokens(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	issuer, err := NewTokenIssuer(key, "ES256", "ecp-gateway", 15*time.Minute, time.Hour)
+	if err != nil {
+		t.Fatalf("NewTokenIssuer() error = %v", err)
+	}
+
+	future := time.Now().Add(time.Hour)
+	store := &fakeServiceAccounts{accounts: map[string]*sadom.ServiceAccount{
+		"ci":       newServiceAccount("ci", sadom.ServiceAccountSpec{}),
+		"disabled": newServiceAccount("disabled", sadom.ServiceAccountSpec{Disabled: true}),
+		"revoked":  newServiceAccount("revoked", sadom.ServiceAccountSpec{TokensNotBefore: &future}),
+	}}
+	verifier := NewJWTAuthenticator(&key.PublicKey, "ES256", WithIssuer("ecp-gateway"), WithServiceAccounts(NewServiceAccountValidator(store)))
+
+	issue := func(name string, scope resource.TokenScope) string {
+		tok, err := issuer.IssueToken(context.Background(), newServiceAccount(name, sadom.ServiceAccountSpec{}), sadom.TokenRequest{Scope: scope})
+		if err != nil {
+			t.Fatalf("IssueToken(%s) error = %v", name, err)
+		}
+		return tok.Token
+	}
+
+	id, err := verifier.Authenticate(context.Background(), issue("ci", resource.TokenScope{Workspaces: []string{"w1"}}))
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if id.Subject != "serviceaccount:t1:ci" {
+		t.Errorf("subject = %q, want serviceaccount:t1:ci", id.Subject)
+	}
+	wantScope := resource.TokenScope{Tenants: []string{"t1"}, Workspaces: []string{"w1"}}
+	if !reflect.DeepEqual(id.TokenScope, wantScope) {
+		t.Errorf("token scope = %+v, want %+v (tenant pinned)", id.TokenScope, wantScope)
+	}
+
+	for _, name := range []string{"disabled", "revoked", "deleted"} {
+		if _, err := verifier.Authenticate(context.Background(), issue(name, resource.TokenScope{})); !isUnauthorized(err) {
+			t.Errorf("token of %s service account: err = %v, want ErrUnauthorized", name, err)
+		}
+	}
+
+	broken := NewJWTAuthenticator(&key.PublicKey, "ES256", WithServiceAccounts(NewServiceAccountValidator(&fakeServiceAccounts{err: errors.New("connection refused")})))
+	if _, err := broken.Authenticate(context.Background(), issue("ci", resource.TokenScope{})); !errors.Is(err, kernel.ErrInternal) {
+		t.Errorf("unreadable service account: err = %v, want ErrInternal", err)
+	}
+
+	if _, err := issuer.IssueToken(context.Background(), newServiceAccount("ci", sadom.ServiceAccountSpec{}), sadom.TokenRequest{TTL: 2 * time.Hour}); !errors.Is(err, kernel.ErrValidation) {
+		t.Errorf("lifetime above the maximum: err = %v, want ErrValidation", err)
+	}
+}
+
+func TestServiceAccountTokens_RecreatedAccount(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	issuer, err := NewTokenIssuer(key, "ES256", "ecp-gateway", 15*time.Minute, time.Hour)
+	if err != nil {
+		t.Fatalf("NewTokenIssuer() error = %v", err)
+	}
+	original := newServiceAccount("ci", sadom.ServiceAccountSpec{})
+	original.UID = "uid-1"
+	original.CreatedAt = time.Now().Add(-time.Hour)
+	store := &fakeServiceAccounts{accounts: map[string]*sadom.ServiceAccount{"ci": original}}
+	verifier := NewJWTAuthenticator(&key.PublicKey, "ES256", WithIssuer("ecp-gateway"), WithServiceAccounts(NewServiceAccountValidator(store)))
+
+	tok, err := issuer.IssueToken(context.Background(), original, sadom.TokenRequest{})
+	if err != nil {
+		t.Fatalf("IssueToken() error = %v", err)
+	}
+	if _, err := verifier.Authenticate(context.Background(), tok.Token); err != nil {
+		t.Fatalf("token of the original account: err = %v", err)
+	}
+
+	// Delete the account and create another one under the same name.
+	recreated := newServiceAccount("ci", sadom.ServiceAccountSpec{})
+	recreated.UID = "uid-2"
+	recreated.CreatedAt = time.Now()
+	store.accounts["ci"] = recreated
+	if _, err := verifier.Authenticate(context.Background(), tok.Token); !isUnauthorized(err) {
+		t.Errorf("token of the deleted account presented to the recreated one: err = %v, want ErrUnauthorized", err)
+	}
+
+	// A token without sa_uid, issued before the recreated account existed.
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"sub": "serviceaccount:t1:ci", "iss": "ecp-gateway",
+		"iat": time.Now().Add(-time.Minute).Unix(), "exp": time.Now().Add(time.Hour).Unix(),
+	}).SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	if _, err := verifier.Authenticate(context.Background(), legacy); !isUnauthorized(err) {
+		t.Errorf("token without sa_uid issued before the account: err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestJWTAuthenticator_ServiceAccountSubjects(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("supersecretkey")
+	sign := func(sub string) string {
+		s, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": sub, "iss": "ecp-gateway", "iat": time.Now().Unix(), "exp": time.Now().Add(time.Hour).Unix(),
+		}).SignedString(secret)
+		if err != nil {
+			t.Fatalf("failed to sign token: %v", err)
+		}
+		return s
+	}
+	store := &fakeServiceAccounts{accounts: map[string]*sadom.ServiceAccount{"ci": newServiceAccount("ci", sadom.ServiceAccountSpec{})}}
+
+	human := NewJWTAuthenticator(secret, "HS256")
+	if _, err := human.Authenticate(context.Background(), sign("serviceaccount:t1:ci")); !isUnauthorized(err) {
+		t.Errorf("external issuer asserting a service account: err = %v, want ErrUnauthorized", err)
+	}
+
+	machine := NewJWTAuthenticator(secret, "HS256", WithIssuer("ecp-gateway"), WithServiceAccounts(NewServiceAccountValidator(store)))
+	if _, err := machine.Authenticate(context.Background(), sign("alice")); !isUnauthorized(err) {
+		t.Errorf("service-account authenticator given a user: err = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestParseSigningKey(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+	got, err := ParseSigningKey("ES256", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	if err != nil {
+		t.Fatalf("ParseSigningKey() error = %v", err)
+	}
+	verify, err := VerifyKeyFor(got)
+	if err != nil {
+		t.Fatalf("VerifyKeyFor() error = %v", err)
+	}
+	if !key.PublicKey.Equal(verify) {
+		t.Error("verification key is not the public half of the signing key")
+	}
+
+	if _, err := ParseSigningKey("ES256", []byte("not pem")); err == nil {
+		t.Error("expected error for non-PEM input")
+	}
+}
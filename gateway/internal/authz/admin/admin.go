@@ -0,0 +1,46 @@
+// Package admin authorizes the gateway's tenant-less administration routes.
+//
+// SECA RBAC is evaluated inside a tenant, so it cannot govern routes such as the token
+// revocation list that act across tenants. Those routes are instead restricted to a fixed
+// set of administrator subjects configured on the command line.
+package admin
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+)
+
+// Checker is the authzport.Checker of the administration routes: it allows the listed
+// subjects and denies everyone else. An empty list denies every caller.
+type Checker struct {
+	subjects []string
+}
+
+var _ authzport.Checker = (*Checker)(nil)
+
+// NewChecker creates a Checker allowing subjects.
+func NewChecker(subjects []string) *Checker {
+	return &Checker{subjects: subjects}
+}
+
+// Authorize implements authzport.Checker. Only the claim's subject is consulted; token
+// down-scoping does not apply, as the routes have no tenant, region or workspace.
+func (c *Checker) Authorize(_ context.Context, claim authzport.AuthorizationClaim) (authzport.Decision, error) {
+	if claim.Subject != "" && slices.Contains(c.subjects, claim.Subject) {
+		return authzport.DecisionAllowed, nil
+	}
+	return authzport.DecisionDenied, kernel.ErrForbidden
+}
+
+// ClaimExtractor returns the claim extractor of the administration routes. The claim
+// carries no scope; the authorization middleware fills in the subject.
+func ClaimExtractor(provider string) authzport.ClaimExtractor {
+	return func(r *http.Request) (authzport.AuthorizationClaim, error) {
+		return authzport.AuthorizationClaim{Provider: provider, Verb: strings.ToLower(r.Method)}, nil
+	}
+}
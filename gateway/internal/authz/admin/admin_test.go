@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+)
+
+func TestChecker(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		subjects []string
+		subject  string
+		want     authzport.Decision
+	}{
+		{name: "listed admin", subjects: []string{"root", "ops"}, subject: "ops", want: authzport.DecisionAllowed},
+		{name: "other subject", subjects: []string{"root"}, subject: "alice", want: authzport.DecisionDenied},
+		{name: "no admins configured", subject: "root", want: authzport.DecisionDenied},
+		{name: "empty subject", subjects: []string{""}, want: authzport.DecisionDenied},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewChecker(tc.subjects).Authorize(context.Background(), authzport.AuthorizationClaim{Subject: tc.subject})
+			if got != tc.want {
+				t.Errorf("Authorize() = %v, want %v", got, tc.want)
+			}
+			if tc.want == authzport.DecisionDenied && !errors.Is(err, kernel.ErrForbidden) {
+				t.Errorf("denial error = %v, want ErrForbidden", err)
+			}
+		})
+	}
+}
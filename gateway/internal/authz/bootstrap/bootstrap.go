@@ -0,0 +1,274 @@
+// Package bootstrap creates the built-in RBAC policy of new tenants on the global gateway.
+//
+// A freshly provisioned tenant has no Roles or RoleAssignments, so nobody but a cluster
+// operator can act in it. The Bootstrapper watches tenant namespaces and, the first time
+// it sees one, creates the built-in roles (marked system-managed, so the authorization
+// API refuses to change or delete them) and a RoleAssignment granting the configured
+// bootstrap subject its roles across the tenant.
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+const (
+	// BootstrappedAnnotation is set on a tenant namespace once its policy was created, with
+	// the time as value. Removing it re-runs the bootstrap; existing objects are kept.
+	BootstrappedAnnotation = k8slabels.InternalLabelPrefix + "rbac-bootstrapped"
+	// AssignmentName is the name of the RoleAssignment granting the bootstrap subject.
+	AssignmentName = "tenant-bootstrap"
+
+	defaultResync = 10 * time.Minute
+)
+
+// Bootstrapper provisions the built-in policy of every new tenant namespace.
+//
+// Tenant namespaces are recognised by the secapi.cloud/tenant label without a workspace
+// label, and by their name being the tenant's computed namespace. Objects that already
+// exist are left untouched, so an operator may pre-create or customise them; only one
+// without a status is marked active, as one whose creator failed before marking it.
+//
+// Lifecycle: only one replica may bootstrap at a time. Call Start on the elected replica,
+// with a context cancelled when it loses the lead; it returns after the initial sync and
+// runs the worker until ctx is cancelled. A Bootstrapper is started once: build a new one
+// every time the replica takes the lead.
+type Bootstrapper struct {
+	clientset        kubernetes.Interface
+	factory          informers.SharedInformerFactory
+	roleReader       persistence.ReaderRepo[*roledom.Role]
+	roleWriter       persistence.WriterRepo[*roledom.Role]
+	assignmentReader persistence.ReaderRepo[*radom.RoleAssignment]
+	assignmentWriter persistence.WriterRepo[*radom.RoleAssignment]
+	roles            []RoleTemplate
+	subject          string
+	subjectRoles     []string
+	queue            workqueue.TypedRateLimitingInterface[string]
+	log              *slog.Logger
+}
+
+// New creates a Bootstrapper creating roles, and when subject is set an assignment of
+// subjectRoles to it, in every new tenant namespace.
+func New(
+	clientset kubernetes.Interface,
+	roleReader persistence.ReaderRepo[*roledom.Role],
+	roleWriter persistence.WriterRepo[*roledom.Role],
+	assignmentReader persistence.ReaderRepo[*radom.RoleAssignment],
+	assignmentWriter persistence.WriterRepo[*radom.RoleAssignment],
+	roles []RoleTemplate,
+	subject string,
+	subjectRoles []string,
+	log *slog.Logger,
+) *Bootstrapper {
+	tenantNamespaces := labels.NewSelector().Add(
+		mustRequirement(k8slabels.InternalTenantLabel, selection.Exists),
+		mustRequirement(k8slabels.InternalWorkspaceLabel, selection.DoesNotExist),
+	)
+	return &Bootstrapper{
+		clientset: clientset,
+		factory: informers.NewSharedInformerFactoryWithOptions(clientset, defaultResync,
+			informers.WithTweakListOptions(func(o *metav1.ListOptions) {
+				o.LabelSelector = tenantNamespaces.String()
+			})),
+		roleReader:       roleReader,
+		roleWriter:       roleWriter,
+		assignmentReader: assignmentReader,
+		assignmentWriter: assignmentWriter,
+		roles:            roles,
+		subject:          subject,
+		subjectRoles:     subjectRoles,
+		queue:            workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]()),
+		log:              log,
+	}
+}
+
+// mustRequirement builds a label requirement on a constant key; it only fails on an
+// invalid key, which would be a programming error.
+func mustRequirement(key string, op selection.Operator) labels.Requirement {
+	r, err := labels.NewRequirement(key, op, nil)
+	if err != nil {
+		panic(err)
+	}
+	return *r
+}
+
+// Start registers the namespace informer, waits for its initial sync and launches the worker.
+func (b *Bootstrapper) Start(ctx context.Context) error {
+	b.log.Info("tenant bootstrap: starting", slog.Int("roles", len(b.roles)), slog.String("subject", b.subject))
+
+	informer := b.factory.Core().V1().Namespaces().Informer()
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    b.enqueue,
+		UpdateFunc: func(_, obj any) { b.enqueue(obj) },
+	}); err != nil {
+		return fmt.Errorf("watch namespaces: %w", err)
+	}
+
+	b.factory.Start(ctx.Done())
+	for typ, ok := range b.factory.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return fmt.Errorf("informer cache sync timed out for %v", typ)
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		b.queue.ShutDown()
+	}()
+	go b.run(ctx)
+	return nil
+}
+
+// enqueue queues a namespace that has not been bootstrapped yet.
+func (b *Bootstrapper) enqueue(obj any) {
+	ns, ok := obj.(*corev1.Namespace)
+	if !ok || ns.Annotations[BootstrappedAnnotation] != "" {
+		return
+	}
+	b.queue.Add(ns.Name)
+}
+
+// run processes queued namespaces until the queue shuts down.
+func (b *Bootstrapper) run(ctx context.Context) {
+	for {
+		name, shutdown := b.queue.Get()
+		if shutdown {
+			return
+		}
+		if err := b.sync(ctx, name); err != nil {
+			b.log.WarnContext(ctx, "tenant bootstrap: sync failed, retrying",
+				slog.String("namespace", name), slog.Any("error", err))
+			b.queue.AddRateLimited(name)
+		} else {
+			b.queue.Forget(name)
+		}
+		b.queue.Done(name)
+	}
+}
+
+// sync bootstraps one namespace unless it is gone, already bootstrapped, or not the
+// namespace of the tenant it is labelled with.
+func (b *Bootstrapper) sync(ctx context.Context, name string) error {
+	ns, err := b.factory.Core().V1().Namespaces().Lister().Get(name)
+	if kerrs.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get namespace %s from cache: %w", name, err)
+	}
+	if ns.Annotations[BootstrappedAnnotation] != "" || ns.DeletionTimestamp != nil {
+		return nil
+	}
+	tenant := ns.Labels[k8slabels.InternalTenantLabel]
+	if tenant == "" || k8sadapter.ComputeNamespace(&resource.Scope{Tenant: tenant}) != name {
+		return nil
+	}
+
+	if err := b.Provision(ctx, tenant); err != nil {
+		return err
+	}
+
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, BootstrappedAnnotation, time.Now().UTC().Format(time.RFC3339))
+	if _, err := b.clientset.CoreV1().Namespaces().Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("mark namespace %s bootstrapped: %w", name, err)
+	}
+	b.log.InfoContext(ctx, "tenant bootstrap: created built-in policy", slog.String("tenant", tenant))
+	return nil
+}
+
+// Provision creates the built-in roles and the bootstrap assignment in tenant. Objects
+// that already exist are kept as they are, but marked active when they have no status yet,
+// so Provision can be retried safely.
+func (b *Bootstrapper) Provision(ctx context.Context, tenant string) error {
+	for _, tmpl := range b.roles {
+		r := &roledom.Role{
+			Spec:          roledom.RoleSpec{Permissions: tmpl.Permissions},
+			SystemManaged: true,
+		}
+		r.Name = tmpl.Name
+		r.Tenant = tenant
+		r.Provider = roledom.ProviderID
+		if err := createActive(ctx, b.roleReader, b.roleWriter, r, func(r *roledom.Role) bool {
+			if r.Status != nil && len(r.Status.Conditions) > 0 {
+				return false
+			}
+			r.Status = &roledom.RoleStatus{}
+			r.Status.PushCondition(commonbackend.ConditionFromState(commondomain.ResourceStateActive))
+			return true
+		}); err != nil {
+			return fmt.Errorf("create role %s in tenant %s: %w", tmpl.Name, tenant, err)
+		}
+	}
+
+	if b.subject == "" {
+		return nil
+	}
+	ra := &radom.RoleAssignment{
+		Spec: radom.RoleAssignmentSpec{
+			Subs:   []string{b.subject},
+			Roles:  b.subjectRoles,
+			Scopes: []radom.RoleAssignmentScope{{Tenants: []string{tenant}}},
+		},
+	}
+	ra.Name = AssignmentName
+	ra.Tenant = tenant
+	ra.Provider = radom.ProviderID
+	if err := createActive(ctx, b.assignmentReader, b.assignmentWriter, ra, func(ra *radom.RoleAssignment) bool {
+		if ra.Status != nil && len(ra.Status.Conditions) > 0 {
+			return false
+		}
+		ra.Status = &radom.RoleAssignmentStatus{}
+		ra.Status.PushCondition(commonbackend.ConditionFromState(commondomain.ResourceStateActive))
+		return true
+	}); err != nil {
+		return fmt.Errorf("create role assignment %s in tenant %s: %w", AssignmentName, tenant, err)
+	}
+	return nil
+}
+
+// createActive creates m and stamps it active, as the authorization handlers do for API
+// writes. An object that already exists is loaded instead, and stamped active only when it
+// has no status: a replica that created it may have stopped, or lost the lead, before
+// stamping it. markActive stamps its argument and reports whether it had no status.
+func createActive[T persistence.IdentifiableResource](
+	ctx context.Context, r persistence.ReaderRepo[T], w persistence.WriterRepo[T], m T, markActive func(T) bool,
+) error {
+	saved, err := w.Create(ctx, m)
+	if errors.Is(err, kernel.ErrAlreadyExists) {
+		if err := r.Load(ctx, &m); err != nil {
+			return err
+		}
+		saved = &m
+	} else if err != nil {
+		return err
+	}
+	if !markActive(*saved) {
+		return nil
+	}
+	_, err = w.UpdateStatus(ctx, *saved)
+	return err
+}
@@ -0,0 +1,165 @@
+package bootstrap
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"k8s.io/client-go/kubernetes/fake"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// recordingWriter records created objects and reports names in exists as already existing.
+// It loads an existing object as stored, or with no status when stored has none for it.
+type recordingWriter[T persistence.IdentifiableResource] struct {
+	exists  map[string]bool
+	stored  map[string]T
+	created []T
+	active  []T
+}
+
+func (w *recordingWriter[T]) List(context.Context, resource.ListFilter, *[]T) (*string, error) {
+	return nil, nil
+}
+
+func (w *recordingWriter[T]) Load(_ context.Context, m *T) error {
+	if stored, ok := w.stored[(*m).GetName()]; ok {
+		*m = stored
+	}
+	return nil
+}
+
+func (w *recordingWriter[T]) Delete(context.Context, T) error { return nil }
+
+func (w *recordingWriter[T]) Create(_ context.Context, m T) (*T, error) {
+	if w.exists[m.GetName()] {
+		return nil, kernel.ErrAlreadyExists
+	}
+	w.created = append(w.created, m)
+	return &m, nil
+}
+
+func (w *recordingWriter[T]) Update(_ context.Context, m T) (*T, error) { return &m, nil }
+
+func (w *recordingWriter[T]) UpdateStatus(_ context.Context, m T) (*T, error) {
+	w.active = append(w.active, m)
+	return &m, nil
+}
+
+func TestProvision(t *testing.T) {
+	t.Parallel()
+
+	roles := &recordingWriter[*roledom.Role]{exists: map[string]bool{RoleViewer: true}}
+	assignments := &recordingWriter[*radom.RoleAssignment]{}
+	roles.stored = map[string]*roledom.Role{RoleViewer: activeRole(RoleViewer)}
+	b := New(fake.NewClientset(), roles, roles, assignments, assignments, DefaultRoles(), "admin@example.com", []string{RoleTenantAdmin}, slog.Default())
+
+	if err := b.Provision(context.Background(), "t1"); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+
+	if len(roles.created) != 2 {
+		t.Fatalf("created %d roles, want 2 (viewer already existed)", len(roles.created))
+	}
+	for _, r := range roles.created {
+		if !r.SystemManaged || r.Tenant != "t1" {
+			t.Errorf("role %s: SystemManaged=%v tenant=%q, want system-managed in t1", r.Name, r.SystemManaged, r.Tenant)
+		}
+	}
+	if len(roles.active) != 2 || roles.active[0].Status.State != commondomain.ResourceStateActive {
+		t.Errorf("created roles were not marked active")
+	}
+
+	if len(assignments.created) != 1 {
+		t.Fatalf("created %d assignments, want 1", len(assignments.created))
+	}
+	ra := assignments.created[0]
+	if ra.Name != AssignmentName || ra.Spec.Subs[0] != "admin@example.com" || ra.Spec.Roles[0] != RoleTenantAdmin {
+		t.Errorf("assignment = %s %v %v, want %s granting tenant-admin to the subject", ra.Name, ra.Spec.Subs, ra.Spec.Roles, AssignmentName)
+	}
+	if got := ra.Spec.Scopes[0].Tenants; len(got) != 1 || got[0] != "t1" {
+		t.Errorf("assignment scope tenants = %v, want [t1]", got)
+	}
+}
+
+func TestProvision_NoSubjectCreatesRolesOnly(t *testing.T) {
+	t.Parallel()
+
+	roles := &recordingWriter[*roledom.Role]{}
+	assignments := &recordingWriter[*radom.RoleAssignment]{}
+	b := New(fake.NewClientset(), roles, roles, assignments, assignments, DefaultRoles(), "", nil, slog.Default())
+
+	if err := b.Provision(context.Background(), "t1"); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+	if len(roles.created) != 3 || len(assignments.created) != 0 {
+		t.Errorf("created %d roles and %d assignments, want 3 and 0", len(roles.created), len(assignments.created))
+	}
+}
+
+// TestProvision_MarksExistingWithoutStatusActive covers a replica that created the objects
+// but stopped before marking them active: the next one marks them.
+func TestProvision_MarksExistingWithoutStatusActive(t *testing.T) {
+	t.Parallel()
+
+	roles := &recordingWriter[*roledom.Role]{exists: map[string]bool{RoleViewer: true, RoleTenantAdmin: true}}
+	roles.stored = map[string]*roledom.Role{RoleTenantAdmin: activeRole(RoleTenantAdmin)}
+	assignments := &recordingWriter[*radom.RoleAssignment]{exists: map[string]bool{AssignmentName: true}}
+	b := New(fake.NewClientset(), roles, roles, assignments, assignments, DefaultRoles(), "admin@example.com", []string{RoleTenantAdmin}, slog.Default())
+
+	if err := b.Provision(context.Background(), "t1"); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+
+	var marked []string
+	for _, r := range roles.active {
+		marked = append(marked, r.Name)
+	}
+	if len(marked) != 2 || slices.Contains(marked, RoleTenantAdmin) || !slices.Contains(marked, RoleViewer) {
+		t.Errorf("marked roles %v active, want the created one and the existing viewer without status", marked)
+	}
+	if len(assignments.active) != 1 || assignments.active[0].Status.State != commondomain.ResourceStateActive {
+		t.Errorf("the existing assignment without status was not marked active")
+	}
+}
+
+// activeRole returns a stored role already marked active.
+func activeRole(name string) *roledom.Role {
+	r := &roledom.Role{Status: &roledom.RoleStatus{}}
+	r.Name = name
+	r.Status.PushCondition(commondomain.StatusCondition{State: commondomain.ResourceStateActive})
+	return r
+}
+
+func TestFlagsRoles(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "roles.yaml")
+	content := "roles:\n  - name: operator\n    permissions:\n      - provider: seca.compute\n        resources: [\"*\"]\n        verb: [\"get\"]\n"
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	f := &Flags{RolesFile: path, SubjectRoles: []string{"operator"}}
+	roles, err := f.Roles()
+	if err != nil {
+		t.Fatalf("Roles() error = %v", err)
+	}
+	if len(roles) != 1 || roles[0].Name != "operator" || roles[0].Permissions[0].Provider != "seca.compute" {
+		t.Errorf("Roles() = %+v, want the operator role from the file", roles)
+	}
+
+	f.SubjectRoles = []string{RoleTenantAdmin}
+	if _, err := f.Roles(); err == nil {
+		t.Error("Roles() accepted a subject role missing from the roles file")
+	}
+}
@@ -0,0 +1,107 @@
+package bootstrap
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+)
+
+// Names of the built-in roles created when no roles file is configured.
+const (
+	RoleTenantAdmin    = "tenant-admin"
+	RoleWorkspaceAdmin = "workspace-admin"
+	RoleViewer         = "viewer"
+)
+
+// Flags holds the parsed command-line values for tenant RBAC bootstrap.
+// Use RegisterFlags to bind these to a cobra command.
+type Flags struct {
+	// Enabled turns the bootstrapper on.
+	Enabled bool
+	// Subject is the principal that receives SubjectRoles in every new tenant. Empty
+	// creates the roles only.
+	Subject string
+	// SubjectRoles are the built-in roles assigned to Subject. Default tenant-admin.
+	SubjectRoles []string
+	// RolesFile replaces the built-in role set with the roles listed in a YAML file.
+	RolesFile string
+}
+
+// RegisterFlags adds tenant-bootstrap flags to the given cobra command.
+func RegisterFlags(cmd *cobra.Command, f *Flags) {
+	cmd.Flags().BoolVar(&f.Enabled, "tenant-bootstrap", true,
+		"Create the built-in roles (and the bootstrap role assignment) in every newly provisioned tenant namespace")
+	cmd.Flags().StringVar(&f.Subject, "tenant-bootstrap-subject", "",
+		"Subject assigned --tenant-bootstrap-roles in every new tenant; empty creates the roles only")
+	cmd.Flags().StringSliceVar(&f.SubjectRoles, "tenant-bootstrap-roles", []string{RoleTenantAdmin},
+		"Built-in roles assigned to --tenant-bootstrap-subject")
+	cmd.Flags().StringVar(&f.RolesFile, "tenant-bootstrap-roles-file", "",
+		"YAML file replacing the built-in role set (default: tenant-admin, workspace-admin, viewer)")
+}
+
+// RoleTemplate is a built-in role before it is placed in a tenant.
+type RoleTemplate struct {
+	Name        string               `json:"name"`
+	Permissions []roledom.Permission `json:"permissions"`
+}
+
+// rolesFile is the layout of --tenant-bootstrap-roles-file.
+type rolesFile struct {
+	Roles []RoleTemplate `json:"roles"`
+}
+
+// DefaultRoles returns the built-in role set. Authorization matches providers exactly, so
+// every role lists each provider it covers:
+//   - tenant-admin: everything on every provider, including roles and role assignments;
+//   - workspace-admin: everything on the workspace-scoped providers, read-only on
+//     workspaces and regions (the assignment's scope picks the workspace);
+//   - viewer: get and list on every provider.
+func DefaultRoles() []RoleTemplate {
+	var tenantAdmin, viewer []roledom.Permission
+	for _, provider := range roledom.Providers {
+		tenantAdmin = append(tenantAdmin, roledom.Permission{Provider: provider, Resources: []string{"*"}, Verb: []string{"*"}})
+		viewer = append(viewer, roledom.Permission{Provider: provider, Resources: []string{"*"}, Verb: []string{"get", "list"}})
+	}
+	var workspaceAdmin []roledom.Permission
+	for _, provider := range []string{"seca.compute", "seca.network", "seca.storage"} {
+		workspaceAdmin = append(workspaceAdmin, roledom.Permission{Provider: provider, Resources: []string{"*"}, Verb: []string{"*"}})
+	}
+	for _, provider := range []string{"seca.workspace", "seca.region"} {
+		workspaceAdmin = append(workspaceAdmin, roledom.Permission{Provider: provider, Resources: []string{"*"}, Verb: []string{"get", "list"}})
+	}
+	return []RoleTemplate{
+		{Name: RoleTenantAdmin, Permissions: tenantAdmin},
+		{Name: RoleWorkspaceAdmin, Permissions: workspaceAdmin},
+		{Name: RoleViewer, Permissions: viewer},
+	}
+}
+
+// Roles returns the configured role set: the roles file when set, DefaultRoles otherwise.
+// Every SubjectRoles entry must name one of them.
+func (f *Flags) Roles() ([]RoleTemplate, error) {
+	roles := DefaultRoles()
+	if f.RolesFile != "" {
+		file, err := os.Open(f.RolesFile)
+		if err != nil {
+			return nil, fmt.Errorf("open bootstrap roles file: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		var rf rolesFile
+		if err := utilyaml.NewYAMLOrJSONDecoder(file, 4096).Decode(&rf); err != nil {
+			return nil, fmt.Errorf("decode bootstrap roles file %s: %w", f.RolesFile, err)
+		}
+		roles = rf.Roles
+	}
+
+	for _, name := range f.SubjectRoles {
+		if !slices.ContainsFunc(roles, func(r RoleTemplate) bool { return r.Name == name }) {
+			return nil, fmt.Errorf("--tenant-bootstrap-roles: %q is not a bootstrap role", name)
+		}
+	}
+	return roles, nil
+}
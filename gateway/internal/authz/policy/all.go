@@ -0,0 +1,43 @@
+package policy
+
+import (
+	"context"
+	"errors"
+
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+)
+
+// allOf is the conjunction of several checkers.
+type allOf []authzport.Checker
+
+// AllOf returns a checker that allows a request only when every checker allows it. The
+// checkers are consulted in order and the first decision other than DecisionAllowed is
+// returned, so later checkers are not evaluated for requests an earlier one rejects.
+//
+// The result exposes Start, which starts every checker that has one, so auth.StartChecker
+// keeps working on the combination.
+func AllOf(checkers ...authzport.Checker) authzport.Checker {
+	return allOf(checkers)
+}
+
+// Authorize implements authzport.Checker.
+func (a allOf) Authorize(ctx context.Context, claim authzport.AuthorizationClaim) (authzport.Decision, error) {
+	for _, c := range a {
+		if dec, err := c.Authorize(ctx, claim); dec != authzport.DecisionAllowed || err != nil {
+			return dec, err
+		}
+	}
+	return authzport.DecisionAllowed, nil
+}
+
+// Start starts every checker exposing a Start method and joins their errors.
+func (a allOf) Start(ctx context.Context) error {
+	type starter interface{ Start(context.Context) error }
+	var errs []error
+	for _, c := range a {
+		if s, ok := c.(starter); ok {
+			errs = append(errs, s.Start(ctx))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,147 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+	apk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/authorization-policy/backend/kubernetes"
+)
+
+// policyResync is the period after which the informer re-lists all AuthorizationPolicies.
+const policyResync = 5 * time.Minute
+
+// Checker is the CEL policy implementation of authzport.Checker. It denies a request when
+// any policy applying to its tenant evaluates to false or cannot be evaluated, and allows
+// it otherwise; combine it with the RBAC checker through AllOf.
+//
+// Policies come from the files given to NewChecker and, when a dynamic client is given,
+// from the AuthorizationPolicy resources of the cluster. The resources are compiled when
+// they change, not per request; one that does not compile denies every request of the
+// tenants it applies to until it is fixed.
+//
+// Lifecycle: with a dynamic client, call Start once at server startup. Until the informer
+// has synced, Authorize returns DecisionError: a gateway that cannot see the policies must
+// not skip them.
+type Checker struct {
+	static   []*program
+	watched  atomic.Pointer[[]*program]
+	factory  dynamicinformer.DynamicSharedInformerFactory
+	informer informers.GenericInformer
+	log      *slog.Logger
+	now      func() time.Time
+}
+
+var _ authzport.Checker = (*Checker)(nil)
+
+// NewChecker compiles policies, which must all compile, and watches the AuthorizationPolicy
+// resources through dynClient unless it is nil.
+func NewChecker(policies []Policy, dynClient dynamic.Interface, log *slog.Logger) (*Checker, error) {
+	c := &Checker{log: log, now: time.Now}
+	for _, p := range policies {
+		prg := compile(p)
+		if prg.err != nil {
+			return nil, prg.err
+		}
+		c.static = append(c.static, prg)
+	}
+	if dynClient == nil {
+		return c, nil
+	}
+	c.factory = dynamicinformer.NewDynamicSharedInformerFactory(dynClient, policyResync)
+	c.informer = c.factory.ForResource(apk8s.AuthorizationPolicyGVR)
+	rebuild := func(any) { c.rebuild() }
+	if _, err := c.informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    rebuild,
+		UpdateFunc: func(_, obj any) { rebuild(obj) },
+		DeleteFunc: rebuild,
+	}); err != nil {
+		return nil, fmt.Errorf("watch authorization policies: %w", err)
+	}
+	return c, nil
+}
+
+// Start starts the informer and blocks until its cache is synced. Returns an error if the
+// context is cancelled before sync completes. It is a no-op without a dynamic client.
+func (c *Checker) Start(ctx context.Context) error {
+	if c.factory == nil {
+		return nil
+	}
+	c.log.Info("authz policy: starting authorization policy watch")
+	c.factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.informer.Informer().HasSynced) {
+		return fmt.Errorf("informer cache sync timed out for %s", apk8s.AuthorizationPolicyGVR.Resource)
+	}
+	c.rebuild()
+	return nil
+}
+
+// rebuild recompiles the watched policies from the informer cache, sorted by name so
+// denials are deterministic.
+func (c *Checker) rebuild() {
+	objs, err := c.informer.Lister().List(labels.Everything())
+	if err != nil {
+		c.log.Error("authz policy: list authorization policies from cache", slog.Any("error", err))
+		return
+	}
+	programs := make([]*program, 0, len(objs))
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			c.log.Error("authz policy: unexpected object type in informer cache", slog.String("type", fmt.Sprintf("%T", obj)))
+			continue
+		}
+		var prg *program
+		if ap, err := apk8s.AuthorizationPolicyFromCR(u); err != nil {
+			// Its tenants are unknown, so the broken policy applies to every tenant.
+			prg = &program{policy: Policy{Name: u.GetName()}, err: fmt.Errorf("convert policy %s: %w", u.GetName(), err)}
+		} else {
+			prg = compile(Policy{Name: ap.Name, Tenants: ap.Spec.Tenants, Expression: ap.Spec.Expression, Message: ap.Spec.Message})
+		}
+		if prg.err != nil {
+			c.log.Error("authz policy: policy denies every request it applies to", slog.String("policy", prg.policy.Name), slog.Any("error", prg.err))
+		}
+		programs = append(programs, prg)
+	}
+	slices.SortFunc(programs, func(a, b *program) int { return strings.Compare(a.policy.Name, b.policy.Name) })
+	c.watched.Store(&programs)
+}
+
+// Authorize implements authzport.Checker.
+func (c *Checker) Authorize(_ context.Context, claim authzport.AuthorizationClaim) (authzport.Decision, error) {
+	programs := c.static
+	if c.informer != nil {
+		watched := c.watched.Load()
+		if watched == nil || !c.informer.Informer().HasSynced() {
+			return authzport.DecisionError, kernel.NewError(kernel.KindInternal, fmt.Errorf("authorization policies are not synced"))
+		}
+		programs = append(slices.Clip(programs), *watched...)
+	}
+	now := c.now()
+	for _, prg := range programs {
+		if !prg.policy.appliesTo(claim.Tenant) {
+			continue
+		}
+		allowed, err := prg.allows(claim, now)
+		if err != nil {
+			return authzport.DecisionDenied, fmt.Errorf("%w: policy %s failed closed: %w", kernel.ErrForbidden, prg.policy.Name, err)
+		}
+		if !allowed {
+			return authzport.DecisionDenied, fmt.Errorf("%w: denied by policy %s: %s", kernel.ErrForbidden, prg.policy.Name, prg.policy.reason())
+		}
+	}
+	return authzport.DecisionAllowed, nil
+}
@@ -0,0 +1,141 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+	apk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/authorization-policy/backend/kubernetes"
+)
+
+// stubChecker returns a fixed decision and counts its calls.
+type stubChecker struct {
+	decision authzport.Decision
+	err      error
+	calls    int
+	started  bool
+}
+
+func (s *stubChecker) Authorize(context.Context, authzport.AuthorizationClaim) (authzport.Decision, error) {
+	s.calls++
+	return s.decision, s.err
+}
+
+func (s *stubChecker) Start(context.Context) error {
+	s.started = true
+	return nil
+}
+
+func discardLog() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+func TestChecker_Static(t *testing.T) {
+	t.Parallel()
+
+	checker, err := NewChecker([]Policy{
+		{Name: "acme-no-deletes", Tenants: []string{"acme"}, Expression: "claim.verb != 'delete'", Message: "acme is read-mostly"},
+	}, nil, discardLog())
+	if err != nil {
+		t.Fatalf("NewChecker() error = %v", err)
+	}
+	if dec, err := checker.Authorize(context.Background(), authzport.AuthorizationClaim{Tenant: "acme", Verb: "delete"}); dec != authzport.DecisionDenied || !errors.Is(err, kernel.ErrForbidden) {
+		t.Errorf("acme delete: Authorize() = %v, %v; want DecisionDenied with ErrForbidden", dec, err)
+	}
+	for _, claim := range []authzport.AuthorizationClaim{{Tenant: "acme", Verb: "get"}, {Tenant: "globex", Verb: "delete"}} {
+		if dec, err := checker.Authorize(context.Background(), claim); dec != authzport.DecisionAllowed || err != nil {
+			t.Errorf("Authorize(%+v) = %v, %v; want DecisionAllowed", claim, dec, err)
+		}
+	}
+
+	if _, err := NewChecker([]Policy{{Name: "broken", Expression: "claim.verb =="}}, nil, discardLog()); err == nil {
+		t.Error("NewChecker() with a broken policy: expected error")
+	}
+}
+
+func TestChecker_Watched(t *testing.T) {
+	t.Parallel()
+
+	newPolicy := func(name, expression string, tenants ...string) *apk8s.AuthorizationPolicy {
+		ap := &apk8s.AuthorizationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       apk8s.AuthorizationPolicySpec{Tenants: tenants, Expression: expression},
+		}
+		ap.SetGroupVersionKind(apk8s.AuthorizationPolicyGVK)
+		return ap
+	}
+	scheme := runtime.NewScheme()
+	_ = apk8s.AddToScheme(scheme)
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{apk8s.AuthorizationPolicyGVR: "AuthorizationPolicyList"},
+		newPolicy("acme-no-deletes", "claim.verb != 'delete'", "acme"),
+		newPolicy("globex-broken", "claim.verb ==", "globex"),
+	)
+
+	checker, err := NewChecker(nil, client, discardLog())
+	if err != nil {
+		t.Fatalf("NewChecker() error = %v", err)
+	}
+	if dec, err := checker.Authorize(context.Background(), authzport.AuthorizationClaim{Tenant: "acme", Verb: "get"}); dec != authzport.DecisionError || !errors.Is(err, kernel.ErrInternal) {
+		t.Errorf("before sync: Authorize() = %v, %v; want DecisionError", dec, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := checker.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		claim authzport.AuthorizationClaim
+		want  authzport.Decision
+	}{
+		{name: "acme get", claim: authzport.AuthorizationClaim{Tenant: "acme", Verb: "get"}, want: authzport.DecisionAllowed},
+		{name: "acme delete", claim: authzport.AuthorizationClaim{Tenant: "acme", Verb: "delete"}, want: authzport.DecisionDenied},
+		{name: "broken policy fails closed", claim: authzport.AuthorizationClaim{Tenant: "globex", Verb: "get"}, want: authzport.DecisionDenied},
+		{name: "unaffected tenant", claim: authzport.AuthorizationClaim{Tenant: "initech", Verb: "delete"}, want: authzport.DecisionAllowed},
+	}
+	for _, tc := range tests {
+		if dec, _ := checker.Authorize(context.Background(), tc.claim); dec != tc.want {
+			t.Errorf("%s: Authorize() = %v, want %v", tc.name, dec, tc.want)
+		}
+	}
+}
+
+func TestAllOf(t *testing.T) {
+	t.Parallel()
+
+	allow := &stubChecker{decision: authzport.DecisionAllowed}
+	deny := &stubChecker{decision: authzport.DecisionDenied, err: kernel.ErrForbidden}
+	last := &stubChecker{decision: authzport.DecisionAllowed}
+
+	checker := AllOf(allow, deny, last)
+	if dec, err := checker.Authorize(context.Background(), authzport.AuthorizationClaim{}); dec != authzport.DecisionDenied || !errors.Is(err, kernel.ErrForbidden) {
+		t.Errorf("Authorize() = %v, %v; want the denial of the second checker", dec, err)
+	}
+	if last.calls != 0 {
+		t.Error("AllOf consulted a checker after a denial")
+	}
+	if dec, err := AllOf(allow, last).Authorize(context.Background(), authzport.AuthorizationClaim{}); dec != authzport.DecisionAllowed || err != nil {
+		t.Errorf("Authorize() = %v, %v; want DecisionAllowed", dec, err)
+	}
+
+	starter, ok := checker.(interface{ Start(context.Context) error })
+	if !ok {
+		t.Fatal("AllOf result does not expose Start")
+	}
+	if err := starter.Start(context.Background()); err != nil || !allow.started || !deny.started || !last.started {
+		t.Errorf("Start() = %v; want every checker started", err)
+	}
+}
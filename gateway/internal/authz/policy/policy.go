@@ -0,0 +1,182 @@
+// Package policy evaluates CEL authorization policies alongside SECA RBAC.
+//
+// RBAC answers "may this subject perform this verb on this resource". Some tenants need
+// rules it cannot express, such as "no deletes on Fridays" or "in workspace sandbox, only
+// instances labelled env=dev". A policy is a CEL expression over the authorization claim
+// and the labels of the request and of the resource that must evaluate to true for the request to proceed.
+// Policies come from JSON files (--authz-policy-file) and from the cluster-scoped
+// AuthorizationPolicy resources (--authz-policy-crs).
+//
+// The Checker only ever denies: it is combined with the SECA checker through AllOf, so a
+// request is allowed when RBAC and every policy applying to its tenant allow it.
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+)
+
+// costLimit bounds the evaluation cost of a single policy, so a pathological expression
+// cannot stall the request path. Exceeding it fails the evaluation, which denies.
+const costLimit = 100_000
+
+// Policy is one CEL authorization policy. Example file content:
+//
+//	[
+//	  {"name": "no-friday-deletes",
+//	   "expression": "!(claim.verb == 'delete' && now.getDayOfWeek('Europe/Berlin') == 5)",
+//	   "message": "deletes are frozen on Fridays"},
+//	  {"name": "sandbox-dev-only", "tenants": ["acme"],
+//	   "expression": "claim.workspace != 'sandbox' || claim.resource != 'instances' || claim.verb != 'put' || request.labels[?'env'].orValue('') == 'dev'"}
+//	]
+//
+// The expression sees four variables:
+//   - claim: subject, provider, resource, name, verb, tenant, region, workspace and
+//     sourceIP of the authorization claim, all strings ("" when not applicable);
+//   - request: labels, the labels the body of a PUT sets on the resource (empty otherwise);
+//   - resource: labels, the labels the resource has: those stored for an update or a
+//     delete, those the body sets for a create (empty otherwise);
+//   - now: the evaluation time, a CEL timestamp.
+//
+// A rule on the labels resources end up with reads request.labels: an update judged by
+// resource.labels alone could relabel the resource out of the rule.
+type Policy struct {
+	// Name identifies the policy in denials and logs.
+	Name string `json:"name"`
+	// Tenants restricts the policy to the listed tenants; empty applies it to every tenant.
+	Tenants []string `json:"tenants,omitempty"`
+	// Expression is a CEL expression evaluating to true when the request is allowed.
+	Expression string `json:"expression"`
+	// Message explains a denial in the audit log; empty logs the expression instead.
+	Message string `json:"message,omitempty"`
+}
+
+// appliesTo reports whether the policy governs requests of tenant. A tenant-restricted
+// policy never governs tenant-less requests.
+func (p Policy) appliesTo(tenant string) bool {
+	return len(p.Tenants) == 0 || slices.Contains(p.Tenants, tenant)
+}
+
+// reason returns the denial explanation of the policy.
+func (p Policy) reason() string {
+	if p.Message != "" {
+		return p.Message
+	}
+	return p.Expression
+}
+
+// LoadFile reads the JSON list of policies in path and checks that each one compiles.
+func LoadFile(path string) ([]Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read policy file %q: %w", path, err)
+	}
+	var policies []Policy
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("parse policy file %q: %w", path, err)
+	}
+	for _, p := range policies {
+		if p.Name == "" {
+			return nil, fmt.Errorf("policy file %q: every policy needs a name", path)
+		}
+		if prg := compile(p); prg.err != nil {
+			return nil, fmt.Errorf("policy file %q: %w", path, prg.err)
+		}
+	}
+	return policies, nil
+}
+
+// program is a compiled policy. A policy that does not compile keeps its error and denies
+// every request it applies to, so a broken policy fails closed.
+type program struct {
+	policy Policy
+	prg    cel.Program
+	err    error
+}
+
+// env returns the CEL environment policies are compiled in.
+var env = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.OptionalTypes(),
+		cel.Variable("claim", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("request", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("resource", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("now", cel.TimestampType),
+	)
+})
+
+// compile compiles p. It never returns nil; a compile error is kept in program.err.
+func compile(p Policy) *program {
+	e, err := env()
+	if err != nil {
+		return &program{policy: p, err: fmt.Errorf("create CEL environment: %w", err)}
+	}
+	ast, issues := e.Compile(p.Expression)
+	if issues.Err() != nil {
+		return &program{policy: p, err: fmt.Errorf("policy %s does not compile: %w", p.Name, issues.Err())}
+	}
+	if t := ast.OutputType(); t != cel.BoolType && t != cel.DynType {
+		return &program{policy: p, err: fmt.Errorf("policy %s evaluates to %s, not bool", p.Name, t)}
+	}
+	prg, err := e.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return &program{policy: p, err: fmt.Errorf("policy %s: %w", p.Name, err)}
+	}
+	return &program{policy: p, prg: prg}
+}
+
+// allows evaluates the policy on claim at now. An error means the policy could not be
+// evaluated (it does not compile, exceeded its cost, or did not yield a bool).
+func (p *program) allows(claim authzport.AuthorizationClaim, now time.Time) (bool, error) {
+	if p.err != nil {
+		return false, p.err
+	}
+	out, _, err := p.prg.Eval(activation(claim, now))
+	if err != nil {
+		return false, fmt.Errorf("evaluate policy %s: %w", p.policy.Name, err)
+	}
+	allowed, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("policy %s evaluated to %v, not bool", p.policy.Name, out.Value())
+	}
+	return allowed, nil
+}
+
+// activation binds the policy variables for claim at now.
+func activation(claim authzport.AuthorizationClaim, now time.Time) map[string]any {
+	sourceIP := ""
+	if claim.SourceIP.IsValid() {
+		sourceIP = claim.SourceIP.String()
+	}
+	requestLabels, resourceLabels := claim.RequestLabels, claim.ResourceLabels
+	if requestLabels == nil {
+		requestLabels = map[string]string{}
+	}
+	if resourceLabels == nil {
+		resourceLabels = map[string]string{}
+	}
+	return map[string]any{
+		"claim": map[string]string{
+			"subject":   claim.Subject,
+			"provider":  claim.Provider,
+			"resource":  claim.Resource,
+			"name":      claim.Name,
+			"verb":      claim.Verb,
+			"tenant":    claim.Tenant,
+			"region":    claim.Region,
+			"workspace": claim.Workspace,
+			"sourceIP":  sourceIP,
+		},
+		"request":  map[string]any{"labels": requestLabels},
+		"resource": map[string]any{"labels": resourceLabels},
+		"now":      now,
+	}
+}
@@ -0,0 +1,119 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+)
+
+func TestProgramAllows(t *testing.T) {
+	t.Parallel()
+
+	friday := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	monday := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	noFridayDeletes := Policy{Name: "no-friday-deletes", Expression: "!(claim.verb == 'delete' && now.getDayOfWeek() == 5)"}
+	sandboxDevOnly := Policy{
+		Name:       "sandbox-dev-only",
+		Expression: "claim.workspace != 'sandbox' || claim.resource != 'instances' || claim.verb != 'put' || request.labels[?'env'].orValue('') == 'dev'",
+	}
+
+	tests := []struct {
+		name    string
+		policy  Policy
+		claim   authzport.AuthorizationClaim
+		now     time.Time
+		want    bool
+		wantErr bool
+	}{
+		{name: "delete on friday", policy: noFridayDeletes, claim: authzport.AuthorizationClaim{Verb: "delete"}, now: friday},
+		{name: "delete on monday", policy: noFridayDeletes, claim: authzport.AuthorizationClaim{Verb: "delete"}, now: monday, want: true},
+		{name: "get on friday", policy: noFridayDeletes, claim: authzport.AuthorizationClaim{Verb: "get"}, now: friday, want: true},
+		{
+			name: "sandbox put labelled dev", policy: sandboxDevOnly, now: monday, want: true,
+			claim: authzport.AuthorizationClaim{Verb: "put", Resource: "instances", Workspace: "sandbox", RequestLabels: map[string]string{"env": "dev"}},
+		},
+		{
+			name: "sandbox put labelled prod", policy: sandboxDevOnly, now: monday,
+			claim: authzport.AuthorizationClaim{Verb: "put", Resource: "instances", Workspace: "sandbox", RequestLabels: map[string]string{"env": "prod"}},
+		},
+		{
+			name: "sandbox update relabelling dev to prod", policy: sandboxDevOnly, now: monday,
+			claim: authzport.AuthorizationClaim{
+				Verb: "put", Resource: "instances", Workspace: "sandbox",
+				ResourceLabels: map[string]string{"env": "dev"}, RequestLabels: map[string]string{"env": "prod"},
+			},
+		},
+		{name: "sandbox put unlabelled", policy: sandboxDevOnly, claim: authzport.AuthorizationClaim{Verb: "put", Resource: "instances", Workspace: "sandbox"}, now: monday},
+		{name: "sandbox put of another kind", policy: sandboxDevOnly, claim: authzport.AuthorizationClaim{Verb: "put", Resource: "nics", Workspace: "sandbox"}, now: monday, want: true},
+		{name: "put elsewhere", policy: sandboxDevOnly, claim: authzport.AuthorizationClaim{Verb: "put", Resource: "instances", Workspace: "prod"}, now: monday, want: true},
+		{
+			name: "stored labels", now: monday, want: true,
+			policy: Policy{Name: "keep-prod", Expression: "resource.labels[?'env'].orValue('') != 'prod' || claim.verb != 'delete'"},
+			claim:  authzport.AuthorizationClaim{Verb: "delete", ResourceLabels: map[string]string{"env": "dev"}},
+		},
+		{
+			name: "missing label key fails", now: monday, wantErr: true,
+			policy: Policy{Name: "strict", Expression: "request.labels['env'] == 'dev'"},
+		},
+		{name: "non-bool result fails", policy: Policy{Name: "dyn", Expression: "request.labels"}, now: monday, wantErr: true},
+		{name: "compile error fails", policy: Policy{Name: "broken", Expression: "claim.verb =="}, now: monday, wantErr: true},
+		{name: "non-bool type fails", policy: Policy{Name: "string", Expression: "claim.verb"}, now: monday, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := compile(tc.policy).allows(tc.claim, tc.now)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("allows() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("allows() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPolicyAppliesTo(t *testing.T) {
+	t.Parallel()
+
+	all := Policy{Name: "all"}
+	acme := Policy{Name: "acme", Tenants: []string{"acme"}}
+	if !all.appliesTo("acme") || !all.appliesTo("") {
+		t.Error("a policy without tenants must apply to every request")
+	}
+	if !acme.appliesTo("acme") || acme.appliesTo("globex") || acme.appliesTo("") {
+		t.Error("a tenant-restricted policy must apply to its tenants only")
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		return path
+	}
+
+	policies, err := LoadFile(write("ok.json", `[{"name":"no-deletes","tenants":["acme"],"expression":"claim.verb != 'delete'"}]`))
+	if err != nil || len(policies) != 1 || policies[0].Tenants[0] != "acme" {
+		t.Fatalf("LoadFile() = %+v, %v; want the acme policy", policies, err)
+	}
+	for name, content := range map[string]string{
+		"broken.json":  `[{"name":"broken","expression":"claim.verb =="}]`,
+		"unnamed.json": `[{"expression":"true"}]`,
+		"notjson.json": `{`,
+		"notbool.json": `[{"name":"string","expression":"claim.verb"}]`,
+		"unknown.json": `[{"name":"unknown","expression":"subject == 'alice'"}]`,
+	} {
+		if _, err := LoadFile(write(name, content)); err == nil {
+			t.Errorf("LoadFile(%s): expected error", name)
+		}
+	}
+}
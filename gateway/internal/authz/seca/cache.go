@@ -88,7 +88,8 @@ func (c *CachedChecker) Authorize(ctx context.Context, claim authzport.Authoriza
 		return authzport.DecisionError, kernel.NewError(kernel.KindInternal, fmt.Errorf("load policy data from cache: %w", err))
 	}
 
-	if Evaluate(claim, rolesByName, assignments) {
+	if ra := Grant(claim, rolesByName, assignments, time.Now()); ra != nil {
+		auditTimeBoundGrant(ctx, c.log, claim, ra)
 		return authzport.DecisionAllowed, nil
 	}
 	return authzport.DecisionDenied, kernel.ErrForbidden
@@ -52,30 +52,36 @@ func NewChecker(
 // is never silently disguised as an authorization denial.
 func (c *Checker) Authorize(ctx context.Context, claim authzport.AuthorizationClaim) (authzport.Decision, error) {
 	fetchStart := time.Now()
-	rolesByName, assignments, err := c.load(ctx, claim.Tenant)
+	rolesByName, assignments, err := loadPolicy(ctx, c.roleReader, c.assignmentReader, claim.Tenant)
 	metrics.ObserveRBACFetch("direct", time.Since(fetchStart))
 	if err != nil {
 		c.log.ErrorContext(ctx, "seca rbac: failed to load policy data", slog.Any("error", err))
 		return authzport.DecisionError, kernel.NewError(kernel.KindInternal, fmt.Errorf("load policy data: %w", err))
 	}
 
-	if Evaluate(claim, rolesByName, assignments) {
+	if ra := Grant(claim, rolesByName, assignments, time.Now()); ra != nil {
+		auditTimeBoundGrant(ctx, c.log, claim, ra)
 		return authzport.DecisionAllowed, nil
 	}
 	return authzport.DecisionDenied, kernel.ErrForbidden
 }
 
-// load fetches roles and assignments for the given tenant namespace.
-func (c *Checker) load(ctx context.Context, tenant string) (map[string]*roledom.Role, []*radom.RoleAssignment, error) {
+// loadPolicy fetches roles and assignments for the given tenant namespace.
+func loadPolicy(
+	ctx context.Context,
+	roleReader persistence.ReaderRepo[*roledom.Role],
+	assignmentReader persistence.ReaderRepo[*radom.RoleAssignment],
+	tenant string,
+) (map[string]*roledom.Role, []*radom.RoleAssignment, error) {
 	tenantScope := resource.ListParams{Scope: resource.Scope{Tenant: tenant}}
 
 	var roleList []*roledom.Role
-	if _, err := c.roleReader.List(ctx, tenantScope, &roleList); err != nil {
+	if _, err := roleReader.List(ctx, tenantScope, &roleList); err != nil {
 		return nil, nil, fmt.Errorf("list roles: %w", err)
 	}
 
 	var assignmentList []*radom.RoleAssignment
-	if _, err := c.assignmentReader.List(ctx, tenantScope, &assignmentList); err != nil {
+	if _, err := assignmentReader.List(ctx, tenantScope, &assignmentList); err != nil {
 		return nil, nil, fmt.Errorf("list role assignments: %w", err)
 	}
 
@@ -86,3 +92,21 @@ func (c *Checker) load(ctx context.Context, tenant string) (map[string]*roledom.
 
 	return rolesByName, assignmentList, nil
 }
+
+// auditTimeBoundGrant records that a request was allowed by an assignment with a notAfter
+// bound, so just-in-time access shows up in the audit trail with the grant that carried it.
+func auditTimeBoundGrant(ctx context.Context, log *slog.Logger, claim authzport.AuthorizationClaim, ra *radom.RoleAssignment) {
+	if ra.Spec.Constraints == nil || ra.Spec.Constraints.NotAfter == nil {
+		return
+	}
+	log.InfoContext(ctx, "authz audit: request allowed by time-bound role assignment",
+		slog.String("subject", claim.Subject),
+		slog.String("tenant", claim.Tenant),
+		slog.String("provider", claim.Provider),
+		slog.String("resource", claim.Resource),
+		slog.String("name", claim.Name),
+		slog.String("verb", claim.Verb),
+		slog.String("roleAssignment", ra.Name),
+		slog.Time("notAfter", *ra.Spec.Constraints.NotAfter),
+	)
+}
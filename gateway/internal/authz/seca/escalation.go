@@ -0,0 +1,325 @@
+package seca
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	middleware "github.com/eu-sovereign-cloud/ecp/framework/frontend/middleware"
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	authrest "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/frontend/rest"
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+)
+
+const (
+	// AuthorizationProvider is the provider ID the authorization routes are served under,
+	// and the provider a Permission names to govern Roles and RoleAssignments.
+	AuthorizationProvider = "seca.authorization"
+
+	// VerbEscalate lets a caller write a Role granting permissions it does not hold itself.
+	// It is checked against "roles/<name>" on AuthorizationProvider.
+	VerbEscalate = "escalate"
+	// VerbBind lets a caller write a RoleAssignment referencing a Role whose permissions it
+	// does not hold itself. It is checked against "roles/<name>" on AuthorizationProvider.
+	VerbBind = "bind"
+)
+
+// EscalationGuard is the SECA RBAC implementation of authrest.EscalationGuard.
+//
+// It follows the Kubernetes "escalate/bind" model: a caller may only write a Role whose
+// permissions it already holds, and may only write a RoleAssignment whose roles it already
+// holds in every scope the assignment grants. Without the guard, anyone allowed to put on
+// role-assignments could assign themselves any role.
+//
+// Two verbs lift the restriction for true administrators, both evaluated on
+// "roles/<name>" of the seca.authorization provider:
+//   - escalate: write the named Role regardless of its permissions.
+//   - bind:     reference the named Role in a RoleAssignment regardless of its permissions.
+//
+// A "*" verb covers both, so an existing wildcard administrator keeps working unchanged.
+//
+// The guard reads policy through the same readers as Checker on every write. Role and
+// RoleAssignment writes are rare, so it does not need the informer cache.
+type EscalationGuard struct {
+	roleReader       persistence.ReaderRepo[*roledom.Role]
+	assignmentReader persistence.ReaderRepo[*radom.RoleAssignment]
+	log              *slog.Logger
+}
+
+var _ authrest.EscalationGuard = (*EscalationGuard)(nil)
+
+// NewEscalationGuard creates an EscalationGuard backed by the given readers.
+func NewEscalationGuard(
+	roleReader persistence.ReaderRepo[*roledom.Role],
+	assignmentReader persistence.ReaderRepo[*radom.RoleAssignment],
+	log *slog.Logger,
+) *EscalationGuard {
+	return &EscalationGuard{
+		roleReader:       roleReader,
+		assignmentReader: assignmentReader,
+		log:              log,
+	}
+}
+
+// AuthorizeRoleWrite implements authrest.EscalationGuard.
+// Returns nil when the caller holds every permission of r at tenant level, or holds the
+// escalate verb on the role; otherwise a kernel.KindForbidden error naming the first permission
+// the caller is missing.
+func (g *EscalationGuard) AuthorizeRoleWrite(ctx context.Context, r *roledom.Role) error {
+	caller, err := g.caller(ctx)
+	if err != nil {
+		return err
+	}
+	rolesByName, assignments, err := loadPolicy(ctx, g.roleReader, g.assignmentReader, r.Tenant)
+	if err != nil {
+		g.log.ErrorContext(ctx, "seca rbac: failed to load policy data for escalation check", slog.Any("error", err))
+		return kernel.NewError(kernel.KindInternal, fmt.Errorf("load policy data: %w", err))
+	}
+
+	return checkRoleWrite(caller, r, rolesByName, assignments)
+}
+
+// checkRoleWrite is the pure decision behind AuthorizeRoleWrite.
+func checkRoleWrite(
+	caller *authnport.Identity,
+	r *roledom.Role,
+	rolesByName map[string]*roledom.Role,
+	assignments []*radom.RoleAssignment,
+) error {
+	if Evaluate(roleClaim(caller, r.Tenant, r.Name, VerbEscalate), rolesByName, assignments) {
+		return nil
+	}
+
+	tenantWide := radom.RoleAssignmentScope{Tenants: []string{r.Tenant}}
+	held := heldPermissions(caller, r.Tenant, tenantWide, rolesByName, assignments)
+	if missing, ok := permissionsHeld(caller.TokenScope, held, r.Spec.Permissions); !ok {
+		return kernel.NewError(kernel.KindForbidden,
+			fmt.Errorf("the caller is missing %s, granted by role %s", missing, r.Name))
+	}
+	return nil
+}
+
+// AuthorizeRoleAssignmentWrite implements authrest.EscalationGuard.
+// Returns nil when, for every role ra references, the caller either holds the bind verb on
+// that role or holds all of its permissions in every scope ra grants; otherwise a
+// kernel.KindForbidden error naming the role and the first permission the caller is missing.
+// A reference to a role that does not exist is only allowed with bind, so a later Role write
+// cannot silently widen an assignment nobody could have vetted.
+func (g *EscalationGuard) AuthorizeRoleAssignmentWrite(ctx context.Context, ra *radom.RoleAssignment) error {
+	caller, err := g.caller(ctx)
+	if err != nil {
+		return err
+	}
+	rolesByName, assignments, err := loadPolicy(ctx, g.roleReader, g.assignmentReader, ra.Tenant)
+	if err != nil {
+		g.log.ErrorContext(ctx, "seca rbac: failed to load policy data for escalation check", slog.Any("error", err))
+		return kernel.NewError(kernel.KindInternal, fmt.Errorf("load policy data: %w", err))
+	}
+
+	return checkRoleAssignmentWrite(caller, ra, rolesByName, assignments)
+}
+
+// checkRoleAssignmentWrite is the pure decision behind AuthorizeRoleAssignmentWrite.
+func checkRoleAssignmentWrite(
+	caller *authnport.Identity,
+	ra *radom.RoleAssignment,
+	rolesByName map[string]*roledom.Role,
+	assignments []*radom.RoleAssignment,
+) error {
+	for _, roleName := range ra.Spec.Roles {
+		if Evaluate(roleClaim(caller, ra.Tenant, roleName, VerbBind), rolesByName, assignments) {
+			continue
+		}
+		role, ok := rolesByName[roleName]
+		if !ok {
+			return kernel.NewError(kernel.KindForbidden,
+				fmt.Errorf("role %s does not exist and the caller is missing bind on it", roleName))
+		}
+		for _, scope := range ra.Spec.Scopes {
+			held := heldPermissions(caller, ra.Tenant, scope, rolesByName, assignments)
+			if missing, ok := permissionsHeld(caller.TokenScope, held, role.Spec.Permissions); !ok {
+				return kernel.NewError(kernel.KindForbidden,
+					fmt.Errorf("the caller is missing %s in scope %s, granted by role %s", missing, formatScope(scope), roleName))
+			}
+		}
+	}
+	return nil
+}
+
+// caller returns the authenticated identity stored by the authentication middleware.
+// The guard is only installed alongside the auth chain, so a missing identity is a
+// wiring fault and fails closed.
+func (g *EscalationGuard) caller(ctx context.Context) (*authnport.Identity, error) {
+	identity, ok := middleware.IdentityFromContext(ctx)
+	if !ok {
+		return nil, kernel.ErrUnauthorized
+	}
+	return identity, nil
+}
+
+// roleClaim builds the claim for an escalate or bind check on a single role. The claim is
+// tenant-level (no region, no workspace), like every request the global server authorizes.
+func roleClaim(caller *authnport.Identity, tenant, roleName, verb string) authzport.AuthorizationClaim {
+	return authzport.AuthorizationClaim{
+		Subject:    caller.Subject,
+		TokenScope: caller.TokenScope,
+		Provider:   AuthorizationProvider,
+		Resource:   roledom.Resource,
+		Name:       roleName,
+		Verb:       verb,
+		Tenant:     tenant,
+	}
+}
+
+// heldPermissions returns every permission the caller holds throughout target: the union of
+// the permissions of all roles bound to the caller by an assignment with a scope containing
+// target. Conditional and time-bound assignments do not count, even inside their window. A target the
+// caller's token scope does not contain yields nothing, since the token cap would deny any
+// request made there.
+func heldPermissions(
+	caller *authnport.Identity,
+	tenant string,
+	target radom.RoleAssignmentScope,
+	rolesByName map[string]*roledom.Role,
+	assignments []*radom.RoleAssignment,
+) []roledom.Permission {
+	if len(target.Tenants) == 0 {
+		// Assignments live in the tenant namespace, so an empty tenant list means this tenant.
+		target.Tenants = []string{tenant}
+	}
+	if !tokenScopeContains(caller.TokenScope.Tenants, target.Tenants) ||
+		!tokenScopeContains(caller.TokenScope.Regions, target.Regions) ||
+		!tokenScopeContains(caller.TokenScope.Workspaces, target.Workspaces) {
+		return nil
+	}
+
+	var held []roledom.Permission
+	for _, ra := range assignments {
+		if !subsGrant(ra.Spec.Subs, caller.Subject) {
+			continue
+		}
+		// A conditional grant only holds for some requests and a time-bound one only for
+		// a while; neither may be handed on as an unconditional, open-ended one.
+		if ra.Spec.Constraints.Conditional() || ra.Spec.Constraints.TimeBound() {
+			continue
+		}
+		if !slices.ContainsFunc(ra.Spec.Scopes, func(s radom.RoleAssignmentScope) bool { return scopeContains(s, target) }) {
+			continue
+		}
+		for _, roleName := range ra.Spec.Roles {
+			if role, ok := rolesByName[roleName]; ok {
+				held = append(held, role.Spec.Permissions...)
+			}
+		}
+	}
+	return held
+}
+
+// scopeContains reports whether every (tenant, region, workspace) covered by inner is also
+// covered by outer. An empty dimension is a wildcard, so an empty outer dimension contains
+// anything while an empty inner dimension is only contained by an empty outer one.
+func scopeContains(outer, inner radom.RoleAssignmentScope) bool {
+	return dimensionContains(outer.Tenants, inner.Tenants) &&
+		dimensionContains(outer.Regions, inner.Regions) &&
+		dimensionContains(outer.Workspaces, inner.Workspaces)
+}
+
+// dimensionContains applies the scopeContains rule to one dimension.
+func dimensionContains(outer, inner []string) bool {
+	if len(outer) == 0 {
+		return true
+	}
+	if len(inner) == 0 {
+		return false
+	}
+	for _, v := range inner {
+		if !slices.Contains(outer, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenScopeContains reports whether a token-scope cap admits every value of a granted
+// dimension. An empty cap admits everything; a non-empty cap cannot admit a wildcard.
+func tokenScopeContains(capList, granted []string) bool {
+	if len(capList) == 0 {
+		return true
+	}
+	return dimensionContains(capList, granted)
+}
+
+// permissionsHeld reports whether held covers every (provider, resource, verb) triple
+// granted by want, and the caller's token scope admits it: a token capped to reading
+// compute cannot hand on writes or other providers, whatever its roles hold. On failure it
+// also returns the first uncovered triple, formatted for the error detail.
+func permissionsHeld(tokenScope resource.TokenScope, held, want []roledom.Permission) (string, bool) {
+	for _, p := range want {
+		for _, pattern := range p.Resources {
+			for _, verb := range p.Verb {
+				if !tokenScopeAdmits(tokenScope, p.Provider, pattern, verb) || !slices.ContainsFunc(held, func(h roledom.Permission) bool {
+					return h.Provider == p.Provider && patternCovers(h.Resources, pattern) && matchVerb(h.Verb, verb)
+				}) {
+					return fmt.Sprintf("%s %s on %s", p.Provider, verb, pattern), false
+				}
+			}
+		}
+	}
+	return "", true
+}
+
+// tokenScopeAdmits reports whether a token scope's provider, verb and resource caps admit a
+// granted (provider, resource pattern, verb) triple. Like the held check, it only admits
+// what the caps provably contain, so a "*" verb or resource is only admitted by an
+// uncapped dimension.
+func tokenScopeAdmits(ts resource.TokenScope, provider, resourcePattern, verb string) bool {
+	return (len(ts.Providers) == 0 || slices.Contains(ts.Providers, provider)) &&
+		(len(ts.Verbs) == 0 || (verb != "*" && matchVerb(ts.Verbs, verb))) &&
+		(len(ts.Resources) == 0 || patternCovers(ts.Resources, resourcePattern))
+}
+
+// patternCovers reports whether any held resource pattern matches at least every target a
+// granted pattern matches. Deciding containment between two arbitrary globs is not
+// practical, so it accepts only cases that are provably sound:
+//   - the patterns are equal, or the held pattern is "*";
+//   - the granted pattern is a literal and the held glob matches it;
+//   - the held pattern is a literal prefix followed by a single trailing "*" and the granted
+//     pattern starts with that prefix ("instances*" covers "instances/*").
+//
+// Anything else is treated as not covered, so the check fails closed.
+func patternCovers(held []string, granted string) bool {
+	for _, h := range held {
+		if h == granted || h == "*" {
+			return true
+		}
+		if !isGlob(granted) {
+			if g, err := glob.Compile(h); err == nil && g.Match(granted) {
+				return true
+			}
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(h, "*"); ok && !isGlob(prefix) && strings.HasPrefix(granted, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGlob reports whether a resource pattern contains gobwas/glob metacharacters.
+func isGlob(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[]{}\`)
+}
+
+// formatScope renders a RoleAssignmentScope for an error detail.
+func formatScope(s radom.RoleAssignmentScope) string {
+	return fmt.Sprintf("tenants=%v regions=%v workspaces=%v", s.Tenants, s.Regions, s.Workspaces)
+}
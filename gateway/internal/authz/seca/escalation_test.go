@@ -0,0 +1,287 @@
+package seca
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+)
+
+const escalationTenant = "t1"
+
+// roleInTenant is makeRole with the tenant set, as the handler would build it.
+func roleInTenant(name string, permissions []roledom.Permission) *roledom.Role {
+	r := makeRole(name, permissions)
+	r.Tenant = escalationTenant
+	return r
+}
+
+// assignmentInTenant builds the RoleAssignment under write for the escalation tests.
+func assignmentInTenant(roles []string, scopes ...radom.RoleAssignmentScope) *radom.RoleAssignment {
+	ra := assignSubs([]string{"bob"}, roles, scopes...)
+	ra.Tenant = escalationTenant
+	return ra
+}
+
+func TestCheckRoleWrite(t *testing.T) {
+	t.Parallel()
+
+	viewer := roleInTenant("viewer", []roledom.Permission{
+		{Provider: "seca.compute", Resources: []string{"instances", "instances/*"}, Verb: []string{"get", "list"}},
+	})
+	computeAdmin := roleInTenant("compute-admin", []roledom.Permission{
+		{Provider: "seca.compute", Resources: []string{"instances*"}, Verb: []string{"*"}},
+	})
+	escalator := roleInTenant("escalator", []roledom.Permission{
+		{Provider: AuthorizationProvider, Resources: []string{"roles/*"}, Verb: []string{"*"}},
+	})
+	rolesByName := map[string]*roledom.Role{
+		"viewer":        viewer,
+		"compute-admin": computeAdmin,
+		"escalator":     escalator,
+	}
+	tests := []struct {
+		name        string
+		assignments []*radom.RoleAssignment
+		tokenScope  resource.TokenScope
+		role        *roledom.Role
+		wantErr     bool
+		// wantDetail is part of the detail of the rejection, naming what the caller is missing.
+		wantDetail string
+	}{
+		{
+			name:        "caller holds exactly the granted permissions",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"viewer"}, tenantScope(escalationTenant))},
+			role:        roleInTenant("copy", viewer.Spec.Permissions),
+		},
+		{
+			name:        "caller holds a broader prefix pattern and wildcard verb",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"compute-admin"}, tenantScope(escalationTenant))},
+			role: roleInTenant("editor", []roledom.Permission{
+				{Provider: "seca.compute", Resources: []string{"instances/*"}, Verb: []string{"put", "delete"}},
+			}),
+		},
+		{
+			name:        "granting a verb the caller lacks is rejected",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"viewer"}, tenantScope(escalationTenant))},
+			role: roleInTenant("editor", []roledom.Permission{
+				{Provider: "seca.compute", Resources: []string{"instances/*"}, Verb: []string{"put"}},
+			}),
+			wantErr:    true,
+			wantDetail: "missing seca.compute put on instances/*, granted by role editor",
+		},
+		{
+			name:        "granting another provider is rejected",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"compute-admin"}, tenantScope(escalationTenant))},
+			role: roleInTenant("net", []roledom.Permission{
+				{Provider: "seca.network", Resources: []string{"instances"}, Verb: []string{"get"}},
+			}),
+			wantErr: true,
+		},
+		{
+			name:        "granting a wider pattern than held is rejected",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"viewer"}, tenantScope(escalationTenant))},
+			role: roleInTenant("wide", []roledom.Permission{
+				{Provider: "seca.compute", Resources: []string{"*"}, Verb: []string{"get"}},
+			}),
+			wantErr: true,
+		},
+		{
+			name:        "permissions held only in one region do not count at tenant level",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"viewer"}, tenantRegionScope(escalationTenant, "eu-1"))},
+			role:        roleInTenant("copy", viewer.Spec.Permissions),
+			wantErr:     true,
+		},
+		{
+			name:        "escalate through a wildcard verb on roles allows anything",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"escalator"}, tenantScope(escalationTenant))},
+			role: roleInTenant("root", []roledom.Permission{
+				{Provider: "seca.network", Resources: []string{"*"}, Verb: []string{"*"}},
+			}),
+		},
+		{
+			name:        "a read-only token cannot hand on writes its roles hold",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"compute-admin"}, tenantScope(escalationTenant))},
+			tokenScope:  resource.TokenScope{Verbs: []string{"get", "list"}},
+			role: roleInTenant("editor", []roledom.Permission{
+				{Provider: "seca.compute", Resources: []string{"instances/*"}, Verb: []string{"put"}},
+			}),
+			wantErr: true,
+		},
+		{
+			name:        "a read-only token can hand on reads",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"compute-admin"}, tenantScope(escalationTenant))},
+			tokenScope:  resource.TokenScope{Providers: []string{"seca.compute"}, Verbs: []string{"get", "list"}},
+			role:        roleInTenant("copy", viewer.Spec.Permissions),
+		},
+		{
+			name:        "assignments for other subjects are ignored",
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"bob"}, []string{"escalator"}, tenantScope(escalationTenant))},
+			role:        roleInTenant("copy", viewer.Spec.Permissions),
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			alice := &authnport.Identity{Subject: "alice", TokenScope: tc.tokenScope}
+			err := checkRoleWrite(alice, tc.role, rolesByName, tc.assignments)
+			if tc.wantErr {
+				assertForbidden(t, err, tc.wantDetail)
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected write to be admitted, got %v", err)
+			}
+		})
+	}
+}
+
+func TestCheckRoleAssignmentWrite(t *testing.T) {
+	t.Parallel()
+
+	viewer := roleInTenant("viewer", []roledom.Permission{
+		{Provider: "seca.compute", Resources: []string{"instances"}, Verb: []string{"list"}},
+	})
+	admin := roleInTenant("admin", []roledom.Permission{
+		{Provider: "seca.compute", Resources: []string{"*"}, Verb: []string{"*"}},
+	})
+	binder := roleInTenant("binder", []roledom.Permission{
+		{Provider: AuthorizationProvider, Resources: []string{"roles/admin"}, Verb: []string{"bind"}},
+	})
+	rolesByName := map[string]*roledom.Role{
+		"viewer": viewer,
+		"admin":  admin,
+		"binder": binder,
+	}
+	alice := &authnport.Identity{Subject: "alice"}
+	// boundedAdmin makes alice admin for the next hour only: active now, but not a grant
+	// she may hand on as an open-ended one.
+	hourAgo, inAnHour := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	boundedAdmin := assignSubs([]string{"alice"}, []string{"admin"}, tenantScope(escalationTenant))
+	boundedAdmin.Spec.Constraints = &radom.RoleAssignmentConstraints{NotBefore: &hourAgo, NotAfter: &inAnHour}
+
+	tests := []struct {
+		name        string
+		caller      *authnport.Identity
+		assignments []*radom.RoleAssignment
+		write       *radom.RoleAssignment
+		wantErr     bool
+		// wantDetail is part of the detail of the rejection, naming what the caller is missing.
+		wantDetail string
+	}{
+		{
+			name:        "caller holding the role tenant-wide may assign it in a region",
+			caller:      alice,
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"admin"}, tenantScope(escalationTenant))},
+			write:       assignmentInTenant([]string{"viewer"}, tenantRegionScope(escalationTenant, "eu-1")),
+		},
+		{
+			name:        "empty tenant list in the written scope means the assignment's tenant",
+			caller:      alice,
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"viewer"}, tenantScope(escalationTenant))},
+			write:       assignmentInTenant([]string{"viewer"}, allScope),
+		},
+		{
+			name:        "caller holding a role only in one region may not assign it tenant-wide",
+			caller:      alice,
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"admin"}, tenantRegionScope(escalationTenant, "eu-1"))},
+			write:       assignmentInTenant([]string{"viewer"}, tenantScope(escalationTenant)),
+			wantErr:     true,
+		},
+		{
+			name:        "assigning a role with more permissions than held is rejected",
+			caller:      alice,
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"viewer"}, tenantScope(escalationTenant))},
+			write:       assignmentInTenant([]string{"admin"}, tenantScope(escalationTenant)),
+			wantErr:     true,
+			wantDetail:  "missing seca.compute * on * in scope",
+		},
+		{
+			name:        "an active time-bound grant cannot be handed on open-ended",
+			caller:      alice,
+			assignments: []*radom.RoleAssignment{boundedAdmin},
+			write:       assignmentInTenant([]string{"admin"}, tenantScope(escalationTenant)),
+			wantErr:     true,
+			wantDetail:  "missing seca.compute * on * in scope",
+		},
+		{
+			name:        "bind on the role lifts the restriction",
+			caller:      alice,
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"binder"}, tenantScope(escalationTenant))},
+			write:       assignmentInTenant([]string{"admin"}, tenantScope(escalationTenant)),
+		},
+		{
+			name:        "bind on one role does not cover another",
+			caller:      alice,
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"binder"}, tenantScope(escalationTenant))},
+			write:       assignmentInTenant([]string{"admin", "viewer"}, tenantScope(escalationTenant)),
+			wantErr:     true,
+		},
+		{
+			name:        "unknown role requires bind",
+			caller:      alice,
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"admin"}, tenantScope(escalationTenant))},
+			write:       assignmentInTenant([]string{"missing"}, tenantScope(escalationTenant)),
+			wantErr:     true,
+			wantDetail:  "role missing does not exist and the caller is missing bind on it",
+		},
+		{
+			name: "token scope narrower than the written scope is rejected",
+			caller: &authnport.Identity{
+				Subject:    "alice",
+				TokenScope: resource.TokenScope{Regions: []string{"eu-1"}},
+			},
+			assignments: []*radom.RoleAssignment{assignSubs([]string{"alice"}, []string{"admin"}, tenantScope(escalationTenant))},
+			write:       assignmentInTenant([]string{"viewer"}, tenantScope(escalationTenant)),
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := checkRoleAssignmentWrite(tc.caller, tc.write, rolesByName, tc.assignments)
+			if tc.wantErr {
+				assertForbidden(t, err, tc.wantDetail)
+			}
+			if !tc.wantErr && err != nil {
+				t.Errorf("expected write to be admitted, got %v", err)
+			}
+		})
+	}
+}
+
+// assertForbidden checks that err is a 403 whose detail, as the API response shows it,
+// contains wantDetail.
+func assertForbidden(t *testing.T, err error, wantDetail string) {
+	t.Helper()
+	var kerr *kernel.Error
+	if !errors.As(err, &kerr) || kerr.Kind != kernel.KindForbidden {
+		t.Errorf("expected a forbidden error, got %v", err)
+		return
+	}
+	if !strings.Contains(kerr.Error(), wantDetail) {
+		t.Errorf("detail %q does not contain %q", kerr.Error(), wantDetail)
+	}
+}
+
+func TestEscalationGuard_MissingIdentity(t *testing.T) {
+	t.Parallel()
+
+	g := NewEscalationGuard(&stubRoleReader{}, &stubAssignmentReader{}, slog.Default())
+
+	err := g.AuthorizeRoleWrite(context.Background(), roleInTenant("r", nil))
+
+	if !errors.Is(err, kernel.ErrUnauthorized) {
+		t.Errorf("expected ErrUnauthorized, got %v", err)
+	}
+}
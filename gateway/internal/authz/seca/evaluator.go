@@ -10,8 +10,11 @@
 package seca
 
 import (
+	"fmt"
+	"net/netip"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/gobwas/glob"
 
@@ -21,15 +24,26 @@ import (
 )
 
 // Evaluate checks whether the AuthorizationClaim is permitted by the supplied
-// roles and assignments.
+// roles and assignments at the current time. It is Grant evaluated at time.Now().
+func Evaluate(
+	claim authzport.AuthorizationClaim,
+	rolesByName map[string]*roledom.Role,
+	assignments []*radom.RoleAssignment,
+) bool {
+	return Grant(claim, rolesByName, assignments, time.Now()) != nil
+}
+
+// Grant returns the first RoleAssignment that permits the AuthorizationClaim at time now,
+// or nil when none does.
 //
 // Authorization algorithm:
 //
 //	authorized =
-//	    tokenScopeCovers(claim.TokenScope, tenant, region, workspace)
+//	    tokenScopeCovers(claim.TokenScope, tenant, region, workspace, provider, verb, resource)
 //	  ∧ ∃ ra ∈ assignments:
 //	        scopeCovers(ra.Scopes, tenant, region, workspace)
 //	      ∧ subsGrant(ra.Subs, claim.Subject)
+//	      ∧ constraintsAllow(ra.Constraints, claim, now)
 //	      ∧ ∃ roleName ∈ ra.Roles:
 //	            role := rolesByName[roleName]
 //	            ∃ p ∈ role.Permissions:
@@ -41,18 +55,20 @@ import (
 // RoleAssignment.Scopes scope the grant; empty Tenants/Regions/Workspaces = wildcard.
 // RoleAssignment.Subs restrict the grant to named subjects; "*" covers all subjects.
 // An empty Subs grants nobody (fail-closed; unlike scope slices, empty ≠ wildcard).
+// RoleAssignment.Constraints, when set, limit the grant to a validity window and to
+// requests from given source CIDRs or carrying given resource labels.
 //
-// claim.TokenScope is an optional token cap applied first: a non-empty dimension must cover
-// the request or the whole claim is denied. It can only narrow access, never grant it.
-func Evaluate(
+// claim.TokenScope is an optional token cap applied first, before any role is looked at: a
+// non-empty dimension must cover the request or the whole claim is denied. Its providers,
+// verbs and resources match like a Permission's. It can only narrow access, never grant it.
+func Grant(
 	claim authzport.AuthorizationClaim,
 	rolesByName map[string]*roledom.Role,
 	assignments []*radom.RoleAssignment,
-) bool {
-	if !tokenScopeCovers(claim.TokenScope.Tenants, claim.Tenant) ||
-		!tokenScopeCovers(claim.TokenScope.Regions, claim.Region) ||
-		!tokenScopeCovers(claim.TokenScope.Workspaces, claim.Workspace) {
-		return false
+	now time.Time,
+) *radom.RoleAssignment {
+	if tokenScopeDenial(claim) != "" {
+		return nil
 	}
 
 	for _, ra := range assignments {
@@ -62,25 +78,186 @@ func Evaluate(
 		if !subsGrant(ra.Spec.Subs, claim.Subject) {
 			continue
 		}
-		for _, roleName := range ra.Spec.Roles {
-			role, ok := rolesByName[roleName]
-			if !ok {
-				continue
-			}
-			for _, p := range role.Spec.Permissions {
-				if p.Provider == claim.Provider &&
-					matchResource(p.Resources, claim.Resource, claim.Name) &&
-					matchVerb(p.Verb, claim.Verb) {
-					return true
-				}
+		if !constraintsAllow(ra.Spec.Constraints, claim, now) {
+			continue
+		}
+		if grantingRole(ra, rolesByName, claim) != "" {
+			return ra
+		}
+	}
+	return nil
+}
+
+// grantingRole returns the first of the assignment's roles holding a permission for the
+// claim's provider, resource and verb, or "" when none does. Unknown roles are skipped.
+func grantingRole(ra *radom.RoleAssignment, rolesByName map[string]*roledom.Role, claim authzport.AuthorizationClaim) string {
+	for _, roleName := range ra.Spec.Roles {
+		role, ok := rolesByName[roleName]
+		if !ok {
+			continue
+		}
+		for _, p := range role.Spec.Permissions {
+			if p.Provider == claim.Provider &&
+				matchResource(p.Resources, claim.Resource, claim.Name) &&
+				matchVerb(p.Verb, claim.Verb) {
+				return roleName
 			}
 		}
 	}
-	return false
+	return ""
+}
+
+// Explanation is a Grant decision together with a human-readable reason.
+type Explanation struct {
+	// Allowed reports whether the claim is permitted.
+	Allowed bool
+	// Assignment and Role name the granting RoleAssignment and role; empty when denied.
+	Assignment string
+	Role       string
+	// Reason says which assignment granted the claim or why each candidate did not.
+	Reason string
+}
+
+// Explain evaluates the claim like Grant and says why. It is meant for tooling such as the
+// policy simulator; the request path calls Grant, which does not build reasons.
+//
+// A denial lists, for every assignment naming the subject, the first check it failed:
+// scope, validity window, conditions, or missing permission.
+func Explain(
+	claim authzport.AuthorizationClaim,
+	rolesByName map[string]*roledom.Role,
+	assignments []*radom.RoleAssignment,
+	now time.Time,
+) Explanation {
+	if ra := Grant(claim, rolesByName, assignments, now); ra != nil {
+		role := grantingRole(ra, rolesByName, claim)
+		return Explanation{
+			Allowed:    true,
+			Assignment: ra.Name,
+			Role:       role,
+			Reason:     fmt.Sprintf("granted by role assignment %s through role %s", ra.Name, role),
+		}
+	}
+
+	if reason := tokenScopeDenial(claim); reason != "" {
+		return Explanation{Reason: reason}
+	}
+
+	var reasons []string
+	for _, ra := range assignments {
+		if !subsGrant(ra.Spec.Subs, claim.Subject) {
+			continue
+		}
+		reasons = append(reasons, ra.Name+": "+denyReason(ra, rolesByName, claim, now))
+	}
+	if len(reasons) == 0 {
+		return Explanation{Reason: fmt.Sprintf("no role assignment names subject %q", claim.Subject)}
+	}
+	return Explanation{Reason: strings.Join(reasons, "; ")}
+}
+
+// denyReason says why an assignment naming the claim's subject does not grant it.
+func denyReason(
+	ra *radom.RoleAssignment,
+	rolesByName map[string]*roledom.Role,
+	claim authzport.AuthorizationClaim,
+	now time.Time,
+) string {
+	c := ra.Spec.Constraints
+	switch {
+	case !assignmentCoversScope(ra, claim.Tenant, claim.Region, claim.Workspace):
+		return fmt.Sprintf("scopes do not cover tenant %q, region %q, workspace %q", claim.Tenant, claim.Region, claim.Workspace)
+	case !c.ActiveAt(now):
+		return "outside its validity window"
+	case !constraintsAllow(c, claim, now):
+		return "request does not meet its source or label conditions"
+	}
+
+	var missing []string
+	for _, roleName := range ra.Spec.Roles {
+		if _, ok := rolesByName[roleName]; !ok {
+			missing = append(missing, roleName)
+		}
+	}
+	target := claim.Resource
+	if claim.Name != "" {
+		target += "/" + claim.Name
+	}
+	reason := fmt.Sprintf("no role grants %s %s %s", claim.Verb, claim.Provider, target)
+	if len(missing) > 0 {
+		reason += " (roles not found: " + strings.Join(missing, ", ") + ")"
+	}
+	return reason
+}
+
+// constraintsAllow reports whether a RoleAssignment's constraints hold for the claim at
+// time now. Nil constraints always hold. Source CIDRs require a parsable peer address
+// inside one of them; resource labels require every listed key=value on the labels of the
+// resource and, for a write, on those the write sets, so a claim without labels (any
+// non-write request) never satisfies a label condition.
+func constraintsAllow(c *radom.RoleAssignmentConstraints, claim authzport.AuthorizationClaim, now time.Time) bool {
+	if c == nil {
+		return true
+	}
+	if !c.ActiveAt(now) {
+		return false
+	}
+	if len(c.SourceCIDRs) > 0 {
+		if !claim.SourceIP.IsValid() {
+			return false
+		}
+		if !slices.ContainsFunc(c.SourceCIDRs, func(p netip.Prefix) bool { return p.Contains(claim.SourceIP) }) {
+			return false
+		}
+	}
+	if !labelsMatch(c.ResourceLabels, claim.ResourceLabels) {
+		return false
+	}
+	return claim.RequestLabels == nil || labelsMatch(c.ResourceLabels, claim.RequestLabels)
+}
+
+// labelsMatch reports whether labels carry every key=value of want.
+func labelsMatch(want, labels map[string]string) bool {
+	for key, value := range want {
+		if got, ok := labels[key]; !ok || got != value {
+			return false
+		}
+	}
+	return true
+}
+
+// tokenScopeDenial says which dimension of the claim's token scope does not cover the
+// request, or returns "" when the token scope permits it.
+func tokenScopeDenial(claim authzport.AuthorizationClaim) string {
+	ts := claim.TokenScope
+	for _, dim := range []struct {
+		name, value string
+		list        []string
+	}{
+		{"tenant", claim.Tenant, ts.Tenants},
+		{"region", claim.Region, ts.Regions},
+		{"workspace", claim.Workspace, ts.Workspaces},
+		{"provider", claim.Provider, ts.Providers},
+	} {
+		if !tokenScopeCovers(dim.list, dim.value) {
+			return fmt.Sprintf("token scope does not cover %s %q", dim.name, dim.value)
+		}
+	}
+	if len(ts.Verbs) > 0 && !matchVerb(ts.Verbs, claim.Verb) {
+		return fmt.Sprintf("token scope does not cover verb %q", claim.Verb)
+	}
+	if len(ts.Resources) > 0 && !matchResource(ts.Resources, claim.Resource, claim.Name) {
+		target := claim.Resource
+		if claim.Name != "" {
+			target += "/" + claim.Name
+		}
+		return fmt.Sprintf("token scope does not cover resource %q", target)
+	}
+	return ""
 }
 
 // tokenScopeCovers reports whether an optional token-scope cap permits the request's
-// value for one dimension (tenant, region, or workspace).
+// value for one scalar dimension (tenant, region, workspace, or provider).
 //
 // A cap denies only when it is non-empty AND the request's value is present but not listed.
 // An empty cap imposes no restriction. An empty request value means the dimension does not
@@ -1,7 +1,10 @@
 package seca
 
 import (
+	"net/netip"
+	"strings"
 	"testing"
+	"time"
 
 	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
 	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
@@ -317,6 +320,63 @@ func TestEvaluate(t *testing.T) {
 			},
 			want: false, // admin grants everything, but the token cap excludes t1
 		},
+		{
+			name:        "down-scope provider covers request",
+			claim:       with(baseClaim, func(c *authzport.AuthorizationClaim) { c.TokenScope.Providers = []string{"seca.compute"} }),
+			assignments: []*radom.RoleAssignment{assign([]string{"admin"}, allScope)},
+			want:        true,
+		},
+		{
+			name: "down-scope provider excludes request → denied",
+			claim: with(baseClaim, func(c *authzport.AuthorizationClaim) {
+				c.Provider = "seca.network"
+				c.TokenScope.Providers = []string{"seca.compute"}
+			}),
+			assignments: []*radom.RoleAssignment{assign([]string{"admin"}, allScope)},
+			want:        false,
+		},
+		{
+			name:        "down-scope read-only verbs cover list",
+			claim:       with(baseClaim, func(c *authzport.AuthorizationClaim) { c.TokenScope.Verbs = []string{"get", "list"} }),
+			assignments: []*radom.RoleAssignment{assign([]string{"admin"}, allScope)},
+			want:        true,
+		},
+		{
+			name: "down-scope read-only verbs exclude put → denied",
+			claim: with(baseClaim, func(c *authzport.AuthorizationClaim) {
+				c.Name, c.Verb = instanceName, "put"
+				c.TokenScope.Verbs = []string{"get", "list"}
+			}),
+			assignments: []*radom.RoleAssignment{assign([]string{"admin"}, allScope)},
+			want:        false,
+		},
+		{
+			name: "down-scope bare verb covers its actions",
+			claim: with(baseClaim, func(c *authzport.AuthorizationClaim) {
+				c.Name, c.Verb = instanceName, "post.start"
+				c.TokenScope.Verbs = []string{"post"}
+			}),
+			assignments: []*radom.RoleAssignment{assign([]string{"admin"}, allScope)},
+			want:        true,
+		},
+		{
+			name: "down-scope resource pattern covers item",
+			claim: with(baseClaim, func(c *authzport.AuthorizationClaim) {
+				c.Name, c.Verb = instanceName, "get"
+				c.TokenScope.Resources = []string{"instances/*"}
+			}),
+			assignments: []*radom.RoleAssignment{assign([]string{"admin"}, allScope)},
+			want:        true,
+		},
+		{
+			name: "down-scope resource pattern excludes other resource → denied",
+			claim: with(baseClaim, func(c *authzport.AuthorizationClaim) {
+				c.Resource = "skus"
+				c.TokenScope.Resources = []string{"instances", "instances/*"}
+			}),
+			assignments: []*radom.RoleAssignment{assign([]string{"admin"}, allScope)},
+			want:        false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -456,3 +516,148 @@ func TestSubsGrant(t *testing.T) {
 		}
 	}
 }
+
+func TestGrant_Constraints(t *testing.T) {
+	t.Parallel()
+
+	viewerRole := makeRole("viewer", []roledom.Permission{
+		{Provider: "seca.compute", Resources: []string{"instances/*"}, Verb: []string{"get", "put"}},
+	})
+	rolesByName := map[string]*roledom.Role{"viewer": viewerRole}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	hourAgo, inAnHour := now.Add(-time.Hour), now.Add(time.Hour)
+	onCallNet := netip.MustParsePrefix("10.0.0.0/8")
+
+	base := authzport.AuthorizationClaim{
+		Subject:  "alice",
+		Provider: "seca.compute",
+		Resource: "instances",
+		Name:     instanceName,
+		Verb:     "get",
+		Tenant:   "t1",
+		SourceIP: netip.MustParseAddr("10.1.2.3"),
+	}
+
+	tests := []struct {
+		name        string
+		constraints *radom.RoleAssignmentConstraints
+		claim       authzport.AuthorizationClaim
+		want        bool
+	}{
+		{"inside window", &radom.RoleAssignmentConstraints{NotBefore: &hourAgo, NotAfter: &inAnHour}, base, true},
+		{"before notBefore", &radom.RoleAssignmentConstraints{NotBefore: &inAnHour}, base, false},
+		{"after notAfter", &radom.RoleAssignmentConstraints{NotAfter: &hourAgo}, base, false},
+		{"source inside CIDR", &radom.RoleAssignmentConstraints{SourceCIDRs: []netip.Prefix{onCallNet}}, base, true},
+		{
+			"source outside CIDR",
+			&radom.RoleAssignmentConstraints{SourceCIDRs: []netip.Prefix{onCallNet}},
+			with(base, func(c *authzport.AuthorizationClaim) { c.SourceIP = netip.MustParseAddr("192.168.1.1") }),
+			false,
+		},
+		{
+			"unknown source never matches a CIDR",
+			&radom.RoleAssignmentConstraints{SourceCIDRs: []netip.Prefix{onCallNet}},
+			with(base, func(c *authzport.AuthorizationClaim) { c.SourceIP = netip.Addr{} }),
+			false,
+		},
+		{
+			"write carrying required labels",
+			&radom.RoleAssignmentConstraints{ResourceLabels: map[string]string{"env": "dev"}},
+			with(base, func(c *authzport.AuthorizationClaim) {
+				c.Verb = "put"
+				c.ResourceLabels = map[string]string{"env": "dev", "team": "a"}
+				c.RequestLabels = map[string]string{"env": "dev", "team": "b"}
+			}),
+			true,
+		},
+		{
+			"update relabelling the resource out of the condition",
+			&radom.RoleAssignmentConstraints{ResourceLabels: map[string]string{"env": "dev"}},
+			with(base, func(c *authzport.AuthorizationClaim) {
+				c.Verb = "put"
+				c.ResourceLabels = map[string]string{"env": "dev"}
+				c.RequestLabels = map[string]string{"env": "prod"}
+			}),
+			false,
+		},
+		{
+			"update clearing the labels",
+			&radom.RoleAssignmentConstraints{ResourceLabels: map[string]string{"env": "dev"}},
+			with(base, func(c *authzport.AuthorizationClaim) {
+				c.Verb = "put"
+				c.ResourceLabels = map[string]string{"env": "dev"}
+				c.RequestLabels = map[string]string{}
+			}),
+			false,
+		},
+		{
+			"write with a different label value",
+			&radom.RoleAssignmentConstraints{ResourceLabels: map[string]string{"env": "dev"}},
+			with(base, func(c *authzport.AuthorizationClaim) {
+				c.Verb = "put"
+				c.ResourceLabels = map[string]string{"env": "prod"}
+			}),
+			false,
+		},
+		{"read never satisfies a label condition", &radom.RoleAssignmentConstraints{ResourceLabels: map[string]string{"env": "dev"}}, base, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ra := assignSubs([]string{"alice"}, []string{"viewer"}, tenantScope("t1"))
+			ra.Spec.Constraints = tc.constraints
+
+			got := Grant(tc.claim, rolesByName, []*radom.RoleAssignment{ra}, now) != nil
+
+			if got != tc.want {
+				t.Errorf("Grant() allowed = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	viewerRole := makeRole("viewer", []roledom.Permission{
+		{Provider: "seca.compute", Resources: []string{"instances/*"}, Verb: []string{"get"}},
+	})
+	rolesByName := map[string]*roledom.Role{"viewer": viewerRole}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	claim := authzport.AuthorizationClaim{
+		Subject: "alice", Provider: "seca.compute", Resource: "instances", Name: instanceName, Verb: "get", Tenant: "t1",
+	}
+
+	granting := assignSubs([]string{"alice"}, []string{"viewer"}, tenantScope("t1"))
+	granting.Name = "alice-viewer"
+	got := Explain(claim, rolesByName, []*radom.RoleAssignment{granting}, now)
+	if !got.Allowed || got.Assignment != "alice-viewer" || got.Role != "viewer" {
+		t.Errorf("Explain() = %+v, want allowed by alice-viewer through viewer", got)
+	}
+
+	otherTenant := assignSubs([]string{"alice"}, []string{"viewer"}, tenantScope("t2"))
+	otherTenant.Name = "t2-only"
+	missingRole := assignSubs([]string{"alice"}, []string{"editor"}, tenantScope("t1"))
+	missingRole.Name = "editor"
+	got = Explain(claim, rolesByName, []*radom.RoleAssignment{otherTenant, missingRole}, now)
+	if got.Allowed {
+		t.Fatalf("Explain() allowed, want denied")
+	}
+	for _, want := range []string{"t2-only: scopes do not cover", "editor: no role grants get seca.compute instances/inst1 (roles not found: editor)"} {
+		if !strings.Contains(got.Reason, want) {
+			t.Errorf("reason %q does not contain %q", got.Reason, want)
+		}
+	}
+
+	got = Explain(with(claim, func(c *authzport.AuthorizationClaim) { c.TokenScope.Verbs = []string{"list"} }),
+		rolesByName, []*radom.RoleAssignment{granting}, now)
+	if got.Allowed || got.Reason != `token scope does not cover verb "get"` {
+		t.Errorf("Explain() = %+v, want denial naming the token scope verb", got)
+	}
+
+	got = Explain(with(claim, func(c *authzport.AuthorizationClaim) { c.Subject = "bob" }), rolesByName, []*radom.RoleAssignment{granting}, now)
+	if got.Allowed || got.Reason != `no role assignment names subject "bob"` {
+		t.Errorf("Explain() = %+v, want denial naming the subject", got)
+	}
+}
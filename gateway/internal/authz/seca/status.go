@@ -0,0 +1,276 @@
+package seca
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistence "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+	rak8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment/backend/kubernetes"
+	rolek8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role/backend/kubernetes"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+const (
+	// roleRefsConditionType is the condition type reporting unresolved role references.
+	roleRefsConditionType = "RoleRefs"
+	// roleRefsMissingReason marks an assignment that references roles which do not exist,
+	// either because they never did or because they were deleted afterwards.
+	roleRefsMissingReason = "RoleNotFound"
+	// validityConditionType is the condition type reporting an assignment outside its
+	// notBefore/notAfter window.
+	validityConditionType = "Validity"
+)
+
+// AssignmentStatusController keeps RoleAssignment status in line with what the assignment
+// actually grants.
+//
+// Evaluate skips role names it cannot resolve and assignments outside their validity
+// window, so such an assignment silently grants less than it says. The controller watches
+// Roles and RoleAssignments and, per tenant namespace, pushes a condition onto every
+// affected assignment:
+//   - Validity/error (Expired) once notAfter has passed;
+//   - Validity/pending (NotYetValid) before notBefore;
+//   - RoleRefs/error (RoleNotFound) naming referenced roles that do not exist.
+//
+// Once none applies any more, an active condition is pushed. It also acts as the expiry
+// sweeper: after each sync the namespace is requeued for the next notBefore/notAfter
+// boundary, so the status flips when the window does rather than on the next edit.
+// Status is only written when the head condition changes.
+//
+// Lifecycle: call Start once at server startup; it returns after the initial sync and runs
+// the worker until ctx is cancelled.
+type AssignmentStatusController struct {
+	factory       dynamicinformer.DynamicSharedInformerFactory
+	writer        persistence.WriterRepo[*radom.RoleAssignment]
+	queue         workqueue.TypedRateLimitingInterface[string]
+	maxConditions int
+	log           *slog.Logger
+}
+
+// NewAssignmentStatusController creates a AssignmentStatusController. Status writes go through writer;
+// maxConditions bounds the condition history like the controllers' builder option.
+func NewAssignmentStatusController(
+	dynClient dynamic.Interface,
+	writer persistence.WriterRepo[*radom.RoleAssignment],
+	maxConditions int,
+	log *slog.Logger,
+) *AssignmentStatusController {
+	return &AssignmentStatusController{
+		factory:       dynamicinformer.NewDynamicSharedInformerFactory(dynClient, defaultResync),
+		writer:        writer,
+		queue:         workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]()),
+		maxConditions: maxConditions,
+		log:           log,
+	}
+}
+
+// Start registers the informers, waits for their initial sync and launches the worker.
+func (c *AssignmentStatusController) Start(ctx context.Context) error {
+	c.log.Info("authz status: starting role assignment status controller")
+
+	// Any change to a Role or RoleAssignment re-evaluates its whole tenant namespace:
+	// a Role event may affect every assignment there, and namespaces are small.
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: func(_, obj any) { c.enqueue(obj) },
+		DeleteFunc: c.enqueue,
+	}
+	if _, err := c.factory.ForResource(rolek8s.RoleGVR).Informer().AddEventHandler(handler); err != nil {
+		return fmt.Errorf("watch roles: %w", err)
+	}
+	if _, err := c.factory.ForResource(rak8s.RoleAssignmentGVR).Informer().AddEventHandler(handler); err != nil {
+		return fmt.Errorf("watch role assignments: %w", err)
+	}
+
+	c.factory.Start(ctx.Done())
+	for gvr, ok := range c.factory.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return fmt.Errorf("informer cache sync timed out for %s", gvr.Resource)
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		c.queue.ShutDown()
+	}()
+	go c.run(ctx)
+	return nil
+}
+
+// enqueue queues the namespace of a Role or RoleAssignment event.
+func (c *AssignmentStatusController) enqueue(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		c.log.Warn("authz status: skip event without key", slog.Any("error", err))
+		return
+	}
+	namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+	c.queue.Add(namespace)
+}
+
+// run processes queued namespaces until the queue shuts down.
+func (c *AssignmentStatusController) run(ctx context.Context) {
+	for {
+		namespace, shutdown := c.queue.Get()
+		if shutdown {
+			return
+		}
+		if err := c.sync(ctx, namespace); err != nil {
+			c.log.WarnContext(ctx, "authz status: sync failed, retrying",
+				slog.String("namespace", namespace), slog.Any("error", err))
+			c.queue.AddRateLimited(namespace)
+		} else {
+			c.queue.Forget(namespace)
+		}
+		c.queue.Done(namespace)
+	}
+}
+
+// sync reconciles the status of every RoleAssignment in namespace.
+func (c *AssignmentStatusController) sync(ctx context.Context, namespace string) error {
+	rawRoles, err := c.factory.ForResource(rolek8s.RoleGVR).Lister().ByNamespace(namespace).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("list roles from cache (ns=%s): %w", namespace, err)
+	}
+	roleNames := make(map[string]struct{}, len(rawRoles))
+	for _, obj := range rawRoles {
+		u, err := toUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		roleNames[u.GetName()] = struct{}{}
+	}
+
+	rawAssignments, err := c.factory.ForResource(rak8s.RoleAssignmentGVR).Lister().ByNamespace(namespace).List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("list assignments from cache (ns=%s): %w", namespace, err)
+	}
+
+	now := time.Now()
+	var next time.Time
+	var errs []error
+	for _, obj := range rawAssignments {
+		u, err := toUnstructured(obj)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ra, err := rak8s.RoleAssignmentFromCR(u)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("convert role assignment %s: %w", u.GetName(), err))
+			continue
+		}
+		if ra.DeletedAt != nil {
+			continue
+		}
+		if t, ok := nextBoundary(ra.Spec.Constraints, now); ok && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+		if !applyAssignmentCondition(ra, roleNames, now, c.maxConditions) {
+			continue
+		}
+		if _, err := c.writer.UpdateStatus(ctx, ra); err != nil && !errors.Is(err, kernel.ErrNotFound) {
+			errs = append(errs, fmt.Errorf("update status of role assignment %s: %w", ra.Name, err))
+		}
+	}
+	if !next.IsZero() {
+		c.queue.AddAfter(namespace, next.Sub(now))
+	}
+	return errors.Join(errs...)
+}
+
+// applyAssignmentCondition pushes the condition describing what ra grants at time now onto
+// its status when it differs from the current head condition, and reports whether it did.
+// An assignment whose head condition is not one of ours and which grants as written is
+// left alone: that is the state the write path already left it in.
+func applyAssignmentCondition(ra *radom.RoleAssignment, roleNames map[string]struct{}, now time.Time, maxConditions int) bool {
+	var missing []string
+	for _, name := range ra.Spec.Roles {
+		if _, ok := roleNames[name]; !ok && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+	}
+
+	if ra.Status == nil {
+		ra.Status = &radom.RoleAssignmentStatus{}
+	}
+	head := ra.Status.PeekConditions()
+	constraints := ra.Spec.Constraints
+
+	var want commondomain.StatusCondition
+	switch {
+	case constraints.Expired(now):
+		want = validityCondition(commondomain.ResourceStateError, "Expired",
+			"assignment expired at "+constraints.NotAfter.UTC().Format(time.RFC3339))
+	case !constraints.ActiveAt(now):
+		want = validityCondition(commondomain.ResourceStatePending, "NotYetValid",
+			"assignment grants from "+constraints.NotBefore.UTC().Format(time.RFC3339))
+	case len(missing) > 0:
+		want = roleRefsMissingCondition(missing)
+	case head != nil && (head.Type == roleRefsConditionType || head.Type == validityConditionType):
+		want = commonbackend.ConditionFromState(commondomain.ResourceStateActive)
+	default:
+		return false
+	}
+	if head != nil && commondomain.EqualStatusConditions(*head, want) {
+		return false
+	}
+
+	ra.Status.PushCondition(want)
+	commonbackend.TrimConditions(&ra.Status.Status, maxConditions)
+	return true
+}
+
+// nextBoundary returns the earliest notBefore or notAfter strictly after now.
+func nextBoundary(c *radom.RoleAssignmentConstraints, now time.Time) (time.Time, bool) {
+	if c == nil {
+		return time.Time{}, false
+	}
+	switch {
+	case c.NotBefore != nil && c.NotBefore.After(now):
+		return *c.NotBefore, true
+	case c.NotAfter != nil && c.NotAfter.After(now):
+		return *c.NotAfter, true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// validityCondition reports an assignment outside its validity window.
+func validityCondition(state commondomain.ResourceState, reason, message string) commondomain.StatusCondition {
+	return commondomain.StatusCondition{
+		LastTransitionAt: time.Now(),
+		Type:             validityConditionType,
+		State:            state,
+		Reason:           reason,
+		Message:          message,
+	}
+}
+
+// roleRefsMissingCondition reports the roles an assignment references but which do not exist.
+func roleRefsMissingCondition(missing []string) commondomain.StatusCondition {
+	return commondomain.StatusCondition{
+		LastTransitionAt: time.Now(),
+		Type:             roleRefsConditionType,
+		State:            commondomain.ResourceStateError,
+		Reason:           roleRefsMissingReason,
+		Message:          "referenced roles not found: " + strings.Join(missing, ", "),
+	}
+}
@@ -0,0 +1,100 @@
+package seca
+
+import (
+	"testing"
+	"time"
+
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+	commondom "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+func TestApplyAssignmentCondition(t *testing.T) {
+	t.Parallel()
+
+	active := commondom.StatusCondition{Type: "Reconcile", State: commondom.ResourceStateActive, Reason: "active"}
+	roleNames := map[string]struct{}{"viewer": {}}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	hourAgo, inAnHour := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		roles       []string
+		constraints *radom.RoleAssignmentConstraints
+		head        *commondom.StatusCondition
+		wantWrite   bool
+		wantState   commondom.ResourceState
+	}{
+		{
+			name:      "all roles exist on a freshly written assignment",
+			roles:     []string{"viewer"},
+			head:      &active,
+			wantWrite: false,
+			wantState: commondom.ResourceStateActive,
+		},
+		{
+			name:      "missing role is reported",
+			roles:     []string{"viewer", "admin"},
+			head:      &active,
+			wantWrite: true,
+			wantState: commondom.ResourceStateError,
+		},
+		{
+			name:      "already reported missing role is not rewritten",
+			roles:     []string{"admin"},
+			head:      ptr(roleRefsMissingCondition([]string{"admin"})),
+			wantWrite: false,
+			wantState: commondom.ResourceStateError,
+		},
+		{
+			name:      "role created after being reported missing clears the error",
+			roles:     []string{"viewer"},
+			head:      ptr(roleRefsMissingCondition([]string{"viewer"})),
+			wantWrite: true,
+			wantState: commondom.ResourceStateActive,
+		},
+		{
+			name:        "expired assignment is marked even when its roles exist",
+			roles:       []string{"viewer"},
+			constraints: &radom.RoleAssignmentConstraints{NotAfter: &hourAgo},
+			head:        &active,
+			wantWrite:   true,
+			wantState:   commondom.ResourceStateError,
+		},
+		{
+			name:        "assignment before its window is pending",
+			roles:       []string{"viewer"},
+			constraints: &radom.RoleAssignmentConstraints{NotBefore: &inAnHour},
+			head:        &active,
+			wantWrite:   true,
+			wantState:   commondom.ResourceStatePending,
+		},
+		{
+			name:        "assignment inside its window is left active",
+			roles:       []string{"viewer"},
+			constraints: &radom.RoleAssignmentConstraints{NotBefore: &hourAgo, NotAfter: &inAnHour},
+			head:        &active,
+			wantWrite:   false,
+			wantState:   commondom.ResourceStateActive,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ra := &radom.RoleAssignment{Spec: radom.RoleAssignmentSpec{Roles: tc.roles, Constraints: tc.constraints}}
+			ra.Status = &radom.RoleAssignmentStatus{}
+			ra.Status.PushCondition(*tc.head)
+
+			wrote := applyAssignmentCondition(ra, roleNames, now, 5)
+
+			if wrote != tc.wantWrite {
+				t.Errorf("wrote = %v, want %v", wrote, tc.wantWrite)
+			}
+			if ra.Status.State != tc.wantState {
+				t.Errorf("state = %v, want %v", ra.Status.State, tc.wantState)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,351 @@
+// Package simulate evaluates SECA RBAC policy offline.
+//
+// It loads Role and RoleAssignment objects from YAML, runs a list of test cases through
+// seca.Explain and reports each decision with its reason. It backs the
+// `authz simulate` gateway subcommand, which lets tenants test policies kept in Git
+// before applying them through the authorization API.
+package simulate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	authzport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authz"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	seca "github.com/eu-sovereign-cloud/ecp/gateway/internal/authz/seca"
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+	rak8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment/backend/kubernetes"
+	rolek8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role/backend/kubernetes"
+)
+
+// Expected decisions in a Case.
+const (
+	ExpectAllow = "allow"
+	ExpectDeny  = "deny"
+)
+
+// Policy is the set of Roles and RoleAssignments cases are evaluated against.
+type Policy struct {
+	Roles       []*roledom.Role
+	Assignments []*radom.RoleAssignment
+}
+
+// Load decodes Role and RoleAssignment custom resources from a YAML or JSON stream,
+// as written by hand or exported with `kubectl get roles,roleassignments -o yaml`.
+// Multiple documents and `kind: List` wrappers are accepted; any other kind is an error.
+func (p *Policy) Load(r io.Reader) error {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var obj map[string]any
+		if err := decoder.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("decode policy document: %w", err)
+		}
+		if obj == nil {
+			continue
+		}
+		if err := p.add(&unstructured.Unstructured{Object: obj}); err != nil {
+			return err
+		}
+	}
+}
+
+// add converts one object, unwrapping lists.
+func (p *Policy) add(u *unstructured.Unstructured) error {
+	switch u.GetKind() {
+	case "List":
+		list, err := u.ToList()
+		if err != nil {
+			return fmt.Errorf("decode list: %w", err)
+		}
+		for i := range list.Items {
+			if err := p.add(&list.Items[i]); err != nil {
+				return err
+			}
+		}
+	case roledom.Kind:
+		r, err := rolek8s.RoleFromCR(u)
+		if err != nil {
+			return fmt.Errorf("role %s: %w", u.GetName(), err)
+		}
+		p.Roles = append(p.Roles, r)
+	case radom.Kind:
+		ra, err := rak8s.RoleAssignmentFromCR(u)
+		if err != nil {
+			return fmt.Errorf("role assignment %s: %w", u.GetName(), err)
+		}
+		p.Assignments = append(p.Assignments, ra)
+	default:
+		return fmt.Errorf("unsupported kind %q in policy (name %q)", u.GetKind(), u.GetName())
+	}
+	return nil
+}
+
+// forTenant returns the roles by name and the assignments visible to a tenant. Objects
+// carrying no tenant label (typical for hand-written YAML) apply to every tenant.
+func (p *Policy) forTenant(tenant string) (map[string]*roledom.Role, []*radom.RoleAssignment) {
+	rolesByName := make(map[string]*roledom.Role, len(p.Roles))
+	for _, r := range p.Roles {
+		if r.Tenant == "" || r.Tenant == tenant {
+			rolesByName[r.Name] = r
+		}
+	}
+	var assignments []*radom.RoleAssignment
+	for _, ra := range p.Assignments {
+		if ra.Tenant == "" || ra.Tenant == tenant {
+			assignments = append(assignments, ra)
+		}
+	}
+	return rolesByName, assignments
+}
+
+// Caller describes who sends a simulated request.
+type Caller struct {
+	Subject    string              `json:"subject"`
+	SourceIP   string              `json:"sourceIP,omitempty"`
+	TokenScope resource.TokenScope `json:"tokenScope,omitempty"`
+}
+
+// Claim is an authorization claim written out field by field.
+type Claim struct {
+	Caller
+	Provider       string            `json:"provider"`
+	Resource       string            `json:"resource"`
+	Name           string            `json:"name,omitempty"`
+	Verb           string            `json:"verb"`
+	Tenant         string            `json:"tenant"`
+	Region         string            `json:"region,omitempty"`
+	Workspace      string            `json:"workspace,omitempty"`
+	ResourceLabels map[string]string `json:"resourceLabels,omitempty"`
+	// RequestLabels are the labels a PUT body sets; see authzport.AuthorizationClaim.
+	RequestLabels map[string]string `json:"requestLabels,omitempty"`
+}
+
+// Request is a recorded API request; the claim is derived from its method and path the
+// way the gateway's claim extractor derives it from the matched route.
+type Request struct {
+	Caller
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Region is the region the request was served in; empty on the global server.
+	Region string `json:"region,omitempty"`
+	// Labels are the labels a PUT body sets on the resource.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Case is one simulated decision. Exactly one of Claim and Request is set.
+type Case struct {
+	Name    string   `json:"name"`
+	Claim   *Claim   `json:"claim,omitempty"`
+	Request *Request `json:"request,omitempty"`
+	// Expect is ExpectAllow, ExpectDeny, or empty to only report the decision.
+	Expect string `json:"expect,omitempty"`
+	// At evaluates the case at a fixed time, e.g. to test time-bound assignments.
+	At *time.Time `json:"at,omitempty"`
+}
+
+// Suite is the content of a cases file.
+type Suite struct {
+	Cases []Case `json:"cases"`
+}
+
+// LoadSuite decodes a cases file from YAML or JSON and checks every case is well formed.
+func LoadSuite(r io.Reader) (*Suite, error) {
+	var s Suite
+	if err := utilyaml.NewYAMLOrJSONDecoder(r, 4096).Decode(&s); err != nil {
+		return nil, fmt.Errorf("decode cases: %w", err)
+	}
+	for i, c := range s.Cases {
+		if (c.Claim == nil) == (c.Request == nil) {
+			return nil, fmt.Errorf("case %d (%s): exactly one of claim and request must be set", i, c.Name)
+		}
+		if c.Expect != "" && c.Expect != ExpectAllow && c.Expect != ExpectDeny {
+			return nil, fmt.Errorf("case %d (%s): expect must be %q or %q", i, c.Name, ExpectAllow, ExpectDeny)
+		}
+	}
+	return &s, nil
+}
+
+// Result is the outcome of one case.
+type Result struct {
+	Case        Case
+	Explanation seca.Explanation
+	// Err is set when the case could not be turned into a claim.
+	Err error
+}
+
+// Failed reports whether the case errored or its decision differs from the expectation.
+func (r Result) Failed() bool {
+	if r.Err != nil {
+		return true
+	}
+	switch r.Case.Expect {
+	case ExpectAllow:
+		return !r.Explanation.Allowed
+	case ExpectDeny:
+		return r.Explanation.Allowed
+	default:
+		return false
+	}
+}
+
+// Run evaluates every case against the policy. Cases without At are evaluated at now.
+func Run(p *Policy, s *Suite, now time.Time) []Result {
+	results := make([]Result, 0, len(s.Cases))
+	for _, c := range s.Cases {
+		res := Result{Case: c}
+		claim, err := c.claim()
+		if err != nil {
+			res.Err = err
+			results = append(results, res)
+			continue
+		}
+		at := now
+		if c.At != nil {
+			at = *c.At
+		}
+		rolesByName, assignments := p.forTenant(claim.Tenant)
+		res.Explanation = seca.Explain(claim, rolesByName, assignments, at)
+		results = append(results, res)
+	}
+	return results
+}
+
+// Report writes one line per result and a summary, and returns the number of failures.
+func Report(w io.Writer, results []Result) int {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	failed := 0
+	for _, r := range results {
+		status := "PASS"
+		switch {
+		case r.Failed():
+			status = "FAIL"
+			failed++
+		case r.Case.Expect == "":
+			status = "-"
+		}
+		if r.Err != nil {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%v\n", status, r.Case.Name, "error", r.Err)
+			continue
+		}
+		decision := ExpectDeny
+		if r.Explanation.Allowed {
+			decision = ExpectAllow
+		}
+		if r.Failed() {
+			decision += " (expected " + r.Case.Expect + ")"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", status, r.Case.Name, decision, r.Explanation.Reason)
+	}
+	_ = tw.Flush()
+	_, _ = fmt.Fprintf(w, "%d cases, %d failed\n", len(results), failed)
+	return failed
+}
+
+// claim builds the authorization claim a case describes.
+func (c Case) claim() (authzport.AuthorizationClaim, error) {
+	if c.Claim != nil {
+		claim := authzport.AuthorizationClaim{
+			Provider:       c.Claim.Provider,
+			Resource:       c.Claim.Resource,
+			Name:           c.Claim.Name,
+			Verb:           c.Claim.Verb,
+			Tenant:         c.Claim.Tenant,
+			Region:         c.Claim.Region,
+			Workspace:      c.Claim.Workspace,
+			ResourceLabels: c.Claim.ResourceLabels,
+			RequestLabels:  c.Claim.RequestLabels,
+		}
+		return withCaller(claim, c.Claim.Caller)
+	}
+
+	claim, err := claimFromRequest(c.Request.Method, c.Request.Path)
+	if err != nil {
+		return authzport.AuthorizationClaim{}, err
+	}
+	claim.Region = c.Request.Region
+	if claim.Verb == "put" {
+		// Nothing is stored in a simulation: the PUT is judged as a create.
+		claim.ResourceLabels = c.Request.Labels
+		claim.RequestLabels = c.Request.Labels
+		if claim.RequestLabels == nil {
+			claim.RequestLabels = map[string]string{}
+		}
+	}
+	return withCaller(claim, c.Request.Caller)
+}
+
+// withCaller copies the caller fields onto a claim.
+func withCaller(claim authzport.AuthorizationClaim, caller Caller) (authzport.AuthorizationClaim, error) {
+	claim.Subject = caller.Subject
+	claim.TokenScope = caller.TokenScope
+	if caller.SourceIP != "" {
+		ip, err := netip.ParseAddr(caller.SourceIP)
+		if err != nil {
+			return authzport.AuthorizationClaim{}, fmt.Errorf("source IP: %w", err)
+		}
+		claim.SourceIP = ip.Unmap()
+	}
+	return claim, nil
+}
+
+// claimFromRequest derives provider, tenant, workspace, resource, name and verb from a
+// concrete request path such as
+//
+//	/providers/seca.network/v1/tenants/t1/workspaces/w1/networks/n1/subnets/s1
+//
+// Below the tenant and workspace anchors the path alternates kind and name segments. A
+// POST with a trailing segment after a name is the action "post.<segment>". Verbs follow
+// the gateway's claim extractor: GET item → get, GET collection → list.
+func claimFromRequest(method, path string) (authzport.AuthorizationClaim, error) {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segs) < 5 || segs[0] != "providers" || segs[2] != "v1" || segs[3] != "tenants" {
+		return authzport.AuthorizationClaim{}, fmt.Errorf("path %q is not /providers/<provider>/v1/tenants/<tenant>/...", path)
+	}
+	claim := authzport.AuthorizationClaim{Provider: segs[1], Tenant: segs[4]}
+	segs = segs[5:]
+	if len(segs) >= 2 && segs[0] == "workspaces" {
+		claim.Workspace = segs[1]
+		segs = segs[2:]
+	}
+	if len(segs) == 0 {
+		return authzport.AuthorizationClaim{}, fmt.Errorf("path %q names no resource", path)
+	}
+
+	method = strings.ToUpper(method)
+	action := ""
+	if method == http.MethodPost && len(segs) >= 3 && len(segs)%2 == 1 {
+		action = segs[len(segs)-1]
+		segs = segs[:len(segs)-1]
+	}
+	if len(segs)%2 == 0 {
+		claim.Name = segs[len(segs)-1]
+	}
+	var kinds []string
+	for i := 0; i < len(segs); i += 2 {
+		kinds = append(kinds, segs[i])
+	}
+	claim.Resource = strings.Join(kinds, "/")
+
+	switch {
+	case action != "":
+		claim.Verb = "post." + action
+	case method == http.MethodGet && claim.Name == "":
+		claim.Verb = "list"
+	default:
+		claim.Verb = strings.ToLower(method)
+	}
+	return claim, nil
+}
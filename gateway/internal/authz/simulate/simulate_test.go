@@ -0,0 +1,160 @@
+package simulate
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+const policyYAML = `
+apiVersion: authorization.v1.secapi.cloud/v1
+kind: Role
+metadata:
+  name: viewer
+spec:
+  permissions:
+    - provider: seca.compute
+      resources: ["instances", "instances/*"]
+      verb: ["get", "list"]
+---
+apiVersion: v1
+kind: List
+items:
+  - apiVersion: authorization.v1.secapi.cloud/v1
+    kind: RoleAssignment
+    metadata:
+      name: alice-viewer
+    spec:
+      subs: ["alice"]
+      roles: ["viewer"]
+      scopes:
+        - tenants: ["t1"]
+  - apiVersion: authorization.v1.secapi.cloud/v1
+    kind: RoleAssignment
+    metadata:
+      name: bob-viewer
+    spec:
+      subs: ["bob"]
+      roles: ["viewer"]
+      scopes:
+        - tenants: ["t1"]
+    commonData:
+      extensions:
+        notAfter: "2026-01-01T00:00:00Z"
+`
+
+const casesYAML = `
+cases:
+  - name: alice lists instances
+    request:
+      subject: alice
+      method: GET
+      path: /providers/seca.compute/v1/tenants/t1/workspaces/w1/instances
+    expect: allow
+  - name: alice deletes an instance
+    claim:
+      subject: alice
+      provider: seca.compute
+      resource: instances
+      name: i1
+      verb: delete
+      tenant: t1
+    expect: deny
+  - name: bob after expiry
+    request:
+      subject: bob
+      method: GET
+      path: /providers/seca.compute/v1/tenants/t1/workspaces/w1/instances/i1
+    at: "2026-02-01T00:00:00Z"
+    expect: allow
+`
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var p Policy
+	if err := p.Load(strings.NewReader(policyYAML)); err != nil {
+		t.Fatalf("load policy: %v", err)
+	}
+	if len(p.Roles) != 1 || len(p.Assignments) != 2 {
+		t.Fatalf("loaded %d roles and %d assignments, want 1 and 2", len(p.Roles), len(p.Assignments))
+	}
+	suite, err := LoadSuite(strings.NewReader(casesYAML))
+	if err != nil {
+		t.Fatalf("load cases: %v", err)
+	}
+
+	results := Run(&p, suite, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	var out bytes.Buffer
+	if failed := Report(&out, results); failed != 1 {
+		t.Errorf("failed = %d, want 1\n%s", failed, out.String())
+	}
+	if !results[0].Explanation.Allowed || results[0].Explanation.Assignment != "alice-viewer" {
+		t.Errorf("case 0: %+v, want allowed by alice-viewer", results[0].Explanation)
+	}
+	if results[1].Explanation.Allowed {
+		t.Errorf("case 1: allowed, want denied")
+	}
+	if !results[2].Failed() || !strings.Contains(results[2].Explanation.Reason, "validity window") {
+		t.Errorf("case 2: %+v, want a failed case denied by the validity window", results[2].Explanation)
+	}
+}
+
+func TestLoadPolicy_RejectsOtherKinds(t *testing.T) {
+	t.Parallel()
+
+	var p Policy
+	err := p.Load(strings.NewReader("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: x\n"))
+	if err == nil || !strings.Contains(err.Error(), `unsupported kind "ConfigMap"`) {
+		t.Errorf("Load() error = %v, want unsupported kind", err)
+	}
+}
+
+func TestLoadSuite_RequiresOneOfClaimAndRequest(t *testing.T) {
+	t.Parallel()
+
+	_, err := LoadSuite(strings.NewReader("cases:\n  - name: empty\n    expect: allow\n"))
+	if err == nil {
+		t.Error("LoadSuite() accepted a case without claim or request")
+	}
+}
+
+func TestClaimFromRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method, path                     string
+		wantResource, wantName, wantVerb string
+		wantWorkspace                    string
+	}{
+		{"GET", "/providers/seca.compute/v1/tenants/t1/workspaces/w1/instances", "instances", "", "list", "w1"},
+		{"GET", "/providers/seca.compute/v1/tenants/t1/workspaces/w1/instances/i1", "instances", "i1", "get", "w1"},
+		{"PUT", "/providers/seca.authorization/v1/tenants/t1/roles/r1", "roles", "r1", "put", ""},
+		{"POST", "/providers/seca.compute/v1/tenants/t1/workspaces/w1/instances/i1/start", "instances", "i1", "post.start", "w1"},
+		{"DELETE", "/providers/seca.network/v1/tenants/t1/workspaces/w1/networks/n1/subnets/s1", "networks/subnets", "s1", "delete", "w1"},
+		{"GET", "/providers/seca.network/v1/tenants/t1/workspaces/w1/networks/n1/subnets", "networks/subnets", "", "list", "w1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			t.Parallel()
+			claim, err := claimFromRequest(tc.method, tc.path)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claim.Tenant != "t1" || claim.Workspace != tc.wantWorkspace {
+				t.Errorf("tenant/workspace = %q/%q, want t1/%q", claim.Tenant, claim.Workspace, tc.wantWorkspace)
+			}
+			if claim.Resource != tc.wantResource || claim.Name != tc.wantName || claim.Verb != tc.wantVerb {
+				t.Errorf("claim = %s %s/%s, want %s %s/%s",
+					claim.Verb, claim.Resource, claim.Name, tc.wantVerb, tc.wantResource, tc.wantName)
+			}
+		})
+	}
+
+	if _, err := claimFromRequest("GET", "/healthz"); err == nil {
+		t.Error("expected an error for a non-provider path")
+	}
+}
@@ -0,0 +1,28 @@
+package leader
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Flags holds the parsed command-line values for leader election.
+// Use RegisterFlags to bind these to a cobra command.
+type Flags struct {
+	// Enabled runs the singleton controllers on the elected replica only. Disabled, every
+	// replica runs them, which is only safe with a single replica.
+	Enabled bool
+	// LeaseName names the Lease the replicas compete for.
+	LeaseName string
+	// Namespace holds the Lease. Empty uses the namespace of the pod, or "default" outside
+	// a cluster.
+	Namespace string
+}
+
+// RegisterFlags adds leader-election flags to the given cobra command.
+func RegisterFlags(cmd *cobra.Command, f *Flags, leaseName string) {
+	cmd.Flags().BoolVar(&f.Enabled, "leader-elect", true,
+		"Run the status controllers on one elected replica only; disable only with a single replica")
+	cmd.Flags().StringVar(&f.LeaseName, "leader-election-id", leaseName,
+		"Name of the Lease the replicas elect a leader with")
+	cmd.Flags().StringVar(&f.Namespace, "leader-election-namespace", "",
+		"Namespace of the leader-election Lease (default: the pod namespace)")
+}
@@ -0,0 +1,111 @@
+// Package leader runs the gateway's singleton controllers on one replica at a time.
+//
+// The API servers scale out freely, but a controller that writes status must not run on
+// every replica: the replicas would race on the same objects and multiply the writes. The
+// replicas elect a leader with a coordination.k8s.io Lease, and only the leader runs them.
+package leader
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	leaseDuration = 15 * time.Second
+	renewDeadline = 10 * time.Second
+	retryPeriod   = 2 * time.Second
+)
+
+// inClusterNamespaceFile holds the namespace of the pod, mounted with its service account token.
+const inClusterNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// Run starts, in the background, the election of the replica that runs start, and returns.
+// start is called with a context cancelled when the replica loses the lead, and must not
+// block; the replica then competes again until ctx is done. A replica whose start fails
+// releases the lead, so that another one takes it over, and competes again after a retry
+// period. With the election disabled, start runs right away with ctx.
+func Run(
+	ctx context.Context,
+	f *Flags,
+	clientset kubernetes.Interface,
+	logger *slog.Logger,
+	start func(ctx context.Context) error,
+) error {
+	if !f.Enabled {
+		return start(ctx)
+	}
+
+	namespace := f.Namespace
+	if namespace == "" {
+		namespace = "default"
+		if raw, err := os.ReadFile(inClusterNamespaceFile); err == nil {
+			namespace = strings.TrimSpace(string(raw))
+		}
+	}
+	identity, err := os.Hostname()
+	if err != nil {
+		return fmt.Errorf("failed to name the replica: %w", err)
+	}
+	lock, err := resourcelock.New(resourcelock.LeasesResourceLock, namespace, f.LeaseName,
+		clientset.CoreV1(), clientset.CoordinationV1(), resourcelock.ResourceLockConfig{Identity: identity})
+	if err != nil {
+		return fmt.Errorf("failed to create leader-election lock: %w", err)
+	}
+	logger = logger.With("lease", namespace+"/"+f.LeaseName, "identity", identity)
+
+	// newElector returns the elector of one round of the election; abdicate ends the round,
+	// releasing the lead if held.
+	newElector := func(abdicate context.CancelFunc) (*leaderelection.LeaderElector, error) {
+		return leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			LeaseDuration:   leaseDuration,
+			RenewDeadline:   renewDeadline,
+			RetryPeriod:     retryPeriod,
+			ReleaseOnCancel: true,
+			Name:            f.LeaseName,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(leading context.Context) {
+					logger.Info("leader: acquired the lead, starting controllers")
+					if err := start(leading); err != nil {
+						logger.Error("leader: controllers failed to start, releasing the lead", slog.Any("error", err))
+						abdicate()
+					}
+				},
+				OnStoppedLeading: func() {
+					logger.Info("leader: lost the lead, controllers stopped")
+				},
+			},
+		})
+	}
+	if _, err := newElector(func() {}); err != nil {
+		return fmt.Errorf("failed to create leader elector: %w", err)
+	}
+
+	go func() {
+		// Run returns when the lead is lost or released; compete again until ctx is done.
+		for ctx.Err() == nil {
+			round, abdicate := context.WithCancel(ctx)
+			// The configuration was checked above, so this cannot fail.
+			elector, _ := newElector(abdicate)
+			elector.Run(round)
+			released := round.Err() != nil
+			abdicate()
+			if released && ctx.Err() == nil {
+				// The controllers failed to start here; leave the others a chance first.
+				select {
+				case <-ctx.Done():
+				case <-time.After(retryPeriod):
+				}
+			}
+		}
+	}()
+	return nil
+}
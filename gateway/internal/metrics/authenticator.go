@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
+)
+
+// InstrumentedAuthenticator wraps an authnport.Authenticator and records each
+// Authenticate call into the ecp_gateway_authn_duration_seconds histogram, labelled
+// with the authenticator's name and the outcome: "authenticated", "rejected"
+// (credential failure) or "error" (technical failure, answered with 500). The
+// histogram's _count series doubles as the per-authenticator outcome counter.
+type InstrumentedAuthenticator struct {
+	inner authnport.Authenticator
+	name  string
+}
+
+// NewInstrumentedAuthenticator returns an InstrumentedAuthenticator that wraps inner
+// and labels observations with name.
+func NewInstrumentedAuthenticator(inner authnport.Authenticator, name string) *InstrumentedAuthenticator {
+	return &InstrumentedAuthenticator{inner: inner, name: name}
+}
+
+// Authenticate implements authnport.Authenticator; times the call and records it.
+func (a *InstrumentedAuthenticator) Authenticate(ctx context.Context, token string) (*authnport.Identity, error) {
+	start := time.Now()
+	id, err := a.inner.Authenticate(ctx, token)
+	ObserveAuthn(a.name, authnResult(err), time.Since(start))
+	return id, err
+}
+
+// authnResult classifies an Authenticate error the way the authentication middleware does.
+func authnResult(err error) string {
+	switch {
+	case err == nil:
+		return "authenticated"
+	case errors.Is(err, kernel.ErrInternal) || errors.Is(err, kernel.ErrUnavailable):
+		return "error"
+	default:
+		return "rejected"
+	}
+}
@@ -1,6 +1,6 @@
 // Package metrics provides Prometheus instrumentation for the ECP gateway.
 //
-// Three histograms are registered on the default registry so that the standard
+// Four histograms are registered on the default registry so that the standard
 // go_* and process_* collectors are also exported — useful for comparing memory
 // and CPU overhead between the direct and cached RBAC checker implementations.
 //
@@ -11,6 +11,8 @@
 //   - ecp_gateway_rbac_fetch_duration_seconds{impl} — latency of the RBAC data
 //     fetch (Kubernetes List for the direct checker; informer cache read for cached).
 //     Metric (c).
+//   - ecp_gateway_authn_duration_seconds{authenticator,result} — latency and outcome
+//     of a single Authenticator.Authenticate call, per authenticator of the chain.
 //
 // Buckets span ≈50µs–3s (18 exponential steps, factor 2) to resolve both the
 // sub-millisecond cached path and the multi-millisecond direct path in detail.
@@ -46,6 +48,12 @@ var (
 		Help:    "Latency of the RBAC data fetch inside the checker (List or cache read).",
 		Buckets: buckets,
 	}, []string{"impl"})
+
+	authnDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ecp_gateway_authn_duration_seconds",
+		Help:    "Latency of a single Authenticator.Authenticate call, by authenticator and result.",
+		Buckets: buckets,
+	}, []string{"authenticator", "result"})
 )
 
 // Handler returns the standard Prometheus metrics HTTP handler.
@@ -78,3 +86,10 @@ func ObserveAuthzCheck(impl string, d time.Duration) {
 func ObserveRBACFetch(impl string, d time.Duration) {
 	rbacFetchDuration.WithLabelValues(impl).Observe(d.Seconds())
 }
+
+// ObserveAuthn records the duration and outcome of a single Authenticate call.
+// Call with the authenticator's name, the result label ("authenticated", "rejected"
+// or "error") and the elapsed duration.
+func ObserveAuthn(authenticator, result string, d time.Duration) {
+	authnDuration.WithLabelValues(authenticator, result).Observe(d.Seconds())
+}
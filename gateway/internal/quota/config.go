@@ -0,0 +1,19 @@
+package quota
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Flags holds the parsed command-line values for quotas.
+// Use RegisterFlags to bind these to a cobra command.
+type Flags struct {
+	// Enabled checks every create and update against the Quota resources and serves the
+	// quota and usage routes.
+	Enabled bool
+}
+
+// RegisterFlags adds quota flags to the given cobra command.
+func RegisterFlags(cmd *cobra.Command, f *Flags) {
+	cmd.Flags().BoolVar(&f.Enabled, "quotas", false,
+		"Enforce the tenants' and workspaces' Quota resources and serve the quota and usage routes (disabled by default)")
+}
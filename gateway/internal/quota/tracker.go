@@ -0,0 +1,418 @@
+// Package quota enforces the Quota resources of the workspace provider at the regional
+// gateway.
+//
+// The Tracker watches every counted resource kind, the quotas and the instance SKUs, and
+// derives the usage of a tenant or workspace from its informer caches. As an
+// admissionport.Reviewer it rejects a create or update that would exceed a quota governing
+// the written scope with kernel.KindForbidden (HTTP 403) before the write is persisted.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+	instancek8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance/backend/kubernetes"
+	computeskuk8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/sku/backend/kubernetes"
+	internetgatewayk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway/backend/kubernetes"
+	netk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network/backend/kubernetes"
+	nick8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic/backend/kubernetes"
+	publicipk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/public-ip/backend/kubernetes"
+	securitygrouprulek8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group-rule/backend/kubernetes"
+	securitygroupk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group/backend/kubernetes"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+	bsk8s "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage/backend/kubernetes"
+	imgk8s "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/image/backend/kubernetes"
+	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
+	wsk8s "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/backend/kubernetes"
+	quotadom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota"
+	quotak8s "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota/backend/kubernetes"
+)
+
+const (
+	// resync is the period after which the informers re-list their resources.
+	resync = 10 * time.Minute
+	// reservationTTL bounds how long an admitted write is counted before the informers
+	// see it. It covers the write itself and the watch latency after it.
+	reservationTTL = time.Minute
+)
+
+// countedGVRs maps each quotadom.CountedResources kind to the resource holding it.
+var countedGVRs = map[string]schema.GroupVersionResource{
+	wsdom.Resource:                                 wsk8s.WorkspaceGVR,
+	instancedom.Resource:                           instancek8s.InstanceGVR,
+	bsdom.Resource:                                 bsk8s.BlockStorageGVR,
+	imgk8s.ImageResource:                           imgk8s.ImageGVR,
+	netk8s.NetworkResource:                         netk8s.NetworkGVR,
+	nick8s.NICResource:                             nick8s.NICGVR,
+	publicipk8s.PublicIPResource:                   publicipk8s.PublicIPGVR,
+	internetgatewayk8s.InternetGatewayResource:     internetgatewayk8s.InternetGatewayGVR,
+	securitygroupk8s.SecurityGroupResource:         securitygroupk8s.SecurityGroupGVR,
+	securitygrouprulek8s.SecurityGroupRuleResource: securitygrouprulek8s.SecurityGroupRuleGVR,
+}
+
+// key identifies one counted object.
+type key struct {
+	resource, tenant, workspace, name string
+}
+
+// reservation counts an admitted write until the informers see it or it expires.
+type reservation struct {
+	usage   quotadom.Usage
+	expires time.Time
+}
+
+// Tracker is the quota implementation of admissionport.Reviewer.
+//
+// Usage is read from the informer caches, which lag behind the writes they count. To keep
+// concurrent writes from slipping past a limit together, Review checks and reserves under
+// the lock of the written tenant: an admitted write counts at its new usage for
+// reservationTTL, or for as long as its cached object counts less. Writes in different
+// tenants never wait for each other. Writes that fail after admission hold their reservation
+// until it expires. Reservations are local to the gateway replica, so several replicas
+// admitting writes at the same moment may together overshoot a limit by up to one write
+// each.
+//
+// Lifecycle: call Start once at server startup. Until the informers have synced, Review
+// and Usage return an internal error.
+type Tracker struct {
+	factory dynamicinformer.DynamicSharedInformerFactory
+	counted map[string]informers.GenericInformer
+	quotas  informers.GenericInformer
+	skus    informers.GenericInformer
+	log     *slog.Logger
+	now     func() time.Time
+	// mu guards tenants only; each tenant's reservations have their own lock.
+	mu      sync.Mutex
+	tenants map[string]*tenantReservations
+}
+
+// tenantReservations holds the reservations of one tenant under its own lock.
+type tenantReservations struct {
+	mu       sync.Mutex
+	reserved map[key]reservation
+}
+
+var _ admissionport.Reviewer = (*Tracker)(nil)
+
+// NewTracker watches the counted resources, the quotas and the instance SKUs through
+// dynClient.
+func NewTracker(dynClient dynamic.Interface, log *slog.Logger) *Tracker {
+	t := &Tracker{
+		factory: dynamicinformer.NewDynamicSharedInformerFactory(dynClient, resync),
+		counted: make(map[string]informers.GenericInformer, len(countedGVRs)),
+		log:     log,
+		now:     time.Now,
+		tenants: map[string]*tenantReservations{},
+	}
+	for kind, gvr := range countedGVRs {
+		t.counted[kind] = t.factory.ForResource(gvr)
+	}
+	t.quotas = t.factory.ForResource(quotak8s.QuotaGVR)
+	t.skus = t.factory.ForResource(computeskuk8s.InstanceSKUGVR)
+	return t
+}
+
+// Start starts the informers and blocks until their caches are synced. Returns an error if
+// the context is cancelled before sync completes.
+func (t *Tracker) Start(ctx context.Context) error {
+	t.log.Info("quota: starting usage watch", slog.Int("resources", len(t.counted)))
+	t.factory.Start(ctx.Done())
+	for gvr, synced := range t.factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("informer cache sync timed out for %s", gvr.Resource)
+		}
+	}
+	return nil
+}
+
+// synced reports whether every informer has synced.
+func (t *Tracker) synced() bool {
+	for _, inf := range t.counted {
+		if !inf.Informer().HasSynced() {
+			return false
+		}
+	}
+	return t.quotas.Informer().HasSynced() && t.skus.Informer().HasSynced()
+}
+
+// Review implements admissionport.Reviewer. Writes of kinds no quota can count are
+// admitted without a lookup.
+func (t *Tracker) Review(ctx context.Context, req admissionport.Request) error {
+	if _, ok := countedGVRs[req.Resource]; !ok {
+		return nil
+	}
+	if !t.synced() {
+		return kernel.NewError(kernel.KindInternal, fmt.Errorf("quota usage is not synced"))
+	}
+	scope := req.Scope
+	if req.Resource == wsdom.Resource {
+		// A workspace is held by its tenant, not by itself.
+		scope.Workspace = ""
+	}
+	k := key{resource: req.Resource, tenant: scope.Tenant, workspace: scope.Workspace, name: req.Name}
+	next := t.objectUsage(req.Resource, scope.Tenant, req.Object)
+
+	tenant := t.tenant(scope.Tenant)
+	tenant.mu.Lock()
+	defer tenant.mu.Unlock()
+	held := t.held(scope.Tenant, tenant)
+	growth := held[k].Growth(next)
+	if len(growth) == 0 {
+		return nil
+	}
+
+	var reasons []string
+	tenantUsed := sum(held, func(key) bool { return true })
+	for _, q := range t.quotasOf(resource.Scope{Tenant: scope.Tenant}) {
+		reasons = appendExceeded(reasons, q, tenantUsed, growth)
+	}
+	if scope.Workspace != "" {
+		workspaceUsed := sum(held, func(o key) bool { return o.workspace == scope.Workspace })
+		for _, q := range t.quotasOf(scope) {
+			reasons = appendExceeded(reasons, q, workspaceUsed, growth)
+		}
+	}
+	if len(reasons) > 0 {
+		t.log.InfoContext(ctx, "quota: write rejected", slog.String("tenant", scope.Tenant),
+			slog.String("workspace", scope.Workspace), slog.String("resource", req.Resource),
+			slog.String("name", req.Name), slog.String("reason", strings.Join(reasons, "; ")))
+		return kernel.NewError(kernel.KindForbidden,
+			fmt.Errorf("%s %s exceeds quota: %s", req.Resource, req.Name, strings.Join(reasons, "; ")))
+	}
+	tenant.reserved[k] = reservation{usage: next, expires: t.now().Add(reservationTTL)}
+	return nil
+}
+
+// Usage returns the usage of scope against the quotas governing it: the tenant quotas and,
+// for a workspace, its own quotas.
+func (t *Tracker) Usage(_ context.Context, scope resource.Scope) (*quotadom.UsageReport, error) {
+	if !t.synced() {
+		return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("quota usage is not synced"))
+	}
+	tenant := t.tenant(scope.Tenant)
+	tenant.mu.Lock()
+	defer tenant.mu.Unlock()
+	held := t.held(scope.Tenant, tenant)
+	tenantUsed := sum(held, func(key) bool { return true })
+	used := tenantUsed
+	if scope.Workspace != "" {
+		used = sum(held, func(o key) bool { return o.workspace == scope.Workspace })
+	}
+
+	var quotas []quotadom.QuotaUsage
+	for _, q := range t.quotasOf(resource.Scope{Tenant: scope.Tenant}) {
+		quotas = append(quotas, quotaUsage(q, tenantUsed))
+	}
+	if scope.Workspace != "" {
+		for _, q := range t.quotasOf(scope) {
+			quotas = append(quotas, quotaUsage(q, used))
+		}
+	}
+	return quotadom.NewUsageReport(used, quotas), nil
+}
+
+// tenant returns the reservations of tenant, creating them on first use.
+func (t *Tracker) tenant(name string) *tenantReservations {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	r, ok := t.tenants[name]
+	if !ok {
+		r = &tenantReservations{reserved: map[key]reservation{}}
+		t.tenants[name] = r
+	}
+	return r
+}
+
+// held returns the usage of every counted object of tenant, from the informer caches and
+// its live reservations. An object both cached and reserved counts the larger of the two
+// for each limit. Expired reservations are dropped. The caller holds reservations.mu.
+func (t *Tracker) held(tenant string, reservations *tenantReservations) map[key]quotadom.Usage {
+	selector := labels.SelectorFromSet(labels.Set{k8slabels.InternalTenantLabel: tenant})
+	held := map[key]quotadom.Usage{}
+	for kind, inf := range t.counted {
+		objs, err := inf.Lister().List(selector)
+		if err != nil {
+			t.log.Error("quota: list from cache", slog.String("resource", kind), slog.Any("error", err))
+			continue
+		}
+		for _, obj := range objs {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				t.log.Error("quota: unexpected object type in informer cache", slog.String("type", fmt.Sprintf("%T", obj)))
+				continue
+			}
+			workspace := u.GetLabels()[k8slabels.InternalWorkspaceLabel]
+			if kind == wsdom.Resource {
+				workspace = ""
+			}
+			held[key{resource: kind, tenant: tenant, workspace: workspace, name: u.GetName()}] = t.cachedUsage(kind, tenant, u)
+		}
+	}
+	now := t.now()
+	for k, r := range reservations.reserved {
+		if now.After(r.expires) {
+			delete(reservations.reserved, k)
+			continue
+		}
+		held[k] = held[k].Add(held[k].Growth(r.usage))
+	}
+	return held
+}
+
+// cachedUsage returns the usage of a cached object of kind. An instance is counted from its
+// SKU reference alone, read off the object: converting it would open its sealed spec, a
+// KMS call per instance on every review.
+func (t *Tracker) cachedUsage(kind, tenant string, u *unstructured.Unstructured) quotadom.Usage {
+	switch kind {
+	case instancedom.Resource:
+		skuRef, _, err := unstructured.NestedString(u.Object, "spec", "skuRef", "resource")
+		if err != nil {
+			t.log.Error("quota: read instance SKU reference", slog.String("name", u.GetName()), slog.Any("error", err))
+		}
+		return t.instanceUsage(tenant, skuRef)
+	case bsdom.Resource:
+		bs, err := bsk8s.BlockStorageFromCR(u)
+		if err != nil {
+			// Still count the object itself; only its size is unknown.
+			t.log.Error("quota: convert cached object", slog.String("resource", kind),
+				slog.String("name", u.GetName()), slog.Any("error", err))
+		}
+		return t.objectUsage(kind, tenant, bs)
+	}
+	return t.objectUsage(kind, tenant, nil)
+}
+
+// objectUsage returns the usage of one object of kind: one of its kind, plus its size for
+// block storages and the vCPU and RAM of its SKU for instances. An instance whose SKU is
+// unknown counts no vCPU or RAM.
+func (t *Tracker) objectUsage(kind, tenant string, obj any) quotadom.Usage {
+	usage := quotadom.Usage{kind: 1}
+	switch o := obj.(type) {
+	case *bsdom.BlockStorage:
+		if o != nil {
+			usage[quotadom.LimitBlockStorageGB] = o.Spec.SizeGB
+		}
+	case *instancedom.Instance:
+		if o != nil {
+			return t.instanceUsage(tenant, o.Spec.SkuRef.Resource)
+		}
+	}
+	return usage
+}
+
+// instanceUsage returns the usage of one instance of the SKU skuRef refers to: one instance
+// plus the vCPU and RAM of its SKU, or no vCPU or RAM when the SKU is unknown.
+func (t *Tracker) instanceUsage(tenant, skuRef string) quotadom.Usage {
+	usage := quotadom.Usage{instancedom.Resource: 1}
+	name := lastSegment(skuRef)
+	cached, err := t.skus.Lister().ByNamespace(k8sadapter.ComputeNamespace(resource.Scope{Tenant: tenant})).Get(name)
+	if err != nil {
+		t.log.Debug("quota: instance SKU not found", slog.String("sku", name), slog.Any("error", err))
+		return usage
+	}
+	u, ok := cached.(*unstructured.Unstructured)
+	if !ok {
+		return usage
+	}
+	sku, err := computeskuk8s.InstanceSKUFromCR(u)
+	if err != nil {
+		t.log.Error("quota: convert instance SKU", slog.String("sku", name), slog.Any("error", err))
+		return usage
+	}
+	usage[quotadom.LimitVCPU] = sku.Spec.VCPU
+	usage[quotadom.LimitRam] = sku.Spec.Ram
+	return usage
+}
+
+// quotasOf returns the quotas set on exactly scope: the tenant quotas for a tenant scope,
+// the workspace quotas for a workspace scope.
+func (t *Tracker) quotasOf(scope resource.Scope) []*quotadom.Quota {
+	objs, err := t.quotas.Lister().ByNamespace(k8sadapter.ComputeNamespace(scope)).List(labels.Everything())
+	if err != nil {
+		t.log.Error("quota: list quotas from cache", slog.Any("error", err))
+		return nil
+	}
+	quotas := make([]*quotadom.Quota, 0, len(objs))
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		q, err := quotak8s.QuotaFromCR(u)
+		if err != nil {
+			t.log.Error("quota: convert quota", slog.String("name", u.GetName()), slog.Any("error", err))
+			continue
+		}
+		quotas = append(quotas, q)
+	}
+	return quotas
+}
+
+// appendExceeded appends a reason for every limit of q that growth would exceed.
+func appendExceeded(reasons []string, q *quotadom.Quota, used, growth quotadom.Usage) []string {
+	excess := q.Spec.Exceeded(used, growth)
+	if len(excess) == 0 {
+		return reasons
+	}
+	limits := make([]string, len(excess))
+	for i, e := range excess {
+		limits[i] = fmt.Sprintf("%s limit %d, used %d, requested %d, remaining %d",
+			e.Limit, e.Max, e.Used, e.Requested, e.Remaining())
+	}
+	return append(reasons, fmt.Sprintf("quota %s exceeded: %s", quotaRef(q), strings.Join(limits, ", ")))
+}
+
+// quotaRef names q with its workspace, if any.
+func quotaRef(q *quotadom.Quota) string {
+	if q.Workspace == "" {
+		return q.Name
+	}
+	return q.Workspace + "/" + q.Name
+}
+
+// quotaUsage reports q against the usage of its scope.
+func quotaUsage(q *quotadom.Quota, used quotadom.Usage) quotadom.QuotaUsage {
+	return quotadom.QuotaUsage{
+		Name:      q.Name,
+		Workspace: q.Workspace,
+		Limits:    q.Spec.Limits,
+		Used:      used,
+		Remaining: q.Spec.Remaining(used),
+	}
+}
+
+// sum adds up the usage of the objects matching keep.
+func sum(held map[key]quotadom.Usage, keep func(key) bool) quotadom.Usage {
+	total := quotadom.Usage{}
+	for k, usage := range held {
+		if keep(k) {
+			total = total.Add(usage)
+		}
+	}
+	return total
+}
+
+// lastSegment returns the name at the end of a reference path such as "skus/m1.small".
+func lastSegment(ref string) string {
+	if i := strings.LastIndex(ref, "/"); i != -1 {
+		return ref[i+1:]
+	}
+	return ref
+}
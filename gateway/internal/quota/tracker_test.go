@@ -0,0 +1,218 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+	instancek8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance/backend/kubernetes"
+	computeskudom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/sku"
+	computeskuk8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/sku/backend/kubernetes"
+	publicipk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/public-ip/backend/kubernetes"
+	routetablek8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/route-table/backend/kubernetes"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+	bsk8s "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage/backend/kubernetes"
+	quotadom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota"
+	quotak8s "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota/backend/kubernetes"
+)
+
+func TestCountedGVRs(t *testing.T) {
+	t.Parallel()
+
+	for _, kind := range quotadom.CountedResources {
+		if gvr, ok := countedGVRs[kind]; !ok || gvr.Resource != kind {
+			t.Errorf("countedGVRs[%q] = %v, %v; want the %s resource", kind, gvr, ok, kind)
+		}
+	}
+	if len(countedGVRs) != len(quotadom.CountedResources) {
+		t.Errorf("countedGVRs has %d kinds; want the %d of quotadom.CountedResources", len(countedGVRs), len(quotadom.CountedResources))
+	}
+}
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	listKinds := map[schema.GroupVersionResource]string{
+		quotak8s.QuotaGVR:            "QuotaList",
+		computeskuk8s.InstanceSKUGVR: "InstanceSKUList",
+	}
+	for kind, gvr := range countedGVRs {
+		listKinds[gvr] = kind + "-list"
+	}
+	dynClient := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds)
+	create := func(gvr schema.GroupVersionResource, obj client.Object, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("convert %s: %v", gvr.Resource, err)
+		}
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			t.Fatalf("convert %s to unstructured: %v", gvr.Resource, err)
+		}
+		u := &unstructured.Unstructured{Object: content}
+		if _, err := dynClient.Resource(gvr).Namespace(u.GetNamespace()).Create(ctx, u, metav1.CreateOptions{}); err != nil {
+			t.Fatalf("create %s %s: %v", gvr.Resource, u.GetName(), err)
+		}
+	}
+	createLabelled := func(gvr schema.GroupVersionResource, workspace, name string) {
+		t.Helper()
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion(gvr.GroupVersion().String())
+		u.SetKind("Object")
+		u.SetName(name)
+		u.SetNamespace(k8sadapter.ComputeNamespace(resource.Scope{Tenant: "acme", Workspace: workspace}))
+		u.SetLabels(map[string]string{k8slabels.InternalTenantLabel: "acme", k8slabels.InternalWorkspaceLabel: workspace})
+		create(gvr, u, nil)
+	}
+
+	sku := &computeskudom.InstanceSKU{Spec: computeskudom.InstanceSKUSpec{VCPU: 2, Ram: 4}}
+	sku.Name = "m.small"
+	skuCR, err := computeskuk8s.InstanceSKUToCR(sku)
+	if err == nil {
+		skuCR.SetNamespace(k8sadapter.ComputeNamespace(resource.Scope{Tenant: "acme"}))
+	}
+	create(computeskuk8s.InstanceSKUGVR, skuCR, err)
+	for _, inst := range []*instancedom.Instance{newInstance("dev", "web-1"), newInstance("prod", "api-1")} {
+		cr, err := instancek8s.InstanceToCR(nil)(inst)
+		create(instancek8s.InstanceGVR, cr, err)
+	}
+	cr, err := bsk8s.BlockStorageToCR(newBlockStorage("dev", "disk-1", 50))
+	create(bsk8s.BlockStorageGVR, cr, err)
+	createLabelled(publicipk8s.PublicIPGVR, "dev", "ip-1")
+	cr, err = quotak8s.QuotaToCR(newQuota("", "default", map[string]int{
+		instancedom.Resource: 3, quotadom.LimitVCPU: 6, quotadom.LimitBlockStorageGB: 100,
+	}))
+	create(quotak8s.QuotaGVR, cr, err)
+	cr, err = quotak8s.QuotaToCR(newQuota("dev", "dev", map[string]int{publicipk8s.PublicIPResource: 1}))
+	create(quotak8s.QuotaGVR, cr, err)
+
+	tracker := NewTracker(dynClient, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	review := func(kind, workspace, name string, obj any) error {
+		return tracker.Review(ctx, admissionport.Request{
+			Resource: kind, Scope: resource.Scope{Tenant: "acme", Workspace: workspace}, Name: name, Object: obj,
+		})
+	}
+	if err := review(instancedom.Resource, "dev", "web-2", newInstance("dev", "web-2")); !errors.Is(err, kernel.ErrInternal) {
+		t.Errorf("before sync: Review() = %v; want an internal error", err)
+	}
+	if err := tracker.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	if err := review(instancedom.Resource, "dev", "web-2", newInstance("dev", "web-2")); err != nil {
+		t.Errorf("third instance: Review() = %v; want admitted up to the limit", err)
+	}
+	// web-2 is reserved although the informers have not seen it.
+	err = review(instancedom.Resource, "prod", "api-2", newInstance("prod", "api-2"))
+	if !errors.Is(err, kernel.ErrForbidden) {
+		t.Fatalf("fourth instance: Review() = %v; want forbidden", err)
+	}
+	for _, want := range []string{"quota default exceeded", "instances limit 3, used 3, requested 1, remaining 0", "vcpu limit 6, used 6, requested 2, remaining 0"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("fourth instance: error %q does not contain %q", err, want)
+		}
+	}
+	if err := review(instancedom.Resource, "dev", "web-1", newInstance("dev", "web-1")); err != nil {
+		t.Errorf("unchanged instance: Review() = %v; want admitted", err)
+	}
+
+	if err := review(publicipk8s.PublicIPResource, "dev", "ip-2", nil); !errors.Is(err, kernel.ErrForbidden) || !strings.Contains(err.Error(), "quota dev/dev exceeded") {
+		t.Errorf("second dev public IP: Review() = %v; want forbidden by the dev quota", err)
+	}
+	if err := review(publicipk8s.PublicIPResource, "prod", "ip-2", nil); err != nil {
+		t.Errorf("prod public IP: Review() = %v; want admitted", err)
+	}
+	if err := review(bsdom.Resource, "prod", "disk-2", newBlockStorage("prod", "disk-2", 60)); !errors.Is(err, kernel.ErrForbidden) {
+		t.Errorf("oversized block storage: Review() = %v; want forbidden", err)
+	}
+	if err := review(bsdom.Resource, "dev", "disk-1", newBlockStorage("dev", "disk-1", 40)); err != nil {
+		t.Errorf("shrunk block storage: Review() = %v; want admitted", err)
+	}
+	if err := review(routetablek8s.RouteTableResource, "dev", "rt-1", nil); err != nil {
+		t.Errorf("uncounted kind: Review() = %v; want admitted", err)
+	}
+
+	report, err := tracker.Usage(ctx, resource.Scope{Tenant: "acme", Workspace: "dev"})
+	if err != nil {
+		t.Fatalf("Usage() error = %v", err)
+	}
+	if got := report.Used[instancedom.Resource]; got != 2 {
+		t.Errorf("dev usage: instances = %d; want 2 (web-1 and the reserved web-2)", got)
+	}
+	if got := report.Used[quotadom.LimitRam]; got != 8 {
+		t.Errorf("dev usage: ram = %d; want 8", got)
+	}
+	if names := quotaNames(report.Quotas); !slices.Equal(names, []string{"default", "dev"}) {
+		t.Errorf("dev usage: quotas = %v; want [default dev]", names)
+	}
+	if got := report.Remaining; got[instancedom.Resource] != 0 || got[publicipk8s.PublicIPResource] != 0 || got[quotadom.LimitBlockStorageGB] != 50 {
+		t.Errorf("dev usage: remaining = %v; want no instances, no public IPs and 50 GB", got)
+	}
+
+	// Reservations lapse; only the objects the informers saw are left.
+	tracker.mu.Lock()
+	tracker.now = func() time.Time { return time.Now().Add(2 * reservationTTL) }
+	tracker.mu.Unlock()
+	report, err = tracker.Usage(ctx, resource.Scope{Tenant: "acme"})
+	if err != nil {
+		t.Fatalf("Usage() error = %v", err)
+	}
+	if got := report.Used[instancedom.Resource]; got != 2 {
+		t.Errorf("tenant usage after expiry: instances = %d; want 2", got)
+	}
+	if names := quotaNames(report.Quotas); !slices.Equal(names, []string{"default"}) {
+		t.Errorf("tenant usage: quotas = %v; want only the tenant quota", names)
+	}
+}
+
+func newInstance(workspace, name string) *instancedom.Instance {
+	inst := &instancedom.Instance{Spec: instancedom.InstanceSpec{SkuRef: commondomain.Reference{Resource: "skus/m.small"}}}
+	inst.Name = name
+	inst.Scope = resource.Scope{Tenant: "acme", Workspace: workspace}
+	return inst
+}
+
+func newBlockStorage(workspace, name string, sizeGB int) *bsdom.BlockStorage {
+	bs := &bsdom.BlockStorage{Spec: bsdom.BlockStorageSpec{SizeGB: sizeGB}}
+	bs.Name = name
+	bs.Scope = resource.Scope{Tenant: "acme", Workspace: workspace}
+	return bs
+}
+
+func newQuota(workspace, name string, limits map[string]int) *quotadom.Quota {
+	q := &quotadom.Quota{Spec: quotadom.QuotaSpec{Limits: limits}}
+	q.Name = name
+	q.Scope = resource.Scope{Tenant: "acme", Workspace: workspace}
+	return q
+}
+
+func quotaNames(quotas []quotadom.QuotaUsage) []string {
+	names := make([]string, len(quotas))
+	for i, q := range quotas {
+		names[i] = q.Name
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,19 @@
+package residency
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// Flags holds the parsed command-line values for residency policies.
+// Use RegisterFlags to bind these to a cobra command.
+type Flags struct {
+	// Enabled enforces the tenants' ResidencyPolicy resources: on the regional gateway
+	// every create and update is checked, on the global gateway region listings are filtered.
+	Enabled bool
+}
+
+// RegisterFlags adds residency-policy flags to the given cobra command.
+func RegisterFlags(cmd *cobra.Command, f *Flags) {
+	cmd.Flags().BoolVar(&f.Enabled, "residency-policies", false,
+		"Enforce the tenants' ResidencyPolicy resources (disabled by default)")
+}
@@ -0,0 +1,296 @@
+// Package residency enforces the ResidencyPolicy resources of the tenants.
+//
+// Policies watches the residency policies and the Region catalog through informers. On the
+// regional gateway it is an admissionport.Reviewer rejecting, with kernel.KindForbidden
+// (HTTP 403), every write that would place a tenant's data outside its policies. On the
+// global gateway it is the region handler's RegionFilter, hiding from a caller the regions
+// the policies of its tenants rule out.
+package residency
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	"github.com/eu-sovereign-cloud/ecp/framework/frontend/middleware"
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
+	rpk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy/backend/kubernetes"
+	rak8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment/backend/kubernetes"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+	internetgatewaydom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway"
+	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
+	nicdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic"
+	publicipdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/public-ip"
+	routetabledom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/route-table"
+	securitygroupdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group"
+	securitygroupruledom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group-rule"
+	subnetdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/subnet"
+	rdom "github.com/eu-sovereign-cloud/ecp/resource/region/v1"
+	regionk8s "github.com/eu-sovereign-cloud/ecp/resource/region/v1/backend/kubernetes"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+	imgdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/image"
+	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
+)
+
+const (
+	// resync is the period after which the informers re-list the policies and the catalog.
+	resync = 5 * time.Minute
+
+	// subjectIndex indexes the role assignments by the subjects they name.
+	subjectIndex = "subject"
+)
+
+// providers maps each resource kind written through the regional gateway to the provider
+// serving it, as named in the Region catalog.
+var providers = map[string]string{
+	wsdom.Resource:                "seca.workspace",
+	instancedom.Resource:          "seca.compute",
+	bsdom.Resource:                "seca.storage",
+	imgdom.Resource:               "seca.storage",
+	netdom.Resource:               "seca.network",
+	subnetdom.Resource:            "seca.network",
+	nicdom.Resource:               "seca.network",
+	publicipdom.Resource:          "seca.network",
+	internetgatewaydom.Resource:   "seca.network",
+	routetabledom.Resource:        "seca.network",
+	securitygroupdom.Resource:     "seca.network",
+	securitygroupruledom.Resource: "seca.network",
+}
+
+// Policies is the residency implementation of admissionport.Reviewer and of the region
+// handler's RegionFilter.
+//
+// Lifecycle: call Start once at server startup. Until the informers have synced, Review
+// and RegionVisible return an internal error: a gateway that cannot see the policies must
+// not skip them.
+type Policies struct {
+	// region is the region the regional gateway serves; empty on the global gateway.
+	region   string
+	factory  dynamicinformer.DynamicSharedInformerFactory
+	policies informers.GenericInformer
+	regions  informers.GenericInformer
+	// assignments, set by WatchAssignments, finds the tenants of a caller.
+	assignments informers.GenericInformer
+	log         *slog.Logger
+}
+
+var _ admissionport.Reviewer = (*Policies)(nil)
+
+// NewPolicies watches the residency policies and the Region catalog through dynClient.
+// region is the region the gateway serves, config.Singleton().Region() on a regional
+// gateway, and empty on the global one.
+func NewPolicies(dynClient dynamic.Interface, region string, log *slog.Logger) *Policies {
+	p := &Policies{region: region, log: log}
+	p.factory = dynamicinformer.NewDynamicSharedInformerFactory(dynClient, resync)
+	p.policies = p.factory.ForResource(rpk8s.ResidencyPolicyGVR)
+	p.regions = p.factory.ForResource(regionk8s.RegionGVR)
+	return p
+}
+
+// WatchAssignments makes RegionVisible look up the tenants of a caller whose token is scoped
+// to none in the role assignments naming its subject. Call it before Start, on the global
+// gateway.
+func (p *Policies) WatchAssignments() error {
+	p.assignments = p.factory.ForResource(rak8s.RoleAssignmentGVR)
+	// Indexers must be added before the informer starts.
+	if err := p.assignments.Informer().AddIndexers(cache.Indexers{subjectIndex: subjects}); err != nil {
+		return fmt.Errorf("add role assignment indexer: %w", err)
+	}
+	return nil
+}
+
+// subjects indexes a role assignment by the subjects it names, "*" included.
+func subjects(obj any) ([]string, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, nil
+	}
+	subs, _, err := unstructured.NestedStringSlice(u.Object, "spec", "subs")
+	return subs, err
+}
+
+// Start starts the informers and blocks until their caches are synced. Returns an error
+// if the context is cancelled before sync completes.
+func (p *Policies) Start(ctx context.Context) error {
+	p.log.Info("residency: starting residency policy watch", slog.String("region", p.region))
+	p.factory.Start(ctx.Done())
+	for gvr, synced := range p.factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("informer cache sync timed out for %s", gvr.Resource)
+		}
+	}
+	return nil
+}
+
+// Review implements admissionport.Reviewer. A write is checked against the served region,
+// the provider of its kind and, for instances and subnets, its zone. Every violation of
+// every policy of the tenant is reported at once.
+func (p *Policies) Review(ctx context.Context, req admissionport.Request) error {
+	policies, err := p.tenantPolicies(req.Scope.Tenant)
+	if err != nil || len(policies) == 0 {
+		return err
+	}
+	region := p.catalogRegion(p.region)
+	zone := zoneOf(req.Object)
+	var reasons []string
+	var sources []kernel.ErrorSource
+	for _, rp := range policies {
+		for _, v := range rp.Spec.Violations(region, providers[req.Resource], zone) {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", rp.Name, v))
+		}
+		if zone != "" && len(rp.Spec.Zones) > 0 && !slices.Contains(rp.Spec.Zones, zone) && len(sources) == 0 {
+			sources = append(sources, kernel.ErrorSource{Name: "/spec/zone", Value: zone})
+		}
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	p.log.InfoContext(ctx, "residency: write rejected", slog.String("tenant", req.Scope.Tenant),
+		slog.String("workspace", req.Scope.Workspace), slog.String("resource", req.Resource),
+		slog.String("name", req.Name), slog.String("region", region.Name))
+	return kernel.NewError(kernel.KindForbidden,
+		fmt.Errorf("%s %s violates residency policies: %s", req.Resource, req.Name, strings.Join(reasons, "; ")),
+		sources...)
+}
+
+// RegionVisible implements the region handler's RegionFilter. A region is visible when one of
+// the caller's tenants may use it; a caller of no tenant sees every region. See
+// callerTenants.
+func (p *Policies) RegionVisible(ctx context.Context, region *rdom.Region) (bool, error) {
+	tenants, err := p.callerTenants(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(tenants) == 0 {
+		return true, nil
+	}
+	for _, t := range tenants {
+		policies, err := p.tenantPolicies(t)
+		if err != nil {
+			return false, err
+		}
+		if allowsRegion(policies, region) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// callerTenants returns the tenants of the authenticated caller of ctx: the ones its token is
+// scoped to or, for a token scoped to none, the ones whose role assignments name its subject
+// or "*", once WatchAssignments is called.
+func (p *Policies) callerTenants(ctx context.Context) ([]string, error) {
+	id, ok := middleware.IdentityFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	if len(id.TokenScope.Tenants) > 0 || p.assignments == nil {
+		return id.TokenScope.Tenants, nil
+	}
+	if !p.assignments.Informer().HasSynced() {
+		return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("role assignments are not synced"))
+	}
+	var tenants []string
+	for _, sub := range []string{id.Subject, "*"} {
+		objs, err := p.assignments.Informer().GetIndexer().ByIndex(subjectIndex, sub)
+		if err != nil {
+			return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("look up role assignments: %w", err))
+		}
+		for _, obj := range objs {
+			u, ok := obj.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			ra, err := rak8s.RoleAssignmentFromCR(u)
+			if err != nil {
+				return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("convert role assignment %s: %w", u.GetName(), err))
+			}
+			if ra.Tenant != "" && !slices.Contains(tenants, ra.Tenant) {
+				tenants = append(tenants, ra.Tenant)
+			}
+		}
+	}
+	return tenants, nil
+}
+
+// tenantPolicies returns the residency policies of tenant from the informer cache, sorted
+// by name.
+func (p *Policies) tenantPolicies(tenant string) ([]*rpdom.ResidencyPolicy, error) {
+	if !p.policies.Informer().HasSynced() || !p.regions.Informer().HasSynced() {
+		return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("residency policies are not synced"))
+	}
+	namespace := k8sadapter.ComputeNamespace(resource.Scope{Tenant: tenant})
+	objs, err := p.policies.Lister().ByNamespace(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("list residency policies: %w", err))
+	}
+	policies := make([]*rpdom.ResidencyPolicy, 0, len(objs))
+	for _, obj := range objs {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		rp, err := rpk8s.ResidencyPolicyFromCR(u)
+		if err != nil {
+			// The policy's spec is unknown, so nothing can be shown to comply with it.
+			return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("convert residency policy %s: %w", u.GetName(), err))
+		}
+		policies = append(policies, rp)
+	}
+	slices.SortFunc(policies, func(a, b *rpdom.ResidencyPolicy) int { return strings.Compare(a.Name, b.Name) })
+	return policies, nil
+}
+
+// catalogRegion returns the Region catalog entry of name. A region missing from the
+// catalog is checked by name only.
+func (p *Policies) catalogRegion(name string) *rdom.Region {
+	region := &rdom.Region{}
+	region.Name = name
+	obj, err := p.regions.Lister().Get(name)
+	if err != nil {
+		return region
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return region
+	}
+	if catalog, err := regionk8s.RegionFromCR(u); err == nil {
+		return catalog
+	}
+	return region
+}
+
+// allowsRegion reports whether every policy allows region.
+func allowsRegion(policies []*rpdom.ResidencyPolicy, region *rdom.Region) bool {
+	for _, rp := range policies {
+		if !rp.Spec.AllowsRegion(region) {
+			return false
+		}
+	}
+	return true
+}
+
+// zoneOf returns the zone the object is placed in, or "" for kinds that name none.
+func zoneOf(obj any) string {
+	switch o := obj.(type) {
+	case *instancedom.Instance:
+		return o.Spec.Zone
+	case *subnetdom.Subnet:
+		return o.Spec.Zone
+	}
+	return ""
+}
@@ -0,0 +1,175 @@
+package residency
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	"github.com/eu-sovereign-cloud/ecp/framework/frontend/middleware"
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	admissionport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	rpk8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy/backend/kubernetes"
+	rak8s "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment/backend/kubernetes"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+	regionk8s "github.com/eu-sovereign-cloud/ecp/resource/region/v1/backend/kubernetes"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+)
+
+func TestProviders(t *testing.T) {
+	t.Parallel()
+
+	for kind, provider := range providers {
+		if !strings.HasPrefix(provider, "seca.") {
+			t.Errorf("providers[%q] = %q; want a seca provider", kind, provider)
+		}
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	newPolicy := func(tenant, name string, spec rpk8s.ResidencyPolicySpec) *rpk8s.ResidencyPolicy {
+		rp := &rpk8s.ResidencyPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: k8sadapter.ComputeNamespace(resource.Scope{Tenant: tenant})},
+			Spec:       spec,
+		}
+		rp.SetGroupVersionKind(rpk8s.ResidencyPolicyGVK)
+		return rp
+	}
+	newRegion := func(name string, zones []string, providers ...string) *regionk8s.Region {
+		r := &regionk8s.Region{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: regionk8s.RegionSpec{AvailableZones: zones}}
+		for _, p := range providers {
+			r.Spec.Providers = append(r.Spec.Providers, regionk8s.Provider{Name: p, Url: "https://" + name + "/providers/" + p, Version: "v1"})
+		}
+		r.SetGroupVersionKind(regionk8s.RegionGVK)
+		return r
+	}
+	newAssignment := func(tenant, name string, subs ...string) *rak8s.RoleAssignment {
+		ra := &rak8s.RoleAssignment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name, Namespace: k8sadapter.ComputeNamespace(resource.Scope{Tenant: tenant}),
+				Labels: map[string]string{k8slabels.InternalTenantLabel: tenant},
+			},
+			Spec: rak8s.RoleAssignmentSpec{Subs: subs, Roles: []string{"reader"}},
+		}
+		ra.SetGroupVersionKind(rak8s.RoleAssignmentGVK)
+		return ra
+	}
+	scheme := runtime.NewScheme()
+	_ = rpk8s.AddToScheme(scheme)
+	_ = regionk8s.AddToScheme(scheme)
+	_ = rak8s.AddToScheme(scheme)
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(scheme,
+		map[schema.GroupVersionResource]string{
+			rpk8s.ResidencyPolicyGVR: "ResidencyPolicyList",
+			regionk8s.RegionGVR:      "RegionList",
+			rak8s.RoleAssignmentGVR:  "RoleAssignmentList",
+		},
+		newPolicy("acme", "italy", rpk8s.ResidencyPolicySpec{
+			Regions: []string{"itbg-bergamo"}, Providers: []string{"seca.compute", "seca.workspace"}, Zones: []string{"itbg-1"},
+		}),
+		newRegion("itbg-bergamo", []string{"itbg-1", "itbg-2"}, "seca.compute", "seca.storage", "seca.workspace"),
+		newRegion("region-two", []string{"region-two-a"}, "seca.storage"),
+		newAssignment("acme", "alice-reader", "alice"),
+	)
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	bergamo := NewPolicies(client, "itbg-bergamo", log)
+	review := func(p *Policies, tenant, kind, name string, obj any) error {
+		return p.Review(ctx, admissionport.Request{
+			Resource: kind, Scope: resource.Scope{Tenant: tenant, Workspace: "dev"}, Name: name, Object: obj,
+		})
+	}
+	instance := func(zone string) *instancedom.Instance {
+		inst := &instancedom.Instance{Spec: instancedom.InstanceSpec{Zone: zone}}
+		inst.Name = "web-1"
+		return inst
+	}
+	if err := review(bergamo, "acme", instancedom.Resource, "web-1", instance("itbg-1")); !errors.Is(err, kernel.ErrInternal) {
+		t.Errorf("before sync: Review() = %v; want an internal error", err)
+	}
+	if err := bergamo.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+
+	if err := review(bergamo, "acme", instancedom.Resource, "web-1", instance("itbg-1")); err != nil {
+		t.Errorf("allowed zone: Review() = %v; want admitted", err)
+	}
+	err := review(bergamo, "acme", instancedom.Resource, "web-1", instance("itbg-2"))
+	if !errors.Is(err, kernel.ErrForbidden) || !strings.Contains(err.Error(), "italy: zone itbg-2 is not allowed") {
+		t.Errorf("other zone: Review() = %v; want forbidden by the italy policy", err)
+	}
+	if sources := kernel.AsError(err).Sources; len(sources) != 1 || sources[0].Name != "/spec/zone" {
+		t.Errorf("other zone: sources = %v; want /spec/zone", sources)
+	}
+	if err := review(bergamo, "acme", bsdom.Resource, "disk-1", nil); !errors.Is(err, kernel.ErrForbidden) ||
+		!strings.Contains(err.Error(), "provider seca.storage is not allowed") {
+		t.Errorf("storage write: Review() = %v; want forbidden by provider", err)
+	}
+	if err := review(bergamo, "globex", bsdom.Resource, "disk-1", nil); err != nil {
+		t.Errorf("tenant without policy: Review() = %v; want admitted", err)
+	}
+
+	// A regional gateway of a region the policy rules out rejects every write of the tenant.
+	two := NewPolicies(client, "region-two", log)
+	if err := two.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := review(two, "acme", "workspaces", "dev", nil); !errors.Is(err, kernel.ErrForbidden) ||
+		!strings.Contains(err.Error(), "region region-two is not allowed") {
+		t.Errorf("other region: Review() = %v; want forbidden by region", err)
+	}
+
+	// The global gateway hides the regions the caller's tenants may not use.
+	global := NewPolicies(client, "", log)
+	if err := global.WatchAssignments(); err != nil {
+		t.Fatalf("WatchAssignments() error = %v", err)
+	}
+	if err := global.Start(ctx); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	visible := func(id *authnport.Identity, name string) bool {
+		t.Helper()
+		ctx := ctx
+		if id != nil {
+			ctx = middleware.ContextWithIdentity(ctx, id)
+		}
+		ok, err := global.RegionVisible(ctx, global.catalogRegion(name))
+		if err != nil {
+			t.Fatalf("RegionVisible(%v, %q) error = %v", id, name, err)
+		}
+		return ok
+	}
+	scoped := func(tenant string) *authnport.Identity {
+		return &authnport.Identity{Subject: "bob", TokenScope: resource.TokenScope{Tenants: []string{tenant}}}
+	}
+	if !visible(scoped("acme"), "itbg-bergamo") || visible(scoped("acme"), "region-two") {
+		t.Error("a token scoped to acme must see only itbg-bergamo")
+	}
+	alice := &authnport.Identity{Subject: "alice"}
+	if !visible(alice, "itbg-bergamo") || visible(alice, "region-two") {
+		t.Error("a caller assigned roles in acme must see only itbg-bergamo")
+	}
+	if !visible(scoped("globex"), "region-two") || !visible(&authnport.Identity{Subject: "carol"}, "region-two") || !visible(nil, "region-two") {
+		t.Error("a tenant without policy, or no tenant at all, must see every region")
+	}
+	if got := global.catalogRegion("unknown"); got.Name != "unknown" || len(got.Zones) != 0 {
+		t.Errorf("catalogRegion(unknown) = %+v; want the bare name", got)
+	}
+}
@@ -0,0 +1,140 @@
+// Package sovereignty builds the sovereignty report of a tenant at the regional gateway.
+//
+// The Reporter lists every SECA resource of the tenant in the region, across its
+// workspaces, and locates each one: its region and zone, and the CSP plugin and
+// provider-side objects its controller recorded on it (see labels.BackendRefsAnnotation).
+// Unlike the quota and residency caches, the report reads the cluster directly: it is
+// requested rarely and must be complete.
+package sovereignty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	kernel "github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	instancek8s "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance/backend/kubernetes"
+	internetgatewayk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway/backend/kubernetes"
+	netk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network/backend/kubernetes"
+	nick8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic/backend/kubernetes"
+	publicipk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/public-ip/backend/kubernetes"
+	routetablek8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/route-table/backend/kubernetes"
+	securitygrouprulek8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group-rule/backend/kubernetes"
+	securitygroupk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group/backend/kubernetes"
+	subnetk8s "github.com/eu-sovereign-cloud/ecp/resource/network/v1/subnet/backend/kubernetes"
+	bsk8s "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage/backend/kubernetes"
+	imgk8s "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/image/backend/kubernetes"
+	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
+	wsk8s "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/backend/kubernetes"
+	sovdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/sovereignty"
+)
+
+// pageSize bounds each list call against the API server.
+const pageSize = 500
+
+// reportedKind is one resource kind the report covers.
+type reportedKind struct {
+	kind     string
+	gvr      schema.GroupVersionResource
+	provider string
+}
+
+// reportedKinds are the tenant-owned kinds of the regional gateway, with the provider
+// serving each.
+var reportedKinds = []reportedKind{
+	{wsdom.Resource, wsk8s.WorkspaceGVR, "seca.workspace"},
+	{instancek8s.InstanceResource, instancek8s.InstanceGVR, "seca.compute"},
+	{bsk8s.BlockStorageResource, bsk8s.BlockStorageGVR, "seca.storage"},
+	{imgk8s.ImageResource, imgk8s.ImageGVR, "seca.storage"},
+	{netk8s.NetworkResource, netk8s.NetworkGVR, "seca.network"},
+	{subnetk8s.SubnetResource, subnetk8s.SubnetGVR, "seca.network"},
+	{nick8s.NICResource, nick8s.NICGVR, "seca.network"},
+	{publicipk8s.PublicIPResource, publicipk8s.PublicIPGVR, "seca.network"},
+	{internetgatewayk8s.InternetGatewayResource, internetgatewayk8s.InternetGatewayGVR, "seca.network"},
+	{routetablek8s.RouteTableResource, routetablek8s.RouteTableGVR, "seca.network"},
+	{securitygroupk8s.SecurityGroupResource, securitygroupk8s.SecurityGroupGVR, "seca.network"},
+	{securitygrouprulek8s.SecurityGroupRuleResource, securitygrouprulek8s.SecurityGroupRuleGVR, "seca.network"},
+}
+
+// Reporter is the regional implementation of the workspace handler's SovereigntyReporter.
+type Reporter struct {
+	client dynamic.Interface
+	// region is the region the gateway serves, reported for resources not labelled with one.
+	region string
+	log    *slog.Logger
+	now    func() time.Time
+}
+
+// NewReporter reads the resources through dynClient. region is the region the gateway
+// serves, config.Singleton().Region().
+func NewReporter(dynClient dynamic.Interface, region string, log *slog.Logger) *Reporter {
+	return &Reporter{client: dynClient, region: region, log: log, now: time.Now}
+}
+
+// SovereigntyReport returns the report of every resource of tenant in the region. A
+// failed list fails the whole report with an internal error: a partial report would
+// understate where the tenant's data lives.
+func (r *Reporter) SovereigntyReport(ctx context.Context, tenant string) (*sovdom.Report, error) {
+	selector := labels.SelectorFromSet(labels.Set{k8slabels.InternalTenantLabel: tenant}).String()
+	var entries []sovdom.Entry
+	for _, k := range reportedKinds {
+		opts := metav1.ListOptions{LabelSelector: selector, Limit: pageSize}
+		for {
+			list, err := r.client.Resource(k.gvr).List(ctx, opts)
+			if err != nil {
+				return nil, kernel.NewError(kernel.KindInternal, fmt.Errorf("list %s of tenant %s: %w", k.kind, tenant, err))
+			}
+			for i := range list.Items {
+				entries = append(entries, r.entry(k, &list.Items[i]))
+			}
+			if list.GetContinue() == "" {
+				break
+			}
+			opts.Continue = list.GetContinue()
+		}
+	}
+	return sovdom.NewReport(tenant, r.now().UTC(), entries), nil
+}
+
+// entry locates u, a resource of kind k.
+func (r *Reporter) entry(k reportedKind, u *unstructured.Unstructured) sovdom.Entry {
+	crLabels := u.GetLabels()
+	annotations := u.GetAnnotations()
+	e := sovdom.Entry{
+		Kind:      k.kind,
+		Workspace: crLabels[k8slabels.InternalWorkspaceLabel],
+		Name:      u.GetName(),
+		Provider:  k.provider,
+		Region:    crLabels[k8slabels.InternalRegionLabel],
+		Plugin:    annotations[k8slabels.BackendPluginAnnotation],
+	}
+	if k.kind == wsdom.Resource {
+		// A workspace is held by its tenant, not by itself.
+		e.Workspace = ""
+	}
+	if e.Region == "" {
+		e.Region = r.region
+	}
+	e.Zone, _, _ = unstructured.NestedString(u.Object, "spec", "zone")
+	e.State, _, _ = unstructured.NestedString(u.Object, "status", "state")
+	if raw := annotations[k8slabels.BackendRefsAnnotation]; raw != "" {
+		var refs []backend.BackendRef
+		if err := json.Unmarshal([]byte(raw), &refs); err != nil {
+			r.log.Error("sovereignty: malformed backend refs", slog.String("resource", k.kind),
+				slog.String("name", u.GetName()), slog.Any("error", err))
+		} else {
+			e.BackendRefs = refs
+		}
+	}
+	return e
+}
@@ -0,0 +1,80 @@
+package sovereignty
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+)
+
+func TestSovereigntyReport(t *testing.T) {
+	t.Parallel()
+
+	newObj := func(k reportedKind, kind, name string, labels, annotations map[string]string, spec map[string]any) *unstructured.Unstructured {
+		u := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": k.gvr.GroupVersion().String(),
+			"kind":       kind,
+			"metadata":   map[string]any{"name": name, "namespace": "ns"},
+			"spec":       spec,
+			"status":     map[string]any{"state": "active"},
+		}}
+		u.SetLabels(labels)
+		u.SetAnnotations(annotations)
+		return u
+	}
+	byKind := map[string]reportedKind{}
+	listKinds := map[schema.GroupVersionResource]string{}
+	for _, k := range reportedKinds {
+		byKind[k.kind] = k
+		listKinds[k.gvr] = k.kind + "List"
+	}
+	acme := func(workspace string) map[string]string {
+		return map[string]string{k8slabels.InternalTenantLabel: "acme", k8slabels.InternalWorkspaceLabel: workspace}
+	}
+
+	client := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds,
+		newObj(byKind["instances"], "Instance", "web-1", acme("prod"), map[string]string{
+			k8slabels.BackendPluginAnnotation: "aruba",
+			k8slabels.BackendRefsAnnotation:   `[{"kind":"CloudServer","id":"ns/web-1"}]`,
+		}, map[string]any{"zone": "itbg-1"}),
+		newObj(byKind["block-storages"], "BlockStorage", "disk-1", acme("prod"), map[string]string{
+			k8slabels.BackendPluginAnnotation: "aruba",
+			k8slabels.BackendRefsAnnotation:   `not json`,
+		}, map[string]any{}),
+		newObj(byKind["workspaces"], "Workspace", "prod", acme("prod"), nil, map[string]any{}),
+		newObj(byKind["instances"], "Instance", "other", map[string]string{k8slabels.InternalTenantLabel: "globex"}, nil, map[string]any{}),
+	)
+	reporter := NewReporter(client, "itbg-bergamo", slog.New(slog.NewTextHandler(io.Discard, nil)))
+	reporter.now = func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }
+
+	report, err := reporter.SovereigntyReport(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("SovereigntyReport() error = %v", err)
+	}
+	if len(report.Resources) != 3 {
+		t.Fatalf("got %d resources; want the 3 of acme: %+v", len(report.Resources), report.Resources)
+	}
+
+	disk, inst, ws := report.Resources[0], report.Resources[1], report.Resources[2]
+	if inst.Name != "web-1" || inst.Zone != "itbg-1" || inst.Region != "itbg-bergamo" || inst.Provider != "seca.compute" ||
+		inst.State != "active" || inst.Plugin != "aruba" || len(inst.BackendRefs) != 1 || inst.BackendRefs[0].ID != "ns/web-1" {
+		t.Errorf("instance entry = %+v", inst)
+	}
+	if disk.Name != "disk-1" || disk.Plugin != "aruba" || len(disk.BackendRefs) != 0 {
+		t.Errorf("block storage entry = %+v; want the plugin without the malformed refs", disk)
+	}
+	if ws.Name != "prod" || ws.Workspace != "" || ws.Plugin != "" {
+		t.Errorf("workspace entry = %+v; want no workspace and no plugin", ws)
+	}
+	if len(report.Summary) != 3 || report.Summary[2].Unattributed != 1 {
+		t.Errorf("summary = %+v", report.Summary)
+	}
+}
@@ -0,0 +1,124 @@
+package kubernetes
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// AdmissionPolicyFromCR converts either a concrete *AdmissionPolicy or
+// *unstructured.Unstructured into a *apdom.AdmissionPolicy.
+func AdmissionPolicyFromCR(obj client.Object) (*apdom.AdmissionPolicy, error) {
+	var cr AdmissionPolicy
+
+	switch t := obj.(type) {
+	case *AdmissionPolicy:
+		cr = *t
+	case *unstructured.Unstructured:
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(t.Object, &cr); err != nil {
+			return nil, fmt.Errorf("failed to convert unstructured to AdmissionPolicy: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	crLabels := cr.GetLabels()
+	internalLabels := k8slabels.GetInternalLabels(crLabels)
+	keyedLabels := k8slabels.GetKeyedLabels(crLabels)
+
+	ap := &apdom.AdmissionPolicy{
+		Spec: apdom.AdmissionPolicySpec{
+			Resources:  slices.Clone(cr.Spec.Resources),
+			Workspaces: slices.Clone(cr.Spec.Workspaces),
+			Expression: cr.Spec.Expression,
+			Message:    cr.Spec.Message,
+			Field:      cr.Spec.Field,
+			Mode:       apdom.Mode(cr.Spec.Mode),
+		},
+	}
+	ap.Name = cr.GetName()
+	ap.ResourceVersion = cr.GetResourceVersion()
+	ap.CreatedAt = cr.GetCreationTimestamp().Time
+	ap.UpdatedAt = cr.GetCreationTimestamp().Time
+	ap.Provider = strings.ReplaceAll(internalLabels[k8slabels.InternalProviderLabel], "_", "/")
+	ap.Tenant = internalLabels[k8slabels.InternalTenantLabel]
+	ap.Labels = k8slabels.KeyedToOriginal(keyedLabels, cr.CommonData.Labels)
+	ap.Annotations = cr.CommonData.Annotations
+	ap.Extensions = cr.CommonData.Extensions
+
+	if ts := cr.GetDeletionTimestamp(); ts != nil {
+		ap.DeletedAt = &ts.Time
+	}
+
+	ap.Status = &apdom.AdmissionPolicyStatus{}
+	if cr.Status != nil {
+		ap.Status.State = commonbackend.ResourceStateFromCR(cr.Status.State)
+		ap.Status.Conditions = commonbackend.ConditionsFromCR(cr.Status.Conditions)
+	} else {
+		ap.Status.PushCondition(commondomain.DefaultPendingCondition)
+	}
+
+	return ap, nil
+}
+
+// AdmissionPolicyToCR converts a *apdom.AdmissionPolicy to a Kubernetes AdmissionPolicy CR.
+func AdmissionPolicyToCR(ap *apdom.AdmissionPolicy) (client.Object, error) {
+	if ap == nil {
+		return nil, fmt.Errorf("admission policy is nil")
+	}
+
+	crLabels := k8slabels.OriginalToKeyed(ap.Labels)
+	crLabels[k8slabels.InternalTenantLabel] = ap.Tenant
+	crLabels[k8slabels.InternalProviderLabel] = strings.ReplaceAll(ap.Provider, "/", "_")
+
+	cr := &AdmissionPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            ap.Name,
+			Namespace:       k8sadapter.ComputeNamespace(&kernelresource.Scope{Tenant: ap.Tenant}),
+			Labels:          crLabels,
+			ResourceVersion: ap.ResourceVersion,
+		},
+		CommonData: schemav1.CommonData{
+			Annotations: ap.Annotations,
+			Extensions:  ap.Extensions,
+			Labels:      slices.Sorted(maps.Keys(ap.Labels)),
+		},
+		Spec: AdmissionPolicySpec{
+			Resources:  slices.Clone(ap.Spec.Resources),
+			Workspaces: slices.Clone(ap.Spec.Workspaces),
+			Expression: ap.Spec.Expression,
+			Message:    ap.Spec.Message,
+			Field:      ap.Spec.Field,
+			Mode:       string(ap.Spec.Mode),
+		},
+	}
+	cr.SetGroupVersionKind(AdmissionPolicyGVK)
+
+	if ap.Status != nil && len(ap.Status.Conditions) > 0 {
+		state := commonbackend.ResourceStateToCR(ap.Status.State)
+		if state == nil {
+			return nil, fmt.Errorf("admission policy %s: failed to map resource state domain to CR", ap.Name)
+		}
+		cr.Status = &AdmissionPolicyStatus{
+			State:      *state,
+			Conditions: commonbackend.ConditionsToCR(ap.Status.Conditions),
+		}
+	}
+
+	return cr, nil
+}
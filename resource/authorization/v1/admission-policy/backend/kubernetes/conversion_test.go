@@ -0,0 +1,91 @@
+package kubernetes_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	. "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy/backend/kubernetes"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// FuzzAdmissionPolicyRoundTrip verifies that an AdmissionPolicy domain value survives a
+// domain→CR→domain→CR→domain round-trip.
+//
+// Invariants:
+//   - Name, Provider, and Tenant are stable after one round-trip (domain2 == domain3).
+//   - Resources, Workspaces, Expression, Message, Field and Mode survive unchanged.
+//
+// resources and workspaces are comma-separated lists; an empty string is an empty list.
+func FuzzAdmissionPolicyRoundTrip(f *testing.F) {
+	f.Add("cost-center", "seca.authorization/v1", "t-1", "instances", "", "has(object.Labels['cost-center'])", "instances need a cost center", "/labels", "enforce")
+	f.Add("", "", "", "", "", "", "", "", "")
+	f.Add("dev-skus", "seca.authorization/v1", "tenant-42", "instances,block-storages", "dev,sandbox", "object.Spec.SkuRef.Name == 'small'", "", "/spec/skuRef", "audit")
+
+	f.Fuzz(func(t *testing.T, name, provider, tenant, resources, workspaces, expression, message, field, mode string) {
+		split := func(s string) []string {
+			if s == "" {
+				return nil
+			}
+			return strings.Split(s, ",")
+		}
+		domain := &apdom.AdmissionPolicy{
+			GlobalTenantMetadata: commondomain.GlobalTenantMetadata{
+				CommonMetadata: commondomain.CommonMetadata{
+					Name:     name,
+					Provider: provider,
+				},
+				Scope: kernelresource.Scope{Tenant: tenant},
+			},
+			Spec: apdom.AdmissionPolicySpec{
+				Resources:  split(resources),
+				Workspaces: split(workspaces),
+				Expression: expression,
+				Message:    message,
+				Field:      field,
+				Mode:       apdom.Mode(mode),
+			},
+		}
+
+		cr1, err := AdmissionPolicyToCR(domain)
+		if err != nil {
+			return
+		}
+
+		domain2, err := AdmissionPolicyFromCR(cr1)
+		if err != nil {
+			t.Errorf("CR→domain failed after successful domain→CR: %v", err)
+			return
+		}
+
+		cr2, err := AdmissionPolicyToCR(domain2)
+		if err != nil {
+			t.Errorf("second domain→CR failed: %v", err)
+			return
+		}
+
+		domain3, err := AdmissionPolicyFromCR(cr2)
+		if err != nil {
+			t.Errorf("second CR→domain failed: %v", err)
+			return
+		}
+
+		if domain2.Name != domain3.Name {
+			t.Errorf("Name not stable: %q → %q", domain2.Name, domain3.Name)
+		}
+		if domain2.Provider != domain3.Provider {
+			t.Errorf("Provider not stable: %q → %q", domain2.Provider, domain3.Provider)
+		}
+		if domain2.Tenant != domain3.Tenant {
+			t.Errorf("Tenant not stable: %q → %q", domain2.Tenant, domain3.Tenant)
+		}
+
+		got := domain3.Spec
+		if !slices.Equal(got.Resources, domain.Spec.Resources) || !slices.Equal(got.Workspaces, domain.Spec.Workspaces) ||
+			got.Expression != expression || got.Message != message || got.Field != field || got.Mode != apdom.Mode(mode) {
+			t.Errorf("Spec not preserved: %+v → %+v", domain.Spec, got)
+		}
+	})
+}
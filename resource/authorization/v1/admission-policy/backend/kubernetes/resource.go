@@ -0,0 +1,102 @@
+// +kubebuilder:object:generate=true
+// +groupName=authorization.v1.secapi.cloud
+// +versionName=v1
+
+// Package kubernetes holds the AdmissionPolicy custom resource and its domain conversion.
+//
+// SECA has no admission-policy schema, so unlike the other resources of the group the spec
+// is written by hand rather than generated from the SDK.
+package kubernetes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+)
+
+const (
+	Group   = "authorization.v1.secapi.cloud"
+	Version = "v1"
+
+	AdmissionPolicyResource = "admission-policies"
+	AdmissionPolicyKind     = "AdmissionPolicy"
+)
+
+var (
+	GroupVersion  = schema.GroupVersion{Group: Group, Version: Version}
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+	AddToScheme   = SchemeBuilder.AddToScheme
+
+	AdmissionPolicyGVR = schema.GroupVersionResource{
+		Group: Group, Version: Version, Resource: AdmissionPolicyResource,
+	}
+	AdmissionPolicyGVK = schema.GroupVersionKind{
+		Group: Group, Version: Version, Kind: AdmissionPolicyKind,
+	}
+)
+
+// AdmissionPolicySpec is the desired state of an admission policy.
+type AdmissionPolicySpec struct {
+	// Resources restricts the policy to the listed resource kinds, e.g. "instances".
+	// Empty applies it to every kind.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+
+	// Workspaces restricts the policy to the listed workspaces. Empty applies it to every
+	// workspace and to tenant-scoped resources.
+	// +optional
+	Workspaces []string `json:"workspaces,omitempty"`
+
+	// Expression is a CEL expression evaluating to true when the object is admitted.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Expression string `json:"expression"`
+
+	// Message explains a violation to the caller.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Field is the JSON pointer into the request body reported with a violation.
+	// +kubebuilder:validation:Pattern=`^(/.*)?$`
+	// +optional
+	Field string `json:"field,omitempty"`
+
+	// Mode is "enforce" (reject violating writes) or "audit" (only log them).
+	// +kubebuilder:validation:Enum=enforce;audit
+	// +optional
+	Mode string `json:"mode,omitempty"`
+}
+
+// AdmissionPolicyStatus Current status of the resource
+type AdmissionPolicyStatus = schemav1.Status
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=admission-policies,scope=Namespaced,shortName=admpol
+// +k8s:openapi-gen=true
+
+// AdmissionPolicy is the API for governing the content of a tenant's resources.
+type AdmissionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec       AdmissionPolicySpec    `json:"spec,omitempty"`
+	CommonData schemav1.CommonData    `json:"commonData,omitempty"`
+	Status     *AdmissionPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type AdmissionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []AdmissionPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AdmissionPolicy{}, &AdmissionPolicyList{})
+}
@@ -0,0 +1,82 @@
+// Package admissionpolicy defines the admission-policy resource domain model and identity
+// constants.
+//
+// An AdmissionPolicy governs what a tenant creates, where authorization governs who may
+// act: every instance must carry a cost-center label, only some SKUs are allowed in the
+// dev workspace, names must match a pattern. It is a tenant-scoped CEL expression the
+// regional gateway evaluates against the domain object of every create or update of the
+// resources it targets. A violated policy rejects the write with 422, unless it is in
+// audit mode, where the violation is only logged.
+package admissionpolicy
+
+import (
+	"slices"
+
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// Identity constants for the admission-policy resource.
+const (
+	Kind       = "AdmissionPolicy"
+	Resource   = "admission-policies"
+	Group      = "authorization.v1.secapi.cloud"
+	Version    = "v1"
+	ProviderID = "seca.authorization/v1"
+)
+
+// Mode selects what a violation of the policy does.
+type Mode string
+
+// Policy modes.
+const (
+	// ModeEnforce rejects a write violating the policy. It is the default.
+	ModeEnforce Mode = "enforce"
+	// ModeAudit only logs a write violating the policy, so a new policy can be rolled out
+	// and its impact observed before it blocks anything.
+	ModeAudit Mode = "audit"
+)
+
+// AdmissionPolicy is the domain model for an admission-policy resource.
+type AdmissionPolicy struct {
+	domain.GlobalTenantMetadata
+	Spec   AdmissionPolicySpec
+	Status *AdmissionPolicyStatus
+}
+
+// AdmissionPolicySpec holds the policy expression and the writes it applies to.
+type AdmissionPolicySpec struct {
+	// Resources restricts the policy to the listed resource kinds, e.g. "instances";
+	// empty applies it to every kind.
+	Resources []string
+	// Workspaces restricts the policy to the listed workspaces; empty applies it to every
+	// workspace and to tenant-scoped resources.
+	Workspaces []string
+	// Expression is a CEL expression evaluating to true when the object is admitted.
+	Expression string
+	// Message explains a violation to the caller; empty reports the expression instead.
+	Message string
+	// Field is the JSON pointer into the request body reported with a violation, e.g.
+	// "/spec/skuRef". Empty reports none.
+	Field string
+	// Mode is ModeEnforce or ModeAudit; empty means ModeEnforce.
+	Mode Mode
+}
+
+// AdmissionPolicyStatus defines the status for an admission policy.
+type AdmissionPolicyStatus struct {
+	domain.Status
+}
+
+// AppliesTo reports whether the policy governs writes of resource kind in workspace. A
+// policy restricted to workspaces never governs tenant-scoped resources.
+func (s AdmissionPolicySpec) AppliesTo(resource, workspace string) bool {
+	if len(s.Resources) > 0 && !slices.Contains(s.Resources, resource) {
+		return false
+	}
+	return len(s.Workspaces) == 0 || slices.Contains(s.Workspaces, workspace)
+}
+
+// Audit reports whether a violation of the policy is only logged.
+func (s AdmissionPolicySpec) Audit() bool {
+	return s.Mode == ModeAudit
+}
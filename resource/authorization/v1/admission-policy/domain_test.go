@@ -0,0 +1,27 @@
+package admissionpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppliesTo(t *testing.T) {
+	assert.True(t, AdmissionPolicySpec{}.AppliesTo("instances", "dev"), "no filter applies everywhere")
+	assert.True(t, AdmissionPolicySpec{}.AppliesTo("workspaces", ""))
+
+	instances := AdmissionPolicySpec{Resources: []string{"instances", "block-storages"}}
+	assert.True(t, instances.AppliesTo("block-storages", "dev"))
+	assert.False(t, instances.AppliesTo("networks", "dev"))
+
+	dev := AdmissionPolicySpec{Resources: []string{"instances"}, Workspaces: []string{"dev"}}
+	assert.True(t, dev.AppliesTo("instances", "dev"))
+	assert.False(t, dev.AppliesTo("instances", "prod"))
+	assert.False(t, AdmissionPolicySpec{Workspaces: []string{"dev"}}.AppliesTo("workspaces", ""), "workspace filter skips tenant-scoped resources")
+}
+
+func TestAudit(t *testing.T) {
+	assert.False(t, AdmissionPolicySpec{}.Audit(), "empty mode enforces")
+	assert.False(t, AdmissionPolicySpec{Mode: ModeEnforce}.Audit())
+	assert.True(t, AdmissionPolicySpec{Mode: ModeAudit}.Audit())
+}
@@ -0,0 +1,71 @@
+package kubernetes
+
+import (
+	"fmt"
+	"slices"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/authorization-policy"
+)
+
+// AuthorizationPolicyFromCR converts either a concrete *AuthorizationPolicy or
+// *unstructured.Unstructured into a *apdom.AuthorizationPolicy.
+func AuthorizationPolicyFromCR(obj client.Object) (*apdom.AuthorizationPolicy, error) {
+	var cr AuthorizationPolicy
+
+	switch t := obj.(type) {
+	case *AuthorizationPolicy:
+		cr = *t
+	case *unstructured.Unstructured:
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(t.Object, &cr); err != nil {
+			return nil, fmt.Errorf("failed to convert unstructured to AuthorizationPolicy: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	ap := &apdom.AuthorizationPolicy{
+		Spec: apdom.AuthorizationPolicySpec{
+			Tenants:    slices.Clone(cr.Spec.Tenants),
+			Expression: cr.Spec.Expression,
+			Message:    cr.Spec.Message,
+		},
+	}
+	ap.Name = cr.GetName()
+	ap.Provider = apdom.ProviderID
+	ap.ResourceVersion = cr.GetResourceVersion()
+	ap.CreatedAt = cr.GetCreationTimestamp().Time
+	ap.UpdatedAt = cr.GetCreationTimestamp().Time
+	if ts := cr.GetDeletionTimestamp(); ts != nil {
+		ap.DeletedAt = &ts.Time
+	}
+
+	return ap, nil
+}
+
+// AuthorizationPolicyToCR converts a *apdom.AuthorizationPolicy to a cluster-scoped
+// Kubernetes AuthorizationPolicy CR.
+func AuthorizationPolicyToCR(ap *apdom.AuthorizationPolicy) (client.Object, error) {
+	if ap == nil {
+		return nil, fmt.Errorf("authorization policy is nil")
+	}
+
+	cr := &AuthorizationPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            ap.Name,
+			ResourceVersion: ap.ResourceVersion,
+		},
+		Spec: AuthorizationPolicySpec{
+			Tenants:    slices.Clone(ap.Spec.Tenants),
+			Expression: ap.Spec.Expression,
+			Message:    ap.Spec.Message,
+		},
+	}
+	cr.SetGroupVersionKind(AuthorizationPolicyGVK)
+
+	return cr, nil
+}
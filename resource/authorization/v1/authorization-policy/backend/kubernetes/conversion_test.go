@@ -0,0 +1,51 @@
+package kubernetes_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/authorization-policy"
+	. "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/authorization-policy/backend/kubernetes"
+)
+
+// FuzzAuthorizationPolicyRoundTrip verifies that an AuthorizationPolicy domain value
+// survives a domain→CR→domain round-trip.
+//
+// Invariants:
+//   - Name, Expression and Message survive unchanged.
+//   - Tenants survive in order; the fuzzer passes them comma-separated.
+func FuzzAuthorizationPolicyRoundTrip(f *testing.F) {
+	f.Add("no-friday-deletes", "", `!(claim.verb == "delete" && now.getDayOfWeek() == 5)`, "no deletes on Fridays")
+	f.Add("sandbox-dev-only", "t1,t2", `request.labels["env"] == "dev"`, "")
+	f.Add("", "", "", "")
+
+	f.Fuzz(func(t *testing.T, name, tenants, expression, message string) {
+		domain := &apdom.AuthorizationPolicy{
+			Spec: apdom.AuthorizationPolicySpec{Expression: expression, Message: message},
+		}
+		domain.Name = name
+		if tenants != "" {
+			domain.Spec.Tenants = strings.Split(tenants, ",")
+		}
+
+		cr, err := AuthorizationPolicyToCR(domain)
+		if err != nil {
+			t.Fatalf("domain→CR failed: %v", err)
+		}
+		got, err := AuthorizationPolicyFromCR(cr)
+		if err != nil {
+			t.Fatalf("CR→domain failed: %v", err)
+		}
+
+		if got.Name != name {
+			t.Errorf("Name not preserved: %q → %q", name, got.Name)
+		}
+		if got.Spec.Expression != expression || got.Spec.Message != message {
+			t.Errorf("Spec not preserved: %+v → %+v", domain.Spec, got.Spec)
+		}
+		if !slices.Equal(got.Spec.Tenants, domain.Spec.Tenants) {
+			t.Errorf("Tenants not preserved: %v → %v", domain.Spec.Tenants, got.Spec.Tenants)
+		}
+	})
+}
@@ -0,0 +1,44 @@
+// Package authorizationpolicy defines the authorization-policy resource domain model and
+// identity constants.
+//
+// An AuthorizationPolicy is a global, tenant-less CEL expression the gateways evaluate on
+// every authorized request, in addition to SECA RBAC. It expresses rules RBAC cannot, such
+// as "no deletes on Fridays". A request is allowed only when RBAC and every policy that
+// applies to its tenant allow it.
+package authorizationpolicy
+
+import (
+	"slices"
+
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// Identity constants for the authorization-policy resource.
+const (
+	Kind       = "AuthorizationPolicy"
+	Resource   = "authorization-policies"
+	Group      = "authorization.v1.secapi.cloud"
+	Version    = "v1"
+	ProviderID = "seca.authorization/v1"
+)
+
+// AuthorizationPolicy is the domain model for an authorization-policy resource.
+type AuthorizationPolicy struct {
+	domain.Metadata
+	Spec AuthorizationPolicySpec
+}
+
+// AuthorizationPolicySpec holds the policy expression and the tenants it applies to.
+type AuthorizationPolicySpec struct {
+	// Tenants restricts the policy to the listed tenants; empty applies it to every tenant.
+	Tenants []string
+	// Expression is a CEL expression evaluating to true when the request is allowed.
+	Expression string
+	// Message explains a denial in the audit log; empty logs the expression instead.
+	Message string
+}
+
+// AppliesTo reports whether the policy governs requests of tenant.
+func (s AuthorizationPolicySpec) AppliesTo(tenant string) bool {
+	return len(s.Tenants) == 0 || slices.Contains(s.Tenants, tenant)
+}
@@ -0,0 +1,13 @@
+package authorizationpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppliesTo(t *testing.T) {
+	assert.True(t, AuthorizationPolicySpec{}.AppliesTo("t1"), "no tenants applies everywhere")
+	assert.True(t, AuthorizationPolicySpec{Tenants: []string{"t1", "t2"}}.AppliesTo("t2"))
+	assert.False(t, AuthorizationPolicySpec{Tenants: []string{"t1"}}.AppliesTo("t2"))
+}
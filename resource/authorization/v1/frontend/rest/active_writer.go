@@ -5,18 +5,24 @@ import (
 
 	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
 	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
 	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
 	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
 	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 )
 
 // activeOnWrite wraps a spec write (Create or Update) so it also stamps an active
-// status subresource. Role and RoleAssignment are managed entirely by the ECP
-// control plane — there is no plugin to reconcile them — so they are active the
-// moment they are written. Status is a subresource, so the API server drops it on
-// the spec write; markActive + UpdateStatus persist it in a second call.
+// status subresource. Role, RoleAssignment, ServiceAccount, AdmissionPolicy and
+// ResidencyPolicy are managed entirely by the ECP control plane — there is no plugin to
+// reconcile them — so they are active the moment they are written. Status is a
+// subresource, so the API server drops it on the spec write; markActive + UpdateStatus
+// persist it in a second call.
+// An optional admit hook runs first and aborts the write when it returns an error.
 type activeOnWrite[D persistencepkg.IdentifiableResource] struct {
+	admit        func(context.Context, D) error
 	persist      func(context.Context, D) (*D, error)
 	updateStatus func(context.Context, D) (*D, error)
 	markActive   func(D)
@@ -24,6 +30,11 @@ type activeOnWrite[D persistencepkg.IdentifiableResource] struct {
 
 func (a activeOnWrite[D]) Do(ctx context.Context, m D) (D, error) {
 	var zero D
+	if a.admit != nil {
+		if err := a.admit(ctx, m); err != nil {
+			return zero, err
+		}
+	}
 	saved, err := a.persist(ctx, m)
 	if err != nil {
 		return zero, err
@@ -36,14 +47,16 @@ func (a activeOnWrite[D]) Do(ctx context.Context, m D) (D, error) {
 	return *withStatus, nil
 }
 
-// activeCreator returns a Creator that persists the resource and marks it active.
-func activeCreator[D persistencepkg.IdentifiableResource](repo persistencepkg.WriterRepo[D], markActive func(D)) frest.Creator[D] {
-	return activeOnWrite[D]{persist: repo.Create, updateStatus: repo.UpdateStatus, markActive: markActive}
+// activeCreator returns a Creator that admits, persists and marks the resource active.
+// A nil admit skips admission.
+func activeCreator[D persistencepkg.IdentifiableResource](repo persistencepkg.WriterRepo[D], admit func(context.Context, D) error, markActive func(D)) frest.Creator[D] {
+	return activeOnWrite[D]{admit: admit, persist: repo.Create, updateStatus: repo.UpdateStatus, markActive: markActive}
 }
 
-// activeUpdater returns an Updater that persists the resource and marks it active.
-func activeUpdater[D persistencepkg.IdentifiableResource](repo persistencepkg.WriterRepo[D], markActive func(D)) frest.Updater[D] {
-	return activeOnWrite[D]{persist: repo.Update, updateStatus: repo.UpdateStatus, markActive: markActive}
+// activeUpdater returns an Updater that admits, persists and marks the resource active.
+// A nil admit skips admission.
+func activeUpdater[D persistencepkg.IdentifiableResource](repo persistencepkg.WriterRepo[D], admit func(context.Context, D) error, markActive func(D)) frest.Updater[D] {
+	return activeOnWrite[D]{admit: admit, persist: repo.Update, updateStatus: repo.UpdateStatus, markActive: markActive}
 }
 
 func markRoleActive(r *roledom.Role) {
@@ -55,3 +68,18 @@ func markRoleAssignmentActive(ra *radom.RoleAssignment) {
 	ra.Status = &radom.RoleAssignmentStatus{}
 	ra.Status.PushCondition(commonbackend.ConditionFromState(commondomain.ResourceStateActive))
 }
+
+func markServiceAccountActive(sa *sadom.ServiceAccount) {
+	sa.Status = &sadom.ServiceAccountStatus{}
+	sa.Status.PushCondition(commonbackend.ConditionFromState(commondomain.ResourceStateActive))
+}
+
+func markAdmissionPolicyActive(ap *apdom.AdmissionPolicy) {
+	ap.Status = &apdom.AdmissionPolicyStatus{}
+	ap.Status.PushCondition(commonbackend.ConditionFromState(commondomain.ResourceStateActive))
+}
+
+func markResidencyPolicyActive(rp *rpdom.ResidencyPolicy) {
+	rp.Status = &rpdom.ResidencyPolicyStatus{}
+	rp.Status.PushCondition(commonbackend.ConditionFromState(commondomain.ResourceStateActive))
+}
@@ -42,7 +42,7 @@ func (f *fakeRoleWriter) UpdateStatus(_ context.Context, m *roledom.Role) (**rol
 func TestActiveCreator_MarksActiveAfterPersist(t *testing.T) {
 	f := &fakeRoleWriter{}
 
-	out, err := activeCreator[*roledom.Role](f, markRoleActive).Do(context.Background(), &roledom.Role{})
+	out, err := activeCreator[*roledom.Role](f, nil, markRoleActive).Do(context.Background(), &roledom.Role{})
 
 	require.NoError(t, err)
 	require.True(t, f.createCalled)
@@ -54,8 +54,20 @@ func TestActiveCreator_MarksActiveAfterPersist(t *testing.T) {
 func TestActiveCreator_PersistErrorSkipsStatusWrite(t *testing.T) {
 	f := &fakeRoleWriter{createErr: errors.New("boom")}
 
-	_, err := activeCreator[*roledom.Role](f, markRoleActive).Do(context.Background(), &roledom.Role{})
+	_, err := activeCreator[*roledom.Role](f, nil, markRoleActive).Do(context.Background(), &roledom.Role{})
 
 	require.Error(t, err)
 	require.False(t, f.updateStatusDone, "status must not be written when the spec write fails")
 }
+
+func TestActiveCreator_AdmitErrorSkipsPersist(t *testing.T) {
+	f := &fakeRoleWriter{}
+	denied := errors.New("denied")
+	admit := func(context.Context, *roledom.Role) error { return denied }
+
+	_, err := activeCreator[*roledom.Role](f, admit, markRoleActive).Do(context.Background(), &roledom.Role{})
+
+	require.ErrorIs(t, err, denied)
+	require.False(t, f.createCalled, "spec must not be written when admission fails")
+	require.False(t, f.updateStatusDone)
+}
@@ -0,0 +1,143 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	sdkschema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+)
+
+// admissionPolicyKind is the metadata kind of an admission policy. SECA does not define
+// one, so it follows the kebab-case form of the specified kinds.
+const admissionPolicyKind = sdkschema.GlobalTenantResourceMetadataKind("admission-policy")
+
+// AdmissionPolicy is the API representation of an admission policy. SECA has no
+// admission-policy schema; the shape mirrors the specified tenant-scoped resources.
+type AdmissionPolicy struct {
+	Metadata    *sdkschema.GlobalTenantResourceMetadata `json:"metadata,omitempty"`
+	Labels      sdkschema.Labels                        `json:"labels"`
+	Annotations map[string]string                       `json:"annotations,omitempty"`
+	Extensions  map[string]string                       `json:"extensions,omitempty"`
+	Spec        AdmissionPolicySpec                     `json:"spec"`
+	Status      *AdmissionPolicyStatus                  `json:"status,omitempty"`
+}
+
+// AdmissionPolicySpec is the API representation of an admission policy's spec.
+type AdmissionPolicySpec struct {
+	Resources  []string `json:"resources,omitempty"`
+	Workspaces []string `json:"workspaces,omitempty"`
+	Expression string   `json:"expression"`
+	Message    string   `json:"message,omitempty"`
+	Field      string   `json:"field,omitempty"`
+	Mode       string   `json:"mode,omitempty"`
+}
+
+// AdmissionPolicyStatus is the API representation of an admission policy's status.
+type AdmissionPolicyStatus struct {
+	State      sdkschema.ResourceState     `json:"state,omitempty"`
+	Conditions []sdkschema.StatusCondition `json:"conditions"`
+}
+
+// AdmissionPolicyIterator is a page of admission policies.
+type AdmissionPolicyIterator struct {
+	Items    []AdmissionPolicy          `json:"items"`
+	Metadata sdkschema.ResponseMetadata `json:"metadata"`
+}
+
+// admissionPolicyToAPIWithVerb returns a func that converts an AdmissionPolicy to its API
+// representation with the given verb.
+func admissionPolicyToAPIWithVerb(verb string) func(ap *apdom.AdmissionPolicy) *AdmissionPolicy {
+	return func(ap *apdom.AdmissionPolicy) *AdmissionPolicy {
+		return admissionPolicyToAPI(*ap, verb)
+	}
+}
+
+// admissionPolicyIteratorToAPI converts a list of AdmissionPolicy to an AdmissionPolicyIterator.
+func admissionPolicyIteratorToAPI(policies []*apdom.AdmissionPolicy, nextSkipToken *string) *AdmissionPolicyIterator {
+	items := make([]AdmissionPolicy, len(policies))
+	for i, ap := range policies {
+		items[i] = *admissionPolicyToAPI(*ap, http.MethodGet)
+	}
+	return &AdmissionPolicyIterator{
+		Items: items,
+		Metadata: sdkschema.ResponseMetadata{
+			Provider:  apdom.ProviderID,
+			Resource:  apdom.Resource,
+			Verb:      http.MethodGet,
+			SkipToken: nextSkipToken,
+		},
+	}
+}
+
+// admissionPolicyToAPI converts an AdmissionPolicy to its API representation with the given verb.
+func admissionPolicyToAPI(ap apdom.AdmissionPolicy, verb string) *AdmissionPolicy {
+	resourceVersion := int64(0)
+	if parsed, err := strconv.ParseInt(ap.ResourceVersion, 10, 64); err == nil {
+		resourceVersion = parsed
+	}
+
+	api := &AdmissionPolicy{
+		Metadata: &sdkschema.GlobalTenantResourceMetadata{
+			ApiVersion:      apdom.Version,
+			CreatedAt:       ap.CreatedAt,
+			LastModifiedAt:  ap.UpdatedAt,
+			Kind:            admissionPolicyKind,
+			Name:            ap.Name,
+			Tenant:          ap.Tenant,
+			Provider:        ap.Provider,
+			Resource:        commondomain.FormatResource(admissionPolicyKind, ap.Name),
+			Ref:             commondomain.FormatTenantScopedRef(ap.Provider, ap.Tenant, admissionPolicyKind, ap.Name),
+			ResourceVersion: resourceVersion,
+			Verb:            verb,
+			DeletedAt:       ap.DeletedAt,
+		},
+		Labels:      ap.Labels,
+		Annotations: ap.Annotations,
+		Extensions:  ap.Extensions,
+		Spec: AdmissionPolicySpec{
+			Resources:  ap.Spec.Resources,
+			Workspaces: ap.Spec.Workspaces,
+			Expression: ap.Spec.Expression,
+			Message:    ap.Spec.Message,
+			Field:      ap.Spec.Field,
+			Mode:       string(ap.Spec.Mode),
+		},
+	}
+	if api.Labels == nil {
+		api.Labels = make(sdkschema.Labels)
+	}
+	if ap.Status != nil {
+		api.Status = &AdmissionPolicyStatus{
+			State:      commonfrontend.ResourceStateToAPI(ap.Status.State),
+			Conditions: commonfrontend.ConditionsToAPI(ap.Status.Conditions),
+		}
+	}
+	return api
+}
+
+// admissionPolicyFromAPI converts an API AdmissionPolicy to a domain AdmissionPolicy.
+func admissionPolicyFromAPI(api AdmissionPolicy, id *resource.Identity) *apdom.AdmissionPolicy {
+	ap := &apdom.AdmissionPolicy{
+		Spec: apdom.AdmissionPolicySpec{
+			Resources:  api.Spec.Resources,
+			Workspaces: api.Spec.Workspaces,
+			Expression: api.Spec.Expression,
+			Message:    api.Spec.Message,
+			Field:      api.Spec.Field,
+			Mode:       apdom.Mode(api.Spec.Mode),
+		},
+	}
+	ap.Name = id.GetName()
+	ap.ResourceVersion = id.GetVersion()
+	ap.Provider = apdom.ProviderID
+	ap.Tenant = id.GetTenant()
+	ap.Labels = api.Labels
+	ap.Annotations = api.Annotations
+	ap.Extensions = api.Extensions
+	return ap
+}
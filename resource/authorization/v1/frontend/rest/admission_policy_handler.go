@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+)
+
+// AdmissionPolicyCompiler checks that an admission policy's expression compiles in the
+// environment the regional gateways evaluate it in. Implementations return an error of
+// kind kernel.KindValidation when it does not, which the handler maps to 422.
+type AdmissionPolicyCompiler interface {
+	CompileAdmissionPolicy(ctx context.Context, ap *apdom.AdmissionPolicy) error
+}
+
+// RegisterAdmissionPolicyRoutes mounts the admission-policy routes under baseURL on mux.
+// SECA does not specify them, so they are not part of the generated ServerInterface. The
+// middlewares wrap every route the way oapi-codegen applies them: the last one runs first.
+//
+//	PUT    /v1/tenants/{tenant}/admission-policies/{name}
+//	GET    /v1/tenants/{tenant}/admission-policies[/{name}]
+//	DELETE /v1/tenants/{tenant}/admission-policies/{name}
+func (h *Handler) RegisterAdmissionPolicyRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	handle := func(pattern string, fn http.HandlerFunc) {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle(pattern, handler)
+	}
+	collection := baseURL + "/v1/tenants/{tenant}/" + apdom.Resource
+	handle("GET "+collection, h.ListAdmissionPolicies)
+	handle("GET "+collection+"/{name}", h.GetAdmissionPolicy)
+	handle("PUT "+collection+"/{name}", h.CreateOrUpdateAdmissionPolicy)
+	handle("DELETE "+collection+"/{name}", h.DeleteAdmissionPolicy)
+}
+
+// ListAdmissionPolicies handles GET /v1/tenants/{tenant}/admission-policies.
+func (h *Handler) ListAdmissionPolicies(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "authorization", "resource", "admission-policy")
+	params := serviceAccountListParams(r, r.PathValue("tenant"))
+	frest.HandleList(w, r, logger, params, frest.ListerFromRepo(h.AdmissionPolicyReader), admissionPolicyIteratorToAPI)
+}
+
+// GetAdmissionPolicy handles GET /v1/tenants/{tenant}/admission-policies/{name}.
+func (h *Handler) GetAdmissionPolicy(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "admission-policy", "name", id.Name)
+	frest.HandleGet(w, r, logger, id, frest.GetterFromRepo(h.AdmissionPolicyReader, newAdmissionPolicyWithIdentity), admissionPolicyToAPIWithVerb(http.MethodGet))
+}
+
+// CreateOrUpdateAdmissionPolicy handles PUT /v1/tenants/{tenant}/admission-policies/{name}.
+// A policy whose expression does not compile is rejected here rather than failing every
+// write it applies to once the regional gateways pick it up.
+func (h *Handler) CreateOrUpdateAdmissionPolicy(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "admission-policy", "name", id.Name)
+	admit := h.admitAdmissionPolicy()
+	frest.HandleUpsert(w, r, logger, frest.UpsertOptions[AdmissionPolicy, *apdom.AdmissionPolicy, *AdmissionPolicy]{
+		Params:  id,
+		Creator: activeCreator(h.AdmissionPolicyWriter, admit, markAdmissionPolicyActive),
+		Updater: activeUpdater(h.AdmissionPolicyWriter, admit, markAdmissionPolicyActive),
+		APIToDomain: func(api AdmissionPolicy, p persistencepkg.IdentifiableResource) *apdom.AdmissionPolicy {
+			return admissionPolicyFromAPI(api, p.(*resource.Identity))
+		},
+		DomainToAPI: admissionPolicyToAPIWithVerb(http.MethodPut),
+	})
+}
+
+// DeleteAdmissionPolicy handles DELETE /v1/tenants/{tenant}/admission-policies/{name}.
+func (h *Handler) DeleteAdmissionPolicy(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "admission-policy", "name", id.Name)
+	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.AdmissionPolicyWriter, newAdmissionPolicyWithIdentity))
+}
+
+// admitAdmissionPolicy returns the admission hook for AdmissionPolicy writes: validation,
+// then the compiler if set.
+func (h *Handler) admitAdmissionPolicy() func(context.Context, *apdom.AdmissionPolicy) error {
+	if h.PolicyCompiler == nil {
+		return admitWith(validateAdmissionPolicy, nil)
+	}
+	return admitWith(validateAdmissionPolicy, h.PolicyCompiler.CompileAdmissionPolicy)
+}
+
+// newAdmissionPolicyWithIdentity returns a *apdom.AdmissionPolicy populated with identity fields from ir.
+func newAdmissionPolicyWithIdentity(ir persistencepkg.IdentifiableResource) *apdom.AdmissionPolicy {
+	ap := &apdom.AdmissionPolicy{}
+	ap.Name = ir.GetName()
+	ap.Tenant = ir.GetTenant()
+	ap.ResourceVersion = ir.GetVersion()
+	return ap
+}
@@ -0,0 +1,103 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+)
+
+// fakeAdmissionPolicyStore keeps admission policies in memory by name.
+type fakeAdmissionPolicyStore struct {
+	entries map[string]*apdom.AdmissionPolicy
+}
+
+func (f *fakeAdmissionPolicyStore) List(context.Context, resource.ListFilter, *[]*apdom.AdmissionPolicy) (*string, error) {
+	return nil, nil
+}
+
+func (f *fakeAdmissionPolicyStore) Load(_ context.Context, m **apdom.AdmissionPolicy) error {
+	stored, ok := f.entries[(*m).Name]
+	if !ok {
+		return kernel.ErrNotFound
+	}
+	cp := *stored
+	*m = &cp
+	return nil
+}
+
+func (f *fakeAdmissionPolicyStore) Create(_ context.Context, m *apdom.AdmissionPolicy) (**apdom.AdmissionPolicy, error) {
+	f.entries[m.Name] = m
+	return &m, nil
+}
+
+func (f *fakeAdmissionPolicyStore) Update(_ context.Context, m *apdom.AdmissionPolicy) (**apdom.AdmissionPolicy, error) {
+	f.entries[m.Name] = m
+	return &m, nil
+}
+
+func (f *fakeAdmissionPolicyStore) UpdateStatus(_ context.Context, m *apdom.AdmissionPolicy) (**apdom.AdmissionPolicy, error) {
+	return &m, nil
+}
+
+func (f *fakeAdmissionPolicyStore) Delete(_ context.Context, m *apdom.AdmissionPolicy) error {
+	delete(f.entries, m.Name)
+	return nil
+}
+
+// rejectingCompiler rejects every expression ending in a dangling "==".
+type rejectingCompiler struct{}
+
+func (rejectingCompiler) CompileAdmissionPolicy(_ context.Context, ap *apdom.AdmissionPolicy) error {
+	if strings.HasSuffix(ap.Spec.Expression, "==") {
+		return kernel.NewError(kernel.KindValidation, fmt.Errorf("expression does not compile"),
+			kernel.ErrorSource{Name: "/spec/expression", Value: ap.Spec.Expression})
+	}
+	return nil
+}
+
+func TestCreateOrUpdateAdmissionPolicy(t *testing.T) {
+	store := &fakeAdmissionPolicyStore{entries: map[string]*apdom.AdmissionPolicy{}}
+	h := &Handler{AdmissionPolicyReader: store, AdmissionPolicyWriter: store, PolicyCompiler: rejectingCompiler{}, Logger: slog.Default()}
+	mux := http.NewServeMux()
+	h.RegisterAdmissionPolicyRoutes(mux, "/providers/seca.authorization")
+
+	put := func(name, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPut, "/providers/seca.authorization/v1/tenants/acme/admission-policies/"+name, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := put("cost-center", `{"spec":{"resources":["instances"],"expression":"'cost-center' in object.Labels","field":"/labels","mode":"audit"}}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var created AdmissionPolicy
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.Equal(t, "audit", created.Spec.Mode)
+	require.NotNil(t, created.Status)
+	stored := store.entries["cost-center"]
+	require.Equal(t, "acme", stored.Tenant)
+	require.Equal(t, []string{"instances"}, stored.Spec.Resources)
+	require.True(t, stored.Spec.Audit())
+
+	for _, body := range []string{`{"spec":{}}`, `{"spec":{"expression":"true","mode":"warn"}}`, `{"spec":{"expression":"object.Name =="}}`} {
+		require.Equal(t, http.StatusUnprocessableEntity, put("broken", body).Code, body)
+	}
+	require.NotContains(t, store.entries, "broken")
+
+	req := httptest.NewRequest(http.MethodDelete, "/providers/seca.authorization/v1/tenants/acme/admission-policies/cost-center", nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	require.Empty(t, store.entries)
+}
@@ -2,24 +2,86 @@
 package rest
 
 import (
+	"context"
 	"log/slog"
 
 	sdkauth "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.authorization.v1"
 
 	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
 	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
 	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+	trdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation"
 )
 
 // Handler is the HTTP handler for the authorization API group.
 // It owns the group's sdkauth.ServerInterface: role methods are implemented in
-// role_handler.go and role-assignment methods in role_assignment_handler.go.
+// role_handler.go and role-assignment methods in role_assignment_handler.go. The
+// service-account routes, which SECA does not specify, are in service_account_handler.go
+// and mounted with RegisterServiceAccountRoutes; the admin routes of the token revocation
+// list are in token_revocation_handler.go and mounted with RegisterTokenRevocationRoutes;
+// the admission-policy routes are in admission_policy_handler.go and mounted with
+// RegisterAdmissionPolicyRoutes; the residency-policy routes are in
+// residency_policy_handler.go and mounted with RegisterResidencyPolicyRoutes.
 type Handler struct {
-	RoleReader           persistencepkg.ReaderRepo[*roledom.Role]
-	RoleWriter           persistencepkg.WriterRepo[*roledom.Role]
-	RoleAssignmentReader persistencepkg.ReaderRepo[*radom.RoleAssignment]
-	RoleAssignmentWriter persistencepkg.WriterRepo[*radom.RoleAssignment]
-	Logger               *slog.Logger
+	RoleReader            persistencepkg.ReaderRepo[*roledom.Role]
+	RoleWriter            persistencepkg.WriterRepo[*roledom.Role]
+	RoleAssignmentReader  persistencepkg.ReaderRepo[*radom.RoleAssignment]
+	RoleAssignmentWriter  persistencepkg.WriterRepo[*radom.RoleAssignment]
+	ServiceAccountReader  persistencepkg.ReaderRepo[*sadom.ServiceAccount]
+	ServiceAccountWriter  persistencepkg.WriterRepo[*sadom.ServiceAccount]
+	TokenRevocationReader persistencepkg.ReaderRepo[*trdom.TokenRevocation]
+	TokenRevocationWriter persistencepkg.WriterRepo[*trdom.TokenRevocation]
+	AdmissionPolicyReader persistencepkg.ReaderRepo[*apdom.AdmissionPolicy]
+	AdmissionPolicyWriter persistencepkg.WriterRepo[*apdom.AdmissionPolicy]
+	ResidencyPolicyReader persistencepkg.ReaderRepo[*rpdom.ResidencyPolicy]
+	ResidencyPolicyWriter persistencepkg.WriterRepo[*rpdom.ResidencyPolicy]
+	Logger                *slog.Logger
+
+	// TokenIssuer, when set, signs service-account tokens. A nil TokenIssuer leaves the
+	// token route unmounted.
+	TokenIssuer TokenIssuer
+
+	// Guard, when set, vets every Role and RoleAssignment write before it is persisted.
+	// A nil Guard admits every write the authorization middleware already let through.
+	Guard EscalationGuard
+
+	// PolicyCompiler, when set, rejects AdmissionPolicy writes whose expression does not
+	// compile. A nil PolicyCompiler only checks the policy's shape.
+	PolicyCompiler AdmissionPolicyCompiler
+}
+
+// EscalationGuard rejects Role and RoleAssignment writes that would grant permissions the
+// caller does not hold itself. The caller is taken from the request context. Implementations
+// return an error of kind kernel.KindForbidden on denial, which the handler maps to 403.
+type EscalationGuard interface {
+	AuthorizeRoleWrite(ctx context.Context, r *roledom.Role) error
+	AuthorizeRoleAssignmentWrite(ctx context.Context, ra *radom.RoleAssignment) error
+}
+
+// admitRole returns the admission hook for Role writes: validation, the system-managed
+// role check, then the guard if set.
+func (h *Handler) admitRole() func(context.Context, *roledom.Role) error {
+	return admitWith(validateRole, func(ctx context.Context, r *roledom.Role) error {
+		if err := h.rejectSystemManagedRole(ctx, r); err != nil {
+			return err
+		}
+		if h.Guard == nil {
+			return nil
+		}
+		return h.Guard.AuthorizeRoleWrite(ctx, r)
+	})
+}
+
+// admitRoleAssignment returns the admission hook for RoleAssignment writes: validation, then
+// the guard if set.
+func (h *Handler) admitRoleAssignment() func(context.Context, *radom.RoleAssignment) error {
+	if h.Guard == nil {
+		return admitWith(validateRoleAssignment, nil)
+	}
+	return admitWith(validateRoleAssignment, h.Guard.AuthorizeRoleAssignmentWrite)
 }
 
 var _ sdkauth.ServerInterface = (*Handler)(nil)
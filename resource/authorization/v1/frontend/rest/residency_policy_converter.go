@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	sdkschema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+)
+
+// residencyPolicyKind is the metadata kind of a residency policy. SECA does not define
+// one, so it follows the kebab-case form of the specified kinds.
+const residencyPolicyKind = sdkschema.GlobalTenantResourceMetadataKind("residency-policy")
+
+// ResidencyPolicy is the API representation of a residency policy. SECA has no
+// residency-policy schema; the shape mirrors the specified tenant-scoped resources.
+type ResidencyPolicy struct {
+	Metadata    *sdkschema.GlobalTenantResourceMetadata `json:"metadata,omitempty"`
+	Labels      sdkschema.Labels                        `json:"labels"`
+	Annotations map[string]string                       `json:"annotations,omitempty"`
+	Extensions  map[string]string                       `json:"extensions,omitempty"`
+	Spec        ResidencyPolicySpec                     `json:"spec"`
+	Status      *ResidencyPolicyStatus                  `json:"status,omitempty"`
+}
+
+// ResidencyPolicySpec is the API representation of a residency policy's spec.
+type ResidencyPolicySpec struct {
+	Regions   []string `json:"regions,omitempty"`
+	Providers []string `json:"providers,omitempty"`
+	Zones     []string `json:"zones,omitempty"`
+}
+
+// ResidencyPolicyStatus is the API representation of a residency policy's status.
+type ResidencyPolicyStatus struct {
+	State      sdkschema.ResourceState     `json:"state,omitempty"`
+	Conditions []sdkschema.StatusCondition `json:"conditions"`
+}
+
+// ResidencyPolicyIterator is a page of residency policies.
+type ResidencyPolicyIterator struct {
+	Items    []ResidencyPolicy          `json:"items"`
+	Metadata sdkschema.ResponseMetadata `json:"metadata"`
+}
+
+// residencyPolicyToAPIWithVerb returns a func that converts a ResidencyPolicy to its API
+// representation with the given verb.
+func residencyPolicyToAPIWithVerb(verb string) func(rp *rpdom.ResidencyPolicy) *ResidencyPolicy {
+	return func(rp *rpdom.ResidencyPolicy) *ResidencyPolicy {
+		return residencyPolicyToAPI(*rp, verb)
+	}
+}
+
+// residencyPolicyIteratorToAPI converts a list of ResidencyPolicy to a ResidencyPolicyIterator.
+func residencyPolicyIteratorToAPI(policies []*rpdom.ResidencyPolicy, nextSkipToken *string) *ResidencyPolicyIterator {
+	items := make([]ResidencyPolicy, len(policies))
+	for i, rp := range policies {
+		items[i] = *residencyPolicyToAPI(*rp, http.MethodGet)
+	}
+	return &ResidencyPolicyIterator{
+		Items: items,
+		Metadata: sdkschema.ResponseMetadata{
+			Provider:  rpdom.ProviderID,
+			Resource:  rpdom.Resource,
+			Verb:      http.MethodGet,
+			SkipToken: nextSkipToken,
+		},
+	}
+}
+
+// residencyPolicyToAPI converts a ResidencyPolicy to its API representation with the given verb.
+func residencyPolicyToAPI(rp rpdom.ResidencyPolicy, verb string) *ResidencyPolicy {
+	resourceVersion := int64(0)
+	if parsed, err := strconv.ParseInt(rp.ResourceVersion, 10, 64); err == nil {
+		resourceVersion = parsed
+	}
+
+	api := &ResidencyPolicy{
+		Metadata: &sdkschema.GlobalTenantResourceMetadata{
+			ApiVersion:      rpdom.Version,
+			CreatedAt:       rp.CreatedAt,
+			LastModifiedAt:  rp.UpdatedAt,
+			Kind:            residencyPolicyKind,
+			Name:            rp.Name,
+			Tenant:          rp.Tenant,
+			Provider:        rp.Provider,
+			Resource:        commondomain.FormatResource(residencyPolicyKind, rp.Name),
+			Ref:             commondomain.FormatTenantScopedRef(rp.Provider, rp.Tenant, residencyPolicyKind, rp.Name),
+			ResourceVersion: resourceVersion,
+			Verb:            verb,
+			DeletedAt:       rp.DeletedAt,
+		},
+		Labels:      rp.Labels,
+		Annotations: rp.Annotations,
+		Extensions:  rp.Extensions,
+		Spec: ResidencyPolicySpec{
+			Regions:   rp.Spec.Regions,
+			Providers: rp.Spec.Providers,
+			Zones:     rp.Spec.Zones,
+		},
+	}
+	if api.Labels == nil {
+		api.Labels = make(sdkschema.Labels)
+	}
+	if rp.Status != nil {
+		api.Status = &ResidencyPolicyStatus{
+			State:      commonfrontend.ResourceStateToAPI(rp.Status.State),
+			Conditions: commonfrontend.ConditionsToAPI(rp.Status.Conditions),
+		}
+	}
+	return api
+}
+
+// residencyPolicyFromAPI converts an API ResidencyPolicy to a domain ResidencyPolicy.
+func residencyPolicyFromAPI(api ResidencyPolicy, id *resource.Identity) *rpdom.ResidencyPolicy {
+	rp := &rpdom.ResidencyPolicy{
+		Spec: rpdom.ResidencyPolicySpec{
+			Regions:   api.Spec.Regions,
+			Providers: api.Spec.Providers,
+			Zones:     api.Spec.Zones,
+		},
+	}
+	rp.Name = id.GetName()
+	rp.ResourceVersion = id.GetVersion()
+	rp.Provider = rpdom.ProviderID
+	rp.Tenant = id.GetTenant()
+	rp.Labels = api.Labels
+	rp.Annotations = api.Annotations
+	rp.Extensions = api.Extensions
+	return rp
+}
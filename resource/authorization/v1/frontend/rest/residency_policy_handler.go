@@ -0,0 +1,80 @@
+package rest
+
+import (
+	"net/http"
+
+	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
+)
+
+// RegisterResidencyPolicyRoutes mounts the residency-policy routes under baseURL on mux.
+// SECA does not specify them, so they are not part of the generated ServerInterface. The
+// middlewares wrap every route the way oapi-codegen applies them: the last one runs first.
+//
+//	PUT    /v1/tenants/{tenant}/residency-policies/{name}
+//	GET    /v1/tenants/{tenant}/residency-policies[/{name}]
+//	DELETE /v1/tenants/{tenant}/residency-policies/{name}
+func (h *Handler) RegisterResidencyPolicyRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	handle := func(pattern string, fn http.HandlerFunc) {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle(pattern, handler)
+	}
+	collection := baseURL + "/v1/tenants/{tenant}/" + rpdom.Resource
+	handle("GET "+collection, h.ListResidencyPolicies)
+	handle("GET "+collection+"/{name}", h.GetResidencyPolicy)
+	handle("PUT "+collection+"/{name}", h.CreateOrUpdateResidencyPolicy)
+	handle("DELETE "+collection+"/{name}", h.DeleteResidencyPolicy)
+}
+
+// ListResidencyPolicies handles GET /v1/tenants/{tenant}/residency-policies.
+func (h *Handler) ListResidencyPolicies(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "authorization", "resource", "residency-policy")
+	params := serviceAccountListParams(r, r.PathValue("tenant"))
+	frest.HandleList(w, r, logger, params, frest.ListerFromRepo(h.ResidencyPolicyReader), residencyPolicyIteratorToAPI)
+}
+
+// GetResidencyPolicy handles GET /v1/tenants/{tenant}/residency-policies/{name}.
+func (h *Handler) GetResidencyPolicy(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "residency-policy", "name", id.Name)
+	frest.HandleGet(w, r, logger, id, frest.GetterFromRepo(h.ResidencyPolicyReader, newResidencyPolicyWithIdentity), residencyPolicyToAPIWithVerb(http.MethodGet))
+}
+
+// CreateOrUpdateResidencyPolicy handles PUT /v1/tenants/{tenant}/residency-policies/{name}.
+// A policy applies to writes as soon as the regional gateways see it; resources that
+// already live outside it are left in place.
+func (h *Handler) CreateOrUpdateResidencyPolicy(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "residency-policy", "name", id.Name)
+	admit := admitWith(validateResidencyPolicy, nil)
+	frest.HandleUpsert(w, r, logger, frest.UpsertOptions[ResidencyPolicy, *rpdom.ResidencyPolicy, *ResidencyPolicy]{
+		Params:  id,
+		Creator: activeCreator(h.ResidencyPolicyWriter, admit, markResidencyPolicyActive),
+		Updater: activeUpdater(h.ResidencyPolicyWriter, admit, markResidencyPolicyActive),
+		APIToDomain: func(api ResidencyPolicy, p persistencepkg.IdentifiableResource) *rpdom.ResidencyPolicy {
+			return residencyPolicyFromAPI(api, p.(*resource.Identity))
+		},
+		DomainToAPI: residencyPolicyToAPIWithVerb(http.MethodPut),
+	})
+}
+
+// DeleteResidencyPolicy handles DELETE /v1/tenants/{tenant}/residency-policies/{name}.
+func (h *Handler) DeleteResidencyPolicy(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "residency-policy", "name", id.Name)
+	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.ResidencyPolicyWriter, newResidencyPolicyWithIdentity))
+}
+
+// newResidencyPolicyWithIdentity returns a *rpdom.ResidencyPolicy populated with identity fields from ir.
+func newResidencyPolicyWithIdentity(ir persistencepkg.IdentifiableResource) *rpdom.ResidencyPolicy {
+	rp := &rpdom.ResidencyPolicy{}
+	rp.Name = ir.GetName()
+	rp.Tenant = ir.GetTenant()
+	rp.ResourceVersion = ir.GetVersion()
+	return rp
+}
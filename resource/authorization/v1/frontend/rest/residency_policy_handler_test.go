@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
+)
+
+// fakeResidencyPolicyStore keeps residency policies in memory by name.
+type fakeResidencyPolicyStore struct {
+	entries map[string]*rpdom.ResidencyPolicy
+}
+
+func (f *fakeResidencyPolicyStore) List(context.Context, resource.ListFilter, *[]*rpdom.ResidencyPolicy) (*string, error) {
+	return nil, nil
+}
+
+func (f *fakeResidencyPolicyStore) Load(_ context.Context, m **rpdom.ResidencyPolicy) error {
+	stored, ok := f.entries[(*m).Name]
+	if !ok {
+		return kernel.ErrNotFound
+	}
+	cp := *stored
+	*m = &cp
+	return nil
+}
+
+func (f *fakeResidencyPolicyStore) Create(_ context.Context, m *rpdom.ResidencyPolicy) (**rpdom.ResidencyPolicy, error) {
+	f.entries[m.Name] = m
+	return &m, nil
+}
+
+func (f *fakeResidencyPolicyStore) Update(_ context.Context, m *rpdom.ResidencyPolicy) (**rpdom.ResidencyPolicy, error) {
+	f.entries[m.Name] = m
+	return &m, nil
+}
+
+func (f *fakeResidencyPolicyStore) UpdateStatus(_ context.Context, m *rpdom.ResidencyPolicy) (**rpdom.ResidencyPolicy, error) {
+	return &m, nil
+}
+
+func (f *fakeResidencyPolicyStore) Delete(_ context.Context, m *rpdom.ResidencyPolicy) error {
+	delete(f.entries, m.Name)
+	return nil
+}
+
+func TestCreateOrUpdateResidencyPolicy(t *testing.T) {
+	store := &fakeResidencyPolicyStore{entries: map[string]*rpdom.ResidencyPolicy{}}
+	h := &Handler{ResidencyPolicyReader: store, ResidencyPolicyWriter: store, Logger: slog.Default()}
+	mux := http.NewServeMux()
+	h.RegisterResidencyPolicyRoutes(mux, "/providers/seca.authorization")
+
+	serve := func(method, name, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/providers/seca.authorization/v1/tenants/acme/residency-policies/"+name, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(http.MethodPut, "italy", `{"spec":{"regions":["itbg-bergamo"],"providers":["seca.compute","seca.storage"],"zones":["itbg-1"]}}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var created ResidencyPolicy
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.Equal(t, []string{"itbg-bergamo"}, created.Spec.Regions)
+	require.NotNil(t, created.Status)
+	require.Equal(t, "seca.authorization/v1/tenants/acme/residency-policies/italy", created.Metadata.Ref)
+	require.Equal(t, "acme", store.entries["italy"].Tenant)
+
+	rec = serve(http.MethodPut, "broken", `{"spec":{"providers":["aws.ec2"]}}`)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.Contains(t, rec.Body.String(), "/spec/providers/0")
+	require.NotContains(t, store.entries, "broken")
+
+	require.Equal(t, http.StatusAccepted, serve(http.MethodDelete, "italy", "").Code)
+	require.Empty(t, store.entries)
+}
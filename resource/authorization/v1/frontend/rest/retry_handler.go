@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+)
+
+// RegisterRetryRoutes registers the retry action, POST .../{name}/retry, of the roles and
+// role assignments on mux under baseURL, wrapped in middlewares. The SECA spec has no such
+// action, so the routes are not part of the generated server.
+func (h *Handler) RegisterRetryRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	tenant := baseURL + "/v1/tenants/{tenant}"
+	for collection, fn := range map[string]http.HandlerFunc{
+		tenant + "/" + roledom.Resource: h.RetryRole,
+		tenant + "/" + radom.Resource:   h.RetryRoleAssignment,
+	} {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle("POST "+collection+"/{name}/"+commonfrontend.RetryAction, handler)
+	}
+}
+
+// RetryRole handles POST /v1/tenants/{tenant}/roles/{name}/retry.
+func (h *Handler) RetryRole(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "authorization", "resource", "role")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant")}}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.RoleReader, h.RoleWriter, newRoleWithIdentity,
+		func(role *roledom.Role) *commondomain.Status {
+			if role.Status == nil {
+				return nil
+			}
+			return &role.Status.Status
+		})
+}
+
+// RetryRoleAssignment handles POST /v1/tenants/{tenant}/role-assignments/{name}/retry.
+func (h *Handler) RetryRoleAssignment(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "authorization", "resource", "role-assignment")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant")}}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.RoleAssignmentReader, h.RoleAssignmentWriter, newRoleAssignmentWithIdentity,
+		func(ra *radom.RoleAssignment) *commondomain.Status {
+			if ra.Status == nil {
+				return nil
+			}
+			return &ra.Status.Status
+		})
+}
@@ -45,8 +45,8 @@ func (h *Handler) CreateOrUpdateRoleAssignment(w http.ResponseWriter, r *http.Re
 	}
 	frest.HandleUpsert(w, r, logger, frest.UpsertOptions[sdkschema.RoleAssignment, *radom.RoleAssignment, *sdkschema.RoleAssignment]{
 		Params:  id,
-		Creator: activeCreator(h.RoleAssignmentWriter, markRoleAssignmentActive),
-		Updater: activeUpdater(h.RoleAssignmentWriter, markRoleAssignmentActive),
+		Creator: activeCreator(h.RoleAssignmentWriter, h.admitRoleAssignment(), markRoleAssignmentActive),
+		Updater: activeUpdater(h.RoleAssignmentWriter, h.admitRoleAssignment(), markRoleAssignmentActive),
 		APIToDomain: func(sdk sdkschema.RoleAssignment, p persistencepkg.IdentifiableResource) *radom.RoleAssignment {
 			return roleAssignmentFromAPI(sdk, p.(*resource.Identity))
 		},
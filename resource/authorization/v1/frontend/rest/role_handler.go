@@ -26,7 +26,10 @@ func (h *Handler) DeleteRole(w http.ResponseWriter, r *http.Request, tenant sdks
 	if params.IfUnmodifiedSince != nil {
 		id.Version = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.RoleWriter, newRoleWithIdentity))
+	frest.HandleDelete(w, r, logger, id, guardedDeleter{
+		check: h.rejectSystemManagedRole,
+		next:  frest.DeleterFromRepo(h.RoleWriter, newRoleWithIdentity),
+	})
 }
 
 // GetRole handles GET /v1/tenants/{tenant}/roles/{name}.
@@ -45,8 +48,8 @@ func (h *Handler) CreateOrUpdateRole(w http.ResponseWriter, r *http.Request, ten
 	}
 	frest.HandleUpsert(w, r, logger, frest.UpsertOptions[sdkschema.Role, *roledom.Role, *sdkschema.Role]{
 		Params:  id,
-		Creator: activeCreator(h.RoleWriter, markRoleActive),
-		Updater: activeUpdater(h.RoleWriter, markRoleActive),
+		Creator: activeCreator(h.RoleWriter, h.admitRole(), markRoleActive),
+		Updater: activeUpdater(h.RoleWriter, h.admitRole(), markRoleActive),
 		APIToDomain: func(sdk sdkschema.Role, p persistencepkg.IdentifiableResource) *roledom.Role {
 			return roleFromAPI(sdk, p.(*resource.Identity))
 		},
@@ -0,0 +1,168 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	sdkschema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/validation"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+)
+
+// serviceAccountKind is the metadata kind of a service account. SECA does not define one,
+// so it follows the kebab-case form of the specified kinds.
+const serviceAccountKind = sdkschema.GlobalTenantResourceMetadataKind("service-account")
+
+// ServiceAccount is the API representation of a service account. SECA has no
+// service-account schema; the shape mirrors the specified tenant-scoped resources.
+type ServiceAccount struct {
+	Metadata    *sdkschema.GlobalTenantResourceMetadata `json:"metadata,omitempty"`
+	Labels      sdkschema.Labels                        `json:"labels"`
+	Annotations map[string]string                       `json:"annotations,omitempty"`
+	Extensions  map[string]string                       `json:"extensions,omitempty"`
+	Spec        ServiceAccountSpec                      `json:"spec"`
+	Status      *ServiceAccountStatus                   `json:"status,omitempty"`
+}
+
+// ServiceAccountSpec is the API representation of a service account's spec.
+type ServiceAccountSpec struct {
+	Description     string     `json:"description,omitempty"`
+	Disabled        bool       `json:"disabled,omitempty"`
+	TokensNotBefore *time.Time `json:"tokensNotBefore,omitempty"`
+}
+
+// ServiceAccountStatus is the API representation of a service account's status.
+type ServiceAccountStatus struct {
+	State      sdkschema.ResourceState     `json:"state,omitempty"`
+	Conditions []sdkschema.StatusCondition `json:"conditions"`
+}
+
+// ServiceAccountIterator is a page of service accounts.
+type ServiceAccountIterator struct {
+	Items    []ServiceAccount           `json:"items"`
+	Metadata sdkschema.ResponseMetadata `json:"metadata"`
+}
+
+// TokenRequest is the body of POST .../service-accounts/{name}/tokens.
+type TokenRequest struct {
+	// ExpirationSeconds is the requested lifetime; zero or absent asks for the default.
+	ExpirationSeconds int64 `json:"expirationSeconds,omitempty"`
+	// Scope down-scopes the token; its tenants may only name the service account's tenant.
+	Scope *resource.TokenScope `json:"scope,omitempty"`
+}
+
+// TokenResponse carries an issued token. The token is not stored and cannot be retrieved again.
+type TokenResponse struct {
+	Token     string    `json:"token"`
+	Subject   string    `json:"subject"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// serviceAccountListParams reads the list query parameters shared by every SECA list route.
+func serviceAccountListParams(r *http.Request, tenant string) resource.ListParams {
+	query := r.URL.Query()
+	var limit *int
+	if raw := query.Get("limit"); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil {
+			limit = &parsed
+		}
+	}
+	return resource.ListParams{
+		Scope:     resource.Scope{Tenant: tenant},
+		Limit:     validation.GetLimit(limit),
+		SkipToken: query.Get("skipToken"),
+		Selector:  query.Get("labels"),
+	}
+}
+
+// serviceAccountToAPIWithVerb returns a func that converts a ServiceAccount to its API
+// representation with the given verb.
+func serviceAccountToAPIWithVerb(verb string) func(sa *sadom.ServiceAccount) *ServiceAccount {
+	return func(sa *sadom.ServiceAccount) *ServiceAccount {
+		return serviceAccountToAPI(*sa, verb)
+	}
+}
+
+// serviceAccountIteratorToAPI converts a list of ServiceAccount to a ServiceAccountIterator.
+func serviceAccountIteratorToAPI(accounts []*sadom.ServiceAccount, nextSkipToken *string) *ServiceAccountIterator {
+	items := make([]ServiceAccount, len(accounts))
+	for i, sa := range accounts {
+		items[i] = *serviceAccountToAPI(*sa, http.MethodGet)
+	}
+	return &ServiceAccountIterator{
+		Items: items,
+		Metadata: sdkschema.ResponseMetadata{
+			Provider:  sadom.ProviderID,
+			Resource:  sadom.Resource,
+			Verb:      http.MethodGet,
+			SkipToken: nextSkipToken,
+		},
+	}
+}
+
+// serviceAccountToAPI converts a ServiceAccount to its API representation with the given verb.
+func serviceAccountToAPI(sa sadom.ServiceAccount, verb string) *ServiceAccount {
+	resourceVersion := int64(0)
+	if parsed, err := strconv.ParseInt(sa.ResourceVersion, 10, 64); err == nil {
+		resourceVersion = parsed
+	}
+
+	api := &ServiceAccount{
+		Metadata: &sdkschema.GlobalTenantResourceMetadata{
+			ApiVersion:      sadom.Version,
+			CreatedAt:       sa.CreatedAt,
+			LastModifiedAt:  sa.UpdatedAt,
+			Kind:            serviceAccountKind,
+			Name:            sa.Name,
+			Tenant:          sa.Tenant,
+			Provider:        sa.Provider,
+			Resource:        commondomain.FormatResource(serviceAccountKind, sa.Name),
+			Ref:             commondomain.FormatTenantScopedRef(sa.Provider, sa.Tenant, serviceAccountKind, sa.Name),
+			ResourceVersion: resourceVersion,
+			Verb:            verb,
+			DeletedAt:       sa.DeletedAt,
+		},
+		Labels:      sa.Labels,
+		Annotations: sa.Annotations,
+		Extensions:  sa.Extensions,
+		Spec: ServiceAccountSpec{
+			Description:     sa.Spec.Description,
+			Disabled:        sa.Spec.Disabled,
+			TokensNotBefore: sa.Spec.TokensNotBefore,
+		},
+	}
+	if api.Labels == nil {
+		api.Labels = make(sdkschema.Labels)
+	}
+	if sa.Status != nil {
+		api.Status = &ServiceAccountStatus{
+			State:      commonfrontend.ResourceStateToAPI(sa.Status.State),
+			Conditions: commonfrontend.ConditionsToAPI(sa.Status.Conditions),
+		}
+	}
+	return api
+}
+
+// serviceAccountFromAPI converts an API ServiceAccount to a domain ServiceAccount.
+func serviceAccountFromAPI(api ServiceAccount, id *resource.Identity) *sadom.ServiceAccount {
+	sa := &sadom.ServiceAccount{
+		Spec: sadom.ServiceAccountSpec{
+			Description:     api.Spec.Description,
+			Disabled:        api.Spec.Disabled,
+			TokensNotBefore: api.Spec.TokensNotBefore,
+		},
+	}
+	sa.Name = id.GetName()
+	sa.ResourceVersion = id.GetVersion()
+	sa.Provider = sadom.ProviderID
+	sa.Tenant = id.GetTenant()
+	sa.Labels = api.Labels
+	sa.Annotations = api.Annotations
+	sa.Extensions = api.Extensions
+	return sa
+}
@@ -0,0 +1,207 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	sdkschema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+)
+
+// TokenIssuer signs service-account tokens. Implementations return an error of kind
+// kernel.KindValidation when the request asks for more than they issue, e.g. a lifetime
+// above their maximum.
+type TokenIssuer interface {
+	IssueToken(ctx context.Context, sa *sadom.ServiceAccount, req sadom.TokenRequest) (sadom.Token, error)
+}
+
+// RegisterServiceAccountRoutes mounts the service-account routes under baseURL on mux.
+// SECA does not specify them, so they are not part of the generated ServerInterface. The
+// middlewares wrap every route the way oapi-codegen applies them: the last one runs first.
+// The token route is only mounted when h.TokenIssuer is set.
+//
+//	PUT    /v1/tenants/{tenant}/service-accounts/{name}
+//	GET    /v1/tenants/{tenant}/service-accounts[/{name}]
+//	DELETE /v1/tenants/{tenant}/service-accounts/{name}
+//	POST   /v1/tenants/{tenant}/service-accounts/{name}/tokens
+func (h *Handler) RegisterServiceAccountRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	handle := func(pattern string, fn http.HandlerFunc) {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle(pattern, handler)
+	}
+	collection := baseURL + "/v1/tenants/{tenant}/" + sadom.Resource
+	handle("GET "+collection, h.ListServiceAccounts)
+	handle("GET "+collection+"/{name}", h.GetServiceAccount)
+	handle("PUT "+collection+"/{name}", h.CreateOrUpdateServiceAccount)
+	handle("DELETE "+collection+"/{name}", h.DeleteServiceAccount)
+	if h.TokenIssuer != nil {
+		handle("POST "+collection+"/{name}/tokens", h.CreateServiceAccountToken)
+	}
+}
+
+// ListServiceAccounts handles GET /v1/tenants/{tenant}/service-accounts.
+func (h *Handler) ListServiceAccounts(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "authorization", "resource", "service-account")
+	params := serviceAccountListParams(r, r.PathValue("tenant"))
+	frest.HandleList(w, r, logger, params, frest.ListerFromRepo(h.ServiceAccountReader), serviceAccountIteratorToAPI)
+}
+
+// GetServiceAccount handles GET /v1/tenants/{tenant}/service-accounts/{name}.
+func (h *Handler) GetServiceAccount(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "service-account", "name", id.Name)
+	frest.HandleGet(w, r, logger, id, frest.GetterFromRepo(h.ServiceAccountReader, newServiceAccountWithIdentity), serviceAccountToAPIWithVerb(http.MethodGet))
+}
+
+// CreateOrUpdateServiceAccount handles PUT /v1/tenants/{tenant}/service-accounts/{name}.
+func (h *Handler) CreateOrUpdateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "service-account", "name", id.Name)
+	frest.HandleUpsert(w, r, logger, frest.UpsertOptions[ServiceAccount, *sadom.ServiceAccount, *ServiceAccount]{
+		Params:  id,
+		Creator: activeCreator(h.ServiceAccountWriter, nil, markServiceAccountActive),
+		Updater: activeUpdater(h.ServiceAccountWriter, nil, markServiceAccountActive),
+		APIToDomain: func(api ServiceAccount, p persistencepkg.IdentifiableResource) *sadom.ServiceAccount {
+			return serviceAccountFromAPI(api, p.(*resource.Identity))
+		},
+		DomainToAPI: serviceAccountToAPIWithVerb(http.MethodPut),
+	})
+}
+
+// DeleteServiceAccount handles DELETE /v1/tenants/{tenant}/service-accounts/{name}.
+// Deleting a service account revokes all of its tokens.
+func (h *Handler) DeleteServiceAccount(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "service-account", "name", id.Name)
+	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.ServiceAccountWriter, newServiceAccountWithIdentity))
+}
+
+// CreateServiceAccountToken handles POST /v1/tenants/{tenant}/service-accounts/{name}/tokens.
+// The token is returned once and never stored.
+func (h *Handler) CreateServiceAccountToken(w http.ResponseWriter, r *http.Request) {
+	id := serviceAccountIdentity(r)
+	logger := h.Logger.With("provider", "authorization", "resource", "service-account", "name", id.Name, "tenant", id.Tenant)
+
+	req, err := decodeTokenRequest(w, r)
+	if err != nil {
+		frest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+
+	sa := newServiceAccountWithIdentity(id)
+	sa.ResourceVersion = ""
+	if err := h.ServiceAccountReader.Load(r.Context(), &sa); err != nil {
+		frest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+	if sa.Spec.Disabled {
+		frest.WriteErrorResponse(w, r, logger, fmt.Errorf("%w: service account %s is disabled", kernel.ErrForbidden, id.Name))
+		return
+	}
+	if len(req.Scope.Tenants) > 0 && !slices.Equal(req.Scope.Tenants, []string{id.Tenant}) {
+		frest.WriteErrorResponse(w, r, logger, kernel.NewError(kernel.KindValidation,
+			fmt.Errorf("service account %s can only be scoped to its tenant %s", id.Name, id.Tenant),
+			kernel.ErrorSource{Name: "/scope/tenants"}))
+		return
+	}
+
+	token, err := h.TokenIssuer.IssueToken(r.Context(), sa, req)
+	if err != nil {
+		frest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+	logger.InfoContext(r.Context(), "issued service-account token",
+		slog.String("subject", token.Subject), slog.Time("expiresAt", token.ExpiresAt))
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(TokenResponse{Token: token.Token, Subject: token.Subject, ExpiresAt: token.ExpiresAt}); err != nil {
+		frest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+	w.Header().Set("Content-Type", string(sdkschema.AcceptHeaderJson))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// maxExpirationSeconds is the largest lifetime a time.Duration can hold; the issuer's own
+// maximum is far lower.
+const maxExpirationSeconds = int64(math.MaxInt64 / time.Second)
+
+// decodeTokenRequest reads the optional token request body. An empty body asks for a
+// default token.
+func decodeTokenRequest(w http.ResponseWriter, r *http.Request) (sadom.TokenRequest, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, frest.MaxRequestBodyBytes))
+	if err != nil {
+		return sadom.TokenRequest{}, fmt.Errorf("%w: failed to read request body: %w", frest.ErrBadRequest, err)
+	}
+	var api TokenRequest
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &api); err != nil {
+			return sadom.TokenRequest{}, fmt.Errorf("%w: invalid JSON in request body: %w", frest.ErrBadRequest, err)
+		}
+	}
+	if api.ExpirationSeconds < 0 || api.ExpirationSeconds > maxExpirationSeconds {
+		return sadom.TokenRequest{}, kernel.NewError(kernel.KindValidation,
+			fmt.Errorf("expirationSeconds is out of range"),
+			kernel.ErrorSource{Name: "/expirationSeconds", Value: strconv.FormatInt(api.ExpirationSeconds, 10)})
+	}
+	req := sadom.TokenRequest{TTL: time.Duration(api.ExpirationSeconds) * time.Second}
+	if api.Scope != nil {
+		req.Scope = *api.Scope
+	}
+	var sources []kernel.ErrorSource
+	for i, verb := range req.Scope.Verbs {
+		if !validVerb(verb) {
+			sources = append(sources, kernel.ErrorSource{Name: "/scope/verbs/" + strconv.Itoa(i), Value: verb})
+		}
+	}
+	for i, pattern := range req.Scope.Resources {
+		if !validResourcePattern(pattern) {
+			sources = append(sources, kernel.ErrorSource{Name: "/scope/resources/" + strconv.Itoa(i), Value: pattern})
+		}
+	}
+	if len(sources) > 0 {
+		return sadom.TokenRequest{}, kernel.NewError(kernel.KindValidation,
+			fmt.Errorf("scope holds unknown verbs or invalid resource patterns"), sources...)
+	}
+	return req, nil
+}
+
+// serviceAccountIdentity reads the identity of the addressed service account from the
+// path and the If-Unmodified-Since header.
+func serviceAccountIdentity(r *http.Request) *resource.Identity {
+	id := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant")}}
+	if v := r.Header.Get("If-Unmodified-Since"); v != "" {
+		if _, err := strconv.Atoi(v); err == nil {
+			id.Version = v
+		}
+	}
+	return id
+}
+
+// newServiceAccountWithIdentity returns a *sadom.ServiceAccount populated with identity fields from ir.
+func newServiceAccountWithIdentity(ir persistencepkg.IdentifiableResource) *sadom.ServiceAccount {
+	sa := &sadom.ServiceAccount{}
+	sa.Name = ir.GetName()
+	sa.Tenant = ir.GetTenant()
+	sa.ResourceVersion = ir.GetVersion()
+	return sa
+}
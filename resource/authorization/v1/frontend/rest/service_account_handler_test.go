@@ -0,0 +1,124 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+)
+
+// fakeServiceAccountReader serves service accounts by name; unknown names are not found.
+type fakeServiceAccountReader struct {
+	accounts map[string]*sadom.ServiceAccount
+}
+
+func (f *fakeServiceAccountReader) List(context.Context, resource.ListFilter, *[]*sadom.ServiceAccount) (*string, error) {
+	return nil, nil
+}
+
+func (f *fakeServiceAccountReader) Load(_ context.Context, m **sadom.ServiceAccount) error {
+	stored, ok := f.accounts[(*m).Name]
+	if !ok {
+		return kernel.ErrNotFound
+	}
+	*m = stored
+	return nil
+}
+
+// fakeIssuer records the last request and returns a fixed token.
+type fakeIssuer struct {
+	req sadom.TokenRequest
+}
+
+func (f *fakeIssuer) IssueToken(_ context.Context, sa *sadom.ServiceAccount, req sadom.TokenRequest) (sadom.Token, error) {
+	f.req = req
+	return sadom.Token{Token: "signed", Subject: sadom.Subject(sa.Tenant, sa.Name), ExpiresAt: time.Unix(1767225600, 0).UTC()}, nil
+}
+
+func TestServiceAccountToAPI_ResourceAndRef(t *testing.T) {
+	sa := sadom.ServiceAccount{}
+	sa.Name = "ci"
+	sa.Tenant = "t1"
+	sa.Provider = sadom.ProviderID
+
+	out := serviceAccountToAPI(sa, "get")
+
+	require.Equal(t, "service-accounts/ci", out.Metadata.Resource)
+	require.Equal(t, "seca.authorization/v1/tenants/t1/service-accounts/ci", out.Metadata.Ref)
+}
+
+func TestCreateServiceAccountToken(t *testing.T) {
+	active := &sadom.ServiceAccount{}
+	active.Name, active.Tenant = "ci", "t1"
+	disabled := &sadom.ServiceAccount{Spec: sadom.ServiceAccountSpec{Disabled: true}}
+	disabled.Name, disabled.Tenant = "old", "t1"
+
+	tests := []struct {
+		name       string
+		account    string
+		body       string
+		wantStatus int
+		wantTTL    time.Duration
+	}{
+		{name: "default token", account: "ci", wantStatus: http.StatusOK},
+		{name: "lifetime and own tenant scope", account: "ci", body: `{"expirationSeconds":600,"scope":{"tenants":["t1"],"workspaces":["w1"]}}`, wantStatus: http.StatusOK, wantTTL: 10 * time.Minute},
+		{name: "read-only compute scope", account: "ci", body: `{"scope":{"providers":["seca.compute"],"verbs":["get","list"],"resources":["instances*"]}}`, wantStatus: http.StatusOK},
+		{name: "scope names an unknown verb", account: "ci", body: `{"scope":{"verbs":["read"]}}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "scope holds an invalid resource pattern", account: "ci", body: `{"scope":{"resources":["["]}}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "unknown service account", account: "nope", wantStatus: http.StatusNotFound},
+		{name: "disabled service account", account: "old", wantStatus: http.StatusForbidden},
+		{name: "scope names another tenant", account: "ci", body: `{"scope":{"tenants":["t2"]}}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "negative lifetime", account: "ci", body: `{"expirationSeconds":-1}`, wantStatus: http.StatusUnprocessableEntity},
+		{name: "malformed body", account: "ci", body: `{`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			issuer := &fakeIssuer{}
+			h := &Handler{
+				ServiceAccountReader: &fakeServiceAccountReader{accounts: map[string]*sadom.ServiceAccount{"ci": active, "old": disabled}},
+				TokenIssuer:          issuer,
+				Logger:               slog.Default(),
+			}
+			mux := http.NewServeMux()
+			h.RegisterServiceAccountRoutes(mux, "/providers/seca.authorization")
+
+			req := httptest.NewRequest(http.MethodPost, "/providers/seca.authorization/v1/tenants/t1/service-accounts/"+tc.account+"/tokens", strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			var resp TokenResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			require.Equal(t, "signed", resp.Token)
+			require.Equal(t, "serviceaccount:t1:ci", resp.Subject)
+			require.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+			require.Equal(t, tc.wantTTL, issuer.req.TTL)
+		})
+	}
+}
+
+func TestRegisterServiceAccountRoutes_NoIssuer(t *testing.T) {
+	h := &Handler{ServiceAccountReader: &fakeServiceAccountReader{}, Logger: slog.Default()}
+	mux := http.NewServeMux()
+	h.RegisterServiceAccountRoutes(mux, "/providers/seca.authorization")
+
+	req := httptest.NewRequest(http.MethodPost, "/providers/seca.authorization/v1/tenants/t1/service-accounts/ci/tokens", nil)
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusNotFound, rec.Code, "the token route must not exist without an issuer")
+}
@@ -0,0 +1,44 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+)
+
+// rejectSystemManagedRole refuses a write or delete of a role the control plane created
+// when the tenant was provisioned. Tenants build on those roles; changing one in place
+// would silently change every assignment that references it, so they are read-only
+// through the API. A role that does not exist yet is not protected.
+func (h *Handler) rejectSystemManagedRole(ctx context.Context, ir persistencepkg.IdentifiableResource) error {
+	stored := newRoleWithIdentity(ir)
+	stored.ResourceVersion = ""
+	if err := h.RoleReader.Load(ctx, &stored); err != nil {
+		if errors.Is(err, kernel.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if stored.SystemManaged {
+		return fmt.Errorf("%w: role %s is system-managed and cannot be modified or deleted", kernel.ErrForbidden, ir.GetName())
+	}
+	return nil
+}
+
+// guardedDeleter runs check before delegating to next, and aborts the delete when check
+// returns an error.
+type guardedDeleter struct {
+	check func(context.Context, persistencepkg.IdentifiableResource) error
+	next  frest.Deleter
+}
+
+func (d guardedDeleter) Do(ctx context.Context, ir persistencepkg.IdentifiableResource) error {
+	if err := d.check(ctx, ir); err != nil {
+		return err
+	}
+	return d.next.Do(ctx, ir)
+}
@@ -0,0 +1,74 @@
+package rest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+)
+
+// fakeRoleReader serves roles by name; unknown names are not found.
+type fakeRoleReader struct {
+	roles map[string]*roledom.Role
+}
+
+func (f *fakeRoleReader) List(context.Context, resource.ListFilter, *[]*roledom.Role) (*string, error) {
+	return nil, nil
+}
+
+func (f *fakeRoleReader) Load(_ context.Context, m **roledom.Role) error {
+	stored, ok := f.roles[(*m).Name]
+	if !ok {
+		return kernel.ErrNotFound
+	}
+	*m = stored
+	return nil
+}
+
+// countingDeleter counts the deletes that reach it.
+type countingDeleter struct{ calls int }
+
+func (d *countingDeleter) Do(context.Context, persistencepkg.IdentifiableResource) error {
+	d.calls++
+	return nil
+}
+
+func TestRejectSystemManagedRole(t *testing.T) {
+	builtin := &roledom.Role{SystemManaged: true}
+	builtin.Name = "viewer"
+	custom := &roledom.Role{}
+	custom.Name = "custom"
+	h := &Handler{RoleReader: &fakeRoleReader{roles: map[string]*roledom.Role{"viewer": builtin, "custom": custom}}}
+
+	tests := []struct {
+		name      string
+		role      string
+		forbidden bool
+	}{
+		{name: "built-in role", role: "viewer", forbidden: true},
+		{name: "tenant role", role: "custom"},
+		{name: "role not created yet", role: "new"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next := &countingDeleter{}
+			d := guardedDeleter{check: h.rejectSystemManagedRole, next: next}
+
+			err := d.Do(context.Background(), &resource.Identity{Name: tc.role, Scope: resource.Scope{Tenant: "t1"}})
+
+			if tc.forbidden {
+				require.ErrorIs(t, err, kernel.ErrForbidden)
+				require.Zero(t, next.calls, "a system-managed role must not be deleted")
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 1, next.calls)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	sdkschema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/validation"
+	trdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// tokenRevocationKind is the metadata kind of a token revocation. SECA does not define
+// one, so it follows the kebab-case form of the specified kinds.
+const tokenRevocationKind = sdkschema.GlobalResourceMetadataKind("token-revocation")
+
+// TokenRevocation is the API representation of a token revocation. It is a global,
+// tenant-less resource, like the region.
+type TokenRevocation struct {
+	Metadata *sdkschema.GlobalResourceMetadata `json:"metadata,omitempty"`
+	Spec     TokenRevocationSpec               `json:"spec"`
+}
+
+// TokenRevocationSpec is the API representation of a token revocation's spec, and the
+// body of POST .../token-revocations.
+type TokenRevocationSpec struct {
+	JTI          string     `json:"jti,omitempty"`
+	Subject      string     `json:"subject,omitempty"`
+	IssuedBefore *time.Time `json:"issuedBefore,omitempty"`
+	ExpiresAt    *time.Time `json:"expiresAt,omitempty"`
+	Reason       string     `json:"reason,omitempty"`
+}
+
+// TokenRevocationIterator is a page of token revocations.
+type TokenRevocationIterator struct {
+	Items    []TokenRevocation          `json:"items"`
+	Metadata sdkschema.ResponseMetadata `json:"metadata"`
+}
+
+// tokenRevocationListParams reads the list query parameters of the revocation list.
+func tokenRevocationListParams(r *http.Request) resource.ListParams {
+	query := r.URL.Query()
+	var limit *int
+	if raw := query.Get("limit"); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil {
+			limit = &parsed
+		}
+	}
+	return resource.ListParams{
+		Limit:     validation.GetLimit(limit),
+		SkipToken: query.Get("skipToken"),
+	}
+}
+
+// tokenRevocationIteratorToAPI converts a list of TokenRevocation to a TokenRevocationIterator.
+func tokenRevocationIteratorToAPI(revocations []*trdom.TokenRevocation, nextSkipToken *string) *TokenRevocationIterator {
+	items := make([]TokenRevocation, len(revocations))
+	for i, tr := range revocations {
+		items[i] = *tokenRevocationToAPI(*tr, http.MethodGet)
+	}
+	return &TokenRevocationIterator{
+		Items: items,
+		Metadata: sdkschema.ResponseMetadata{
+			Provider:  trdom.ProviderID,
+			Resource:  trdom.Resource,
+			Verb:      http.MethodGet,
+			SkipToken: nextSkipToken,
+		},
+	}
+}
+
+// tokenRevocationToAPI converts a TokenRevocation to its API representation with the given verb.
+func tokenRevocationToAPI(tr trdom.TokenRevocation, verb string) *TokenRevocation {
+	resourceVersion := int64(0)
+	if parsed, err := strconv.ParseInt(tr.ResourceVersion, 10, 64); err == nil {
+		resourceVersion = parsed
+	}
+	return &TokenRevocation{
+		Metadata: &sdkschema.GlobalResourceMetadata{
+			ApiVersion:      trdom.Version,
+			CreatedAt:       tr.CreatedAt,
+			LastModifiedAt:  tr.UpdatedAt,
+			Kind:            tokenRevocationKind,
+			Name:            tr.Name,
+			Provider:        trdom.ProviderID,
+			Resource:        commondomain.FormatResource(tokenRevocationKind, tr.Name),
+			Ref:             commondomain.FormatResourceRef(trdom.ProviderID, tokenRevocationKind, tr.Name),
+			ResourceVersion: resourceVersion,
+			Verb:            verb,
+			DeletedAt:       tr.DeletedAt,
+		},
+		Spec: TokenRevocationSpec{
+			JTI:          tr.Spec.JTI,
+			Subject:      tr.Spec.Subject,
+			IssuedBefore: tr.Spec.IssuedBefore,
+			ExpiresAt:    tr.Spec.ExpiresAt,
+			Reason:       tr.Spec.Reason,
+		},
+	}
+}
+
+// tokenRevocationSpecFromAPI converts an API TokenRevocationSpec to its domain form.
+func tokenRevocationSpecFromAPI(api TokenRevocationSpec) trdom.TokenRevocationSpec {
+	return trdom.TokenRevocationSpec{
+		JTI:          api.JTI,
+		Subject:      api.Subject,
+		IssuedBefore: api.IssuedBefore,
+		ExpiresAt:    api.ExpiresAt,
+		Reason:       api.Reason,
+	}
+}
@@ -0,0 +1,152 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	sdkschema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	trdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation"
+)
+
+// RegisterTokenRevocationRoutes mounts the admin routes of the token revocation list under
+// baseURL on mux. The list is global, so the routes carry no tenant and the SECA RBAC
+// middleware cannot govern them: the caller passes middlewares that admit administrators
+// only. They wrap every route the way oapi-codegen applies them: the last one runs first.
+//
+//	POST   /v1/token-revocations
+//	GET    /v1/token-revocations
+//	DELETE /v1/token-revocations/{name}
+func (h *Handler) RegisterTokenRevocationRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	handle := func(pattern string, fn http.HandlerFunc) {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle(pattern, handler)
+	}
+	collection := baseURL + "/v1/" + trdom.Resource
+	handle("POST "+collection, h.RevokeTokens)
+	handle("GET "+collection, h.ListTokenRevocations)
+	handle("DELETE "+collection+"/{name}", h.DeleteTokenRevocation)
+}
+
+// RevokeTokens handles POST /v1/token-revocations. The body revokes one token by its jti,
+// or every token of a subject issued before issuedBefore. Revoking the same token or
+// subject again updates the existing entry: the later issuedBefore and expiresAt win, so a
+// repeated revocation never narrows an earlier one. Responds 201 when the entry is new and
+// 200 when it was updated.
+func (h *Handler) RevokeTokens(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "authorization", "resource", "token-revocation")
+
+	var api TokenRevocationSpec
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, frest.MaxRequestBodyBytes))
+	if err != nil {
+		frest.WriteErrorResponse(w, r, logger, fmt.Errorf("%w: failed to read request body: %w", frest.ErrBadRequest, err))
+		return
+	}
+	if err := json.Unmarshal(body, &api); err != nil {
+		frest.WriteErrorResponse(w, r, logger, fmt.Errorf("%w: invalid JSON in request body: %w", frest.ErrBadRequest, err))
+		return
+	}
+	spec := tokenRevocationSpecFromAPI(api)
+	if err := spec.Validate(); err != nil {
+		frest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+
+	tr := &trdom.TokenRevocation{}
+	tr.Name = spec.Name()
+	logger = logger.With("name", tr.Name)
+	status := http.StatusOK
+	switch err := h.TokenRevocationReader.Load(r.Context(), &tr); {
+	case errors.Is(err, kernel.ErrNotFound):
+		tr = &trdom.TokenRevocation{Spec: spec}
+		tr.Name = spec.Name()
+		tr.Provider = trdom.ProviderID
+		created, err := h.TokenRevocationWriter.Create(r.Context(), tr)
+		if err != nil {
+			frest.WriteErrorResponse(w, r, logger, err)
+			return
+		}
+		tr, status = *created, http.StatusCreated
+	case err != nil:
+		frest.WriteErrorResponse(w, r, logger, err)
+		return
+	default:
+		tr.Spec = widenRevocation(tr.Spec, spec)
+		updated, err := h.TokenRevocationWriter.Update(r.Context(), tr)
+		if err != nil {
+			frest.WriteErrorResponse(w, r, logger, err)
+			return
+		}
+		tr = *updated
+	}
+	logger.InfoContext(r.Context(), "revoked tokens",
+		slog.String("jti", spec.JTI), slog.String("subject", spec.Subject), slog.String("reason", spec.Reason))
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(tokenRevocationToAPI(*tr, http.MethodPost)); err != nil {
+		frest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+	w.Header().Set("Content-Type", string(sdkschema.AcceptHeaderJson))
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// ListTokenRevocations handles GET /v1/token-revocations.
+func (h *Handler) ListTokenRevocations(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "authorization", "resource", "token-revocation")
+	frest.HandleList(w, r, logger, tokenRevocationListParams(r), frest.ListerFromRepo(h.TokenRevocationReader), tokenRevocationIteratorToAPI)
+}
+
+// DeleteTokenRevocation handles DELETE /v1/token-revocations/{name}. Deleting an entry
+// lifts the revocation: tokens it rejected are accepted again until they expire.
+func (h *Handler) DeleteTokenRevocation(w http.ResponseWriter, r *http.Request) {
+	id := &resource.Identity{Name: r.PathValue("name")}
+	logger := h.Logger.With("provider", "authorization", "resource", "token-revocation", "name", id.Name)
+	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.TokenRevocationWriter, newTokenRevocationWithIdentity))
+}
+
+// widenRevocation merges a repeated revocation into the stored one. The later cut-off and
+// expiry win, and a nil expiry (never expires) beats any time.
+func widenRevocation(stored, req trdom.TokenRevocationSpec) trdom.TokenRevocationSpec {
+	out := stored
+	out.IssuedBefore = laterOf(stored.IssuedBefore, req.IssuedBefore)
+	if stored.ExpiresAt != nil && req.ExpiresAt != nil {
+		out.ExpiresAt = laterOf(stored.ExpiresAt, req.ExpiresAt)
+	} else {
+		out.ExpiresAt = nil
+	}
+	if req.Reason != "" {
+		out.Reason = req.Reason
+	}
+	return out
+}
+
+// laterOf returns the later of a and b; nil is earlier than any time.
+func laterOf(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
+
+// newTokenRevocationWithIdentity returns a *trdom.TokenRevocation populated with identity fields from ir.
+func newTokenRevocationWithIdentity(ir persistencepkg.IdentifiableResource) *trdom.TokenRevocation {
+	tr := &trdom.TokenRevocation{}
+	tr.Name = ir.GetName()
+	tr.ResourceVersion = ir.GetVersion()
+	return tr
+}
@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	trdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation"
+)
+
+// fakeRevocationStore keeps token revocations in memory by name.
+type fakeRevocationStore struct {
+	entries map[string]*trdom.TokenRevocation
+}
+
+func (f *fakeRevocationStore) List(context.Context, resource.ListFilter, *[]*trdom.TokenRevocation) (*string, error) {
+	return nil, nil
+}
+
+func (f *fakeRevocationStore) Load(_ context.Context, m **trdom.TokenRevocation) error {
+	stored, ok := f.entries[(*m).Name]
+	if !ok {
+		return kernel.ErrNotFound
+	}
+	cp := *stored
+	*m = &cp
+	return nil
+}
+
+func (f *fakeRevocationStore) Create(_ context.Context, m *trdom.TokenRevocation) (**trdom.TokenRevocation, error) {
+	f.entries[m.Name] = m
+	return &m, nil
+}
+
+func (f *fakeRevocationStore) Update(_ context.Context, m *trdom.TokenRevocation) (**trdom.TokenRevocation, error) {
+	f.entries[m.Name] = m
+	return &m, nil
+}
+
+func (f *fakeRevocationStore) UpdateStatus(_ context.Context, m *trdom.TokenRevocation) (**trdom.TokenRevocation, error) {
+	return &m, nil
+}
+
+func (f *fakeRevocationStore) Delete(_ context.Context, m *trdom.TokenRevocation) error {
+	delete(f.entries, m.Name)
+	return nil
+}
+
+func TestRevokeTokens(t *testing.T) {
+	store := &fakeRevocationStore{entries: map[string]*trdom.TokenRevocation{}}
+	h := &Handler{TokenRevocationReader: store, TokenRevocationWriter: store, Logger: slog.Default()}
+	mux := http.NewServeMux()
+	h.RegisterTokenRevocationRoutes(mux, "/providers/seca.authorization")
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/providers/seca.authorization/v1/token-revocations", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := post(`{"subject":"alice","issuedBefore":"2026-03-01T00:00:00Z","expiresAt":"2026-03-02T00:00:00Z"}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var created TokenRevocation
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.Equal(t, "alice", created.Spec.Subject)
+
+	// A second, earlier revocation of the same subject must not narrow the first.
+	rec = post(`{"subject":"alice","issuedBefore":"2026-02-01T00:00:00Z","reason":"offboarded"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	stored := store.entries[created.Metadata.Name]
+	require.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), stored.Spec.IssuedBefore.UTC())
+	require.Nil(t, stored.Spec.ExpiresAt, "an entry without expiry outlives any expiry")
+	require.Equal(t, "offboarded", stored.Spec.Reason)
+
+	for _, body := range []string{`{}`, `{"subject":"alice"}`, `{"jti":"abc","subject":"alice","issuedBefore":"2026-03-01T00:00:00Z"}`} {
+		require.Equal(t, http.StatusUnprocessableEntity, post(body).Code, body)
+	}
+	require.Equal(t, http.StatusBadRequest, post(`{`).Code)
+
+	req := httptest.NewRequest(http.MethodDelete, "/providers/seca.authorization/v1/token-revocations/"+created.Metadata.Name, nil)
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	require.Empty(t, store.entries)
+}
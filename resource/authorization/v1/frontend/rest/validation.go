@@ -0,0 +1,174 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+)
+
+// verbPattern matches "<verb>" and "<verb>.<action>"; the base verb is checked against
+// roledom.Verbs separately.
+var verbPattern = regexp.MustCompile(`^([a-z]+)(\.[a-z][a-z0-9-]*)?$`)
+
+// validateRole rejects a Role that authorization could not evaluate as written: a provider
+// nobody serves, a resource pattern that does not compile as a glob, or an unknown verb.
+// Each of these used to be skipped silently at evaluation time, leaving an active Role
+// that grants less than it claims. Sources point into the request body.
+func validateRole(r *roledom.Role) error {
+	var sources []kernel.ErrorSource
+	if len(r.Spec.Permissions) == 0 {
+		sources = append(sources, kernel.ErrorSource{Name: "/spec/permissions"})
+	}
+	for i, p := range r.Spec.Permissions {
+		at := "/spec/permissions/" + strconv.Itoa(i)
+		if !slices.Contains(roledom.Providers, p.Provider) {
+			sources = append(sources, kernel.ErrorSource{Name: at + "/provider", Value: p.Provider})
+		}
+		if len(p.Resources) == 0 {
+			sources = append(sources, kernel.ErrorSource{Name: at + "/resources"})
+		}
+		for j, pattern := range p.Resources {
+			if !validResourcePattern(pattern) {
+				sources = append(sources, kernel.ErrorSource{Name: at + "/resources/" + strconv.Itoa(j), Value: pattern})
+			}
+		}
+		if len(p.Verb) == 0 {
+			sources = append(sources, kernel.ErrorSource{Name: at + "/verb"})
+		}
+		for j, verb := range p.Verb {
+			if !validVerb(verb) {
+				sources = append(sources, kernel.ErrorSource{Name: at + "/verb/" + strconv.Itoa(j), Value: verb})
+			}
+		}
+	}
+	if len(sources) > 0 {
+		return kernel.NewError(kernel.KindValidation, fmt.Errorf("role %s has invalid permissions", r.Name), sources...)
+	}
+	return nil
+}
+
+// validateRoleAssignment rejects a RoleAssignment that could never grant anything: no
+// subjects, no roles or no scopes, or blank entries in any of them, as well as validity
+// and condition extensions that do not parse. Whether the referenced roles exist is not
+// checked here; that is reported on the assignment's status.
+func validateRoleAssignment(ra *radom.RoleAssignment) error {
+	var sources []kernel.ErrorSource
+	sources = appendBlankSources(sources, "/spec/subs", ra.Spec.Subs)
+	sources = appendBlankSources(sources, "/spec/roles", ra.Spec.Roles)
+	if len(ra.Spec.Scopes) == 0 {
+		sources = append(sources, kernel.ErrorSource{Name: "/spec/scopes"})
+	}
+	if _, err := radom.ConstraintsFromExtensions(ra.Extensions); err != nil {
+		sources = append(sources, kernel.ErrorSource{Name: "/extensions", Value: err.Error()})
+	}
+	if len(sources) > 0 {
+		return kernel.NewError(kernel.KindValidation, fmt.Errorf("role assignment %s is incomplete", ra.Name), sources...)
+	}
+	return nil
+}
+
+// validateAdmissionPolicy rejects an AdmissionPolicy without an expression, with an
+// unknown mode, or whose field is not a JSON pointer. Whether the expression compiles is
+// checked by the PolicyCompiler, which knows the evaluation environment.
+func validateAdmissionPolicy(ap *apdom.AdmissionPolicy) error {
+	var sources []kernel.ErrorSource
+	if ap.Spec.Expression == "" {
+		sources = append(sources, kernel.ErrorSource{Name: "/spec/expression"})
+	}
+	switch ap.Spec.Mode {
+	case "", apdom.ModeEnforce, apdom.ModeAudit:
+	default:
+		sources = append(sources, kernel.ErrorSource{Name: "/spec/mode", Value: string(ap.Spec.Mode)})
+	}
+	if ap.Spec.Field != "" && !strings.HasPrefix(ap.Spec.Field, "/") {
+		sources = append(sources, kernel.ErrorSource{Name: "/spec/field", Value: ap.Spec.Field})
+	}
+	if len(sources) > 0 {
+		return kernel.NewError(kernel.KindValidation, fmt.Errorf("admission policy %s is invalid", ap.Name), sources...)
+	}
+	return nil
+}
+
+// validateResidencyPolicy rejects a ResidencyPolicy with blank entries or naming a
+// provider no write is ever made through. Regions and zones are not checked against the
+// Region catalog: a policy may name a region before it opens.
+func validateResidencyPolicy(rp *rpdom.ResidencyPolicy) error {
+	var sources []kernel.ErrorSource
+	for _, list := range []struct {
+		at     string
+		values []string
+	}{{"/spec/regions", rp.Spec.Regions}, {"/spec/providers", rp.Spec.Providers}, {"/spec/zones", rp.Spec.Zones}} {
+		for i, v := range list.values {
+			if v == "" {
+				sources = append(sources, kernel.ErrorSource{Name: list.at + "/" + strconv.Itoa(i)})
+			}
+		}
+	}
+	for i, p := range rp.Spec.Providers {
+		if p != "" && !slices.Contains(roledom.Providers, p) {
+			sources = append(sources, kernel.ErrorSource{Name: "/spec/providers/" + strconv.Itoa(i), Value: p})
+		}
+	}
+	if len(sources) > 0 {
+		return kernel.NewError(kernel.KindValidation, fmt.Errorf("residency policy %s is invalid", rp.Name), sources...)
+	}
+	return nil
+}
+
+// appendBlankSources adds a source for an empty list, or one per blank entry in it.
+func appendBlankSources(sources []kernel.ErrorSource, at string, values []string) []kernel.ErrorSource {
+	if len(values) == 0 {
+		return append(sources, kernel.ErrorSource{Name: at})
+	}
+	for i, v := range values {
+		if v == "" {
+			sources = append(sources, kernel.ErrorSource{Name: at + "/" + strconv.Itoa(i)})
+		}
+	}
+	return sources
+}
+
+// validResourcePattern reports whether pattern compiles as the gobwas/glob expression
+// authorization matches it with.
+func validResourcePattern(pattern string) bool {
+	if pattern == "" {
+		return false
+	}
+	_, err := glob.Compile(pattern)
+	return err == nil
+}
+
+// validVerb reports whether verb is "*", a known base verb, or a known base verb narrowed
+// to an action.
+func validVerb(verb string) bool {
+	if verb == "*" {
+		return true
+	}
+	m := verbPattern.FindStringSubmatch(verb)
+	return m != nil && slices.Contains(roledom.Verbs, m[1])
+}
+
+// admitWith chains write-time validation with an optional second admission step, such
+// as the escalation guard. The write is rejected on the first error.
+func admitWith[D any](validate func(D) error, next func(context.Context, D) error) func(context.Context, D) error {
+	return func(ctx context.Context, m D) error {
+		if err := validate(m); err != nil {
+			return err
+		}
+		if next == nil {
+			return nil
+		}
+		return next(ctx, m)
+	}
+}
@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	apdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/admission-policy"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
+	roledom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role"
+	radom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/role-assignment"
+)
+
+func TestValidateRole(t *testing.T) {
+	tests := []struct {
+		name        string
+		permission  roledom.Permission
+		wantSources []string
+	}{
+		{
+			name:       "valid permission",
+			permission: roledom.Permission{Provider: "seca.compute", Resources: []string{"instances", "instances/*"}, Verb: []string{"get", "list", "post.start"}},
+		},
+		{
+			name:        "unknown provider",
+			permission:  roledom.Permission{Provider: "seca.computer", Resources: []string{"*"}, Verb: []string{"*"}},
+			wantSources: []string{"/spec/permissions/0/provider"},
+		},
+		{
+			name:        "invalid glob",
+			permission:  roledom.Permission{Provider: "seca.compute", Resources: []string{"instances/[a-"}, Verb: []string{"get"}},
+			wantSources: []string{"/spec/permissions/0/resources/0"},
+		},
+		{
+			name:        "unknown verb and malformed action",
+			permission:  roledom.Permission{Provider: "seca.compute", Resources: []string{"*"}, Verb: []string{"read", "post."}},
+			wantSources: []string{"/spec/permissions/0/verb/0", "/spec/permissions/0/verb/1"},
+		},
+		{
+			name:        "empty resources and verbs",
+			permission:  roledom.Permission{Provider: "seca.compute"},
+			wantSources: []string{"/spec/permissions/0/resources", "/spec/permissions/0/verb"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &roledom.Role{Spec: roledom.RoleSpec{Permissions: []roledom.Permission{tc.permission}}}
+
+			err := validateRole(r)
+
+			if tc.wantSources == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, kernel.ErrValidation)
+			assert.Equal(t, tc.wantSources, sourceNames(err))
+		})
+	}
+}
+
+func TestValidateRoleAssignment(t *testing.T) {
+	valid := radom.RoleAssignmentSpec{
+		Subs:   []string{"alice"},
+		Roles:  []string{"viewer"},
+		Scopes: []radom.RoleAssignmentScope{{}},
+	}
+	require.NoError(t, validateRoleAssignment(&radom.RoleAssignment{Spec: valid}))
+
+	err := validateRoleAssignment(&radom.RoleAssignment{Spec: radom.RoleAssignmentSpec{Subs: []string{""}}})
+
+	require.ErrorIs(t, err, kernel.ErrValidation)
+	require.Equal(t, []string{"/spec/subs/0", "/spec/roles", "/spec/scopes"}, sourceNames(err))
+
+	expiresFirst := &radom.RoleAssignment{Spec: valid}
+	expiresFirst.Extensions = map[string]string{
+		radom.ExtensionNotBefore: "2026-02-01T00:00:00Z",
+		radom.ExtensionNotAfter:  "2026-01-01T00:00:00Z",
+	}
+	err = validateRoleAssignment(expiresFirst)
+
+	require.ErrorIs(t, err, kernel.ErrValidation)
+	require.Equal(t, []string{"/extensions"}, sourceNames(err))
+}
+
+func TestValidateAdmissionPolicy(t *testing.T) {
+	valid := apdom.AdmissionPolicySpec{Expression: "'cost-center' in object.Labels", Field: "/labels", Mode: apdom.ModeAudit}
+	require.NoError(t, validateAdmissionPolicy(&apdom.AdmissionPolicy{Spec: valid}))
+
+	err := validateAdmissionPolicy(&apdom.AdmissionPolicy{Spec: apdom.AdmissionPolicySpec{Field: "labels", Mode: "warn"}})
+
+	require.ErrorIs(t, err, kernel.ErrValidation)
+	require.Equal(t, []string{"/spec/expression", "/spec/mode", "/spec/field"}, sourceNames(err))
+}
+
+func TestValidateResidencyPolicy(t *testing.T) {
+	require.NoError(t, validateResidencyPolicy(&rpdom.ResidencyPolicy{}), "an empty policy allows everything")
+	valid := rpdom.ResidencyPolicySpec{Regions: []string{"itbg-bergamo"}, Providers: []string{"seca.compute"}, Zones: []string{"itbg-1"}}
+	require.NoError(t, validateResidencyPolicy(&rpdom.ResidencyPolicy{Spec: valid}))
+
+	err := validateResidencyPolicy(&rpdom.ResidencyPolicy{Spec: rpdom.ResidencyPolicySpec{
+		Regions:   []string{""},
+		Providers: []string{"seca.compute", "aws.ec2"},
+		Zones:     []string{"itbg-1", ""},
+	}})
+
+	require.ErrorIs(t, err, kernel.ErrValidation)
+	require.Equal(t, []string{"/spec/regions/0", "/spec/zones/1", "/spec/providers/1"}, sourceNames(err))
+}
+
+// sourceNames returns the pointer of every source on a kernel error.
+func sourceNames(err error) []string {
+	var names []string
+	for _, s := range kernel.AsError(err).Sources {
+		names = append(names, s.Name)
+	}
+	return names
+}
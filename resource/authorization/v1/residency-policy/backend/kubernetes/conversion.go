@@ -0,0 +1,118 @@
+package kubernetes
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// ResidencyPolicyFromCR converts either a concrete *ResidencyPolicy or
+// *unstructured.Unstructured into a *rpdom.ResidencyPolicy.
+func ResidencyPolicyFromCR(obj client.Object) (*rpdom.ResidencyPolicy, error) {
+	var cr ResidencyPolicy
+
+	switch t := obj.(type) {
+	case *ResidencyPolicy:
+		cr = *t
+	case *unstructured.Unstructured:
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(t.Object, &cr); err != nil {
+			return nil, fmt.Errorf("failed to convert unstructured to ResidencyPolicy: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	crLabels := cr.GetLabels()
+	internalLabels := k8slabels.GetInternalLabels(crLabels)
+	keyedLabels := k8slabels.GetKeyedLabels(crLabels)
+
+	rp := &rpdom.ResidencyPolicy{
+		Spec: rpdom.ResidencyPolicySpec{
+			Regions:   slices.Clone(cr.Spec.Regions),
+			Providers: slices.Clone(cr.Spec.Providers),
+			Zones:     slices.Clone(cr.Spec.Zones),
+		},
+	}
+	rp.Name = cr.GetName()
+	rp.ResourceVersion = cr.GetResourceVersion()
+	rp.CreatedAt = cr.GetCreationTimestamp().Time
+	rp.UpdatedAt = cr.GetCreationTimestamp().Time
+	rp.Provider = strings.ReplaceAll(internalLabels[k8slabels.InternalProviderLabel], "_", "/")
+	rp.Tenant = internalLabels[k8slabels.InternalTenantLabel]
+	rp.Labels = k8slabels.KeyedToOriginal(keyedLabels, cr.CommonData.Labels)
+	rp.Annotations = cr.CommonData.Annotations
+	rp.Extensions = cr.CommonData.Extensions
+
+	if ts := cr.GetDeletionTimestamp(); ts != nil {
+		rp.DeletedAt = &ts.Time
+	}
+
+	rp.Status = &rpdom.ResidencyPolicyStatus{}
+	if cr.Status != nil {
+		rp.Status.State = commonbackend.ResourceStateFromCR(cr.Status.State)
+		rp.Status.Conditions = commonbackend.ConditionsFromCR(cr.Status.Conditions)
+	} else {
+		rp.Status.PushCondition(commondomain.DefaultPendingCondition)
+	}
+
+	return rp, nil
+}
+
+// ResidencyPolicyToCR converts a *rpdom.ResidencyPolicy to a Kubernetes ResidencyPolicy CR.
+func ResidencyPolicyToCR(rp *rpdom.ResidencyPolicy) (client.Object, error) {
+	if rp == nil {
+		return nil, fmt.Errorf("residency policy is nil")
+	}
+
+	crLabels := k8slabels.OriginalToKeyed(rp.Labels)
+	crLabels[k8slabels.InternalTenantLabel] = rp.Tenant
+	crLabels[k8slabels.InternalProviderLabel] = strings.ReplaceAll(rp.Provider, "/", "_")
+
+	cr := &ResidencyPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            rp.Name,
+			Namespace:       k8sadapter.ComputeNamespace(&kernelresource.Scope{Tenant: rp.Tenant}),
+			Labels:          crLabels,
+			ResourceVersion: rp.ResourceVersion,
+		},
+		CommonData: schemav1.CommonData{
+			Annotations: rp.Annotations,
+			Extensions:  rp.Extensions,
+			Labels:      slices.Sorted(maps.Keys(rp.Labels)),
+		},
+		Spec: ResidencyPolicySpec{
+			Regions:   slices.Clone(rp.Spec.Regions),
+			Providers: slices.Clone(rp.Spec.Providers),
+			Zones:     slices.Clone(rp.Spec.Zones),
+		},
+	}
+	cr.SetGroupVersionKind(ResidencyPolicyGVK)
+
+	if rp.Status != nil && len(rp.Status.Conditions) > 0 {
+		state := commonbackend.ResourceStateToCR(rp.Status.State)
+		if state == nil {
+			return nil, fmt.Errorf("residency policy %s: failed to map resource state domain to CR", rp.Name)
+		}
+		cr.Status = &ResidencyPolicyStatus{
+			State:      *state,
+			Conditions: commonbackend.ConditionsToCR(rp.Status.Conditions),
+		}
+	}
+
+	return cr, nil
+}
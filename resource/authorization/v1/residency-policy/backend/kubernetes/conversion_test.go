@@ -0,0 +1,88 @@
+package kubernetes_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	rpdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy"
+	. "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/residency-policy/backend/kubernetes"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// FuzzResidencyPolicyRoundTrip verifies that a ResidencyPolicy domain value survives a
+// domain→CR→domain→CR→domain round-trip.
+//
+// Invariants:
+//   - Name, Provider, and Tenant are stable after one round-trip (domain2 == domain3).
+//   - Regions, Providers and Zones survive unchanged.
+//
+// regions, providers and zones are comma-separated lists; an empty string is an empty list.
+func FuzzResidencyPolicyRoundTrip(f *testing.F) {
+	f.Add("eu-only", "seca.authorization/v1", "t-1", "itbg-bergamo", "seca.compute,seca.storage", "itbg-1")
+	f.Add("", "", "", "", "", "")
+	f.Add("zones", "seca.authorization/v1", "tenant-42", "", "", "itbg-1,itbg-2")
+
+	f.Fuzz(func(t *testing.T, name, provider, tenant, regions, providers, zones string) {
+		split := func(s string) []string {
+			if s == "" {
+				return nil
+			}
+			return strings.Split(s, ",")
+		}
+		domain := &rpdom.ResidencyPolicy{
+			GlobalTenantMetadata: commondomain.GlobalTenantMetadata{
+				CommonMetadata: commondomain.CommonMetadata{
+					Name:     name,
+					Provider: provider,
+				},
+				Scope: kernelresource.Scope{Tenant: tenant},
+			},
+			Spec: rpdom.ResidencyPolicySpec{
+				Regions:   split(regions),
+				Providers: split(providers),
+				Zones:     split(zones),
+			},
+		}
+
+		cr1, err := ResidencyPolicyToCR(domain)
+		if err != nil {
+			return
+		}
+
+		domain2, err := ResidencyPolicyFromCR(cr1)
+		if err != nil {
+			t.Errorf("CR→domain failed after successful domain→CR: %v", err)
+			return
+		}
+
+		cr2, err := ResidencyPolicyToCR(domain2)
+		if err != nil {
+			t.Errorf("second domain→CR failed: %v", err)
+			return
+		}
+
+		domain3, err := ResidencyPolicyFromCR(cr2)
+		if err != nil {
+			t.Errorf("second CR→domain failed: %v", err)
+			return
+		}
+
+		if domain2.Name != domain3.Name {
+			t.Errorf("Name not stable: %q → %q", domain2.Name, domain3.Name)
+		}
+		if domain2.Provider != domain3.Provider {
+			t.Errorf("Provider not stable: %q → %q", domain2.Provider, domain3.Provider)
+		}
+		if domain2.Tenant != domain3.Tenant {
+			t.Errorf("Tenant not stable: %q → %q", domain2.Tenant, domain3.Tenant)
+		}
+
+		got := domain3.Spec
+		if !slices.Equal(got.Regions, domain.Spec.Regions) || !slices.Equal(got.Providers, domain.Spec.Providers) ||
+			!slices.Equal(got.Zones, domain.Spec.Zones) {
+			t.Errorf("Spec not preserved: %+v → %+v", domain.Spec, got)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+// +kubebuilder:object:generate=true
+// +groupName=authorization.v1.secapi.cloud
+// +versionName=v1
+
+// Package kubernetes holds the ResidencyPolicy custom resource and its domain conversion.
+//
+// SECA has no residency-policy schema, so unlike the other resources of the group the spec
+// is written by hand rather than generated from the SDK.
+package kubernetes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+)
+
+const (
+	Group   = "authorization.v1.secapi.cloud"
+	Version = "v1"
+
+	ResidencyPolicyResource = "residency-policies"
+	ResidencyPolicyKind     = "ResidencyPolicy"
+)
+
+var (
+	GroupVersion  = schema.GroupVersion{Group: Group, Version: Version}
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+	AddToScheme   = SchemeBuilder.AddToScheme
+
+	ResidencyPolicyGVR = schema.GroupVersionResource{
+		Group: Group, Version: Version, Resource: ResidencyPolicyResource,
+	}
+	ResidencyPolicyGVK = schema.GroupVersionKind{
+		Group: Group, Version: Version, Kind: ResidencyPolicyKind,
+	}
+)
+
+// ResidencyPolicySpec is the desired state of a residency policy.
+type ResidencyPolicySpec struct {
+	// Regions lists the region names the tenant may use. Empty allows every region.
+	// +optional
+	Regions []string `json:"regions,omitempty"`
+
+	// Providers lists the providers the tenant may use, as named in the Region catalog.
+	// Empty allows every provider.
+	// +optional
+	Providers []string `json:"providers,omitempty"`
+
+	// Zones lists the zones the tenant may place resources in. Empty allows every zone.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+}
+
+// ResidencyPolicyStatus Current status of the resource
+type ResidencyPolicyStatus = schemav1.Status
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=residency-policies,scope=Namespaced,shortName=respol
+// +k8s:openapi-gen=true
+
+// ResidencyPolicy is the API for restricting where a tenant's data may live.
+type ResidencyPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec       ResidencyPolicySpec    `json:"spec,omitempty"`
+	CommonData schemav1.CommonData    `json:"commonData,omitempty"`
+	Status     *ResidencyPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type ResidencyPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ResidencyPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResidencyPolicy{}, &ResidencyPolicyList{})
+}
@@ -0,0 +1,88 @@
+// Package residencypolicy defines the residency-policy resource domain model and identity
+// constants.
+//
+// A ResidencyPolicy pins where a tenant's data may live: the regions, the providers and
+// the zones it may use. Unlike a RoleAssignment scope, which only narrows what one subject
+// may do, a residency policy is a hard constraint on the whole tenant. The regional
+// gateway rejects every write outside it, and the global gateway hides the regions it
+// rules out from the tenant's region listing.
+package residencypolicy
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	rdom "github.com/eu-sovereign-cloud/ecp/resource/region/v1"
+)
+
+// Identity constants for the residency-policy resource.
+const (
+	Kind       = "ResidencyPolicy"
+	Resource   = "residency-policies"
+	Group      = "authorization.v1.secapi.cloud"
+	Version    = "v1"
+	ProviderID = "seca.authorization/v1"
+)
+
+// ResidencyPolicy is the domain model for a residency-policy resource.
+type ResidencyPolicy struct {
+	domain.GlobalTenantMetadata
+	Spec   ResidencyPolicySpec
+	Status *ResidencyPolicyStatus
+}
+
+// ResidencyPolicySpec lists what a tenant may use. An empty list allows everything of its
+// dimension.
+type ResidencyPolicySpec struct {
+	// Regions lists the region names the tenant may use, e.g. "itbg-bergamo".
+	Regions []string
+	// Providers lists the providers the tenant may use, as named in the Region catalog,
+	// e.g. "seca.compute".
+	Providers []string
+	// Zones lists the zones the tenant may place resources in, e.g. "itbg-1".
+	Zones []string
+}
+
+// ResidencyPolicyStatus defines the status for a residency policy.
+type ResidencyPolicyStatus struct {
+	domain.Status
+}
+
+// Violations returns why a write through provider into zone of region breaks the policy,
+// or nil when it does not. An empty provider or zone is not checked: tenant-level writes
+// name no zone. The region comes from the Region catalog; one the policy allows must still
+// offer an allowed provider and an allowed zone, or no data of the tenant may live there.
+func (s ResidencyPolicySpec) Violations(region *rdom.Region, provider, zone string) []string {
+	var reasons []string
+	if !allows(s.Regions, region.Name) {
+		reasons = append(reasons, fmt.Sprintf("region %s is not allowed", region.Name))
+	}
+	if provider != "" && !allows(s.Providers, provider) {
+		reasons = append(reasons, fmt.Sprintf("provider %s is not allowed", provider))
+	}
+	if zone != "" && !allows(s.Zones, zone) {
+		reasons = append(reasons, fmt.Sprintf("zone %s is not allowed", zone))
+	}
+	if len(s.Providers) > 0 && len(region.Providers) > 0 && !slices.ContainsFunc(region.Providers, func(p rdom.Provider) bool {
+		return slices.Contains(s.Providers, p.Name)
+	}) {
+		reasons = append(reasons, fmt.Sprintf("region %s offers no allowed provider", region.Name))
+	}
+	if len(s.Zones) > 0 && len(region.Zones) > 0 && !slices.ContainsFunc(region.Zones, func(z rdom.Zone) bool {
+		return slices.Contains(s.Zones, string(z))
+	}) {
+		reasons = append(reasons, fmt.Sprintf("region %s offers no allowed zone", region.Name))
+	}
+	return reasons
+}
+
+// AllowsRegion reports whether the tenant may use region at all.
+func (s ResidencyPolicySpec) AllowsRegion(region *rdom.Region) bool {
+	return len(s.Violations(region, "", "")) == 0
+}
+
+// allows reports whether value is in allowed, an empty list allowing everything.
+func allows(allowed []string, value string) bool {
+	return len(allowed) == 0 || slices.Contains(allowed, value)
+}
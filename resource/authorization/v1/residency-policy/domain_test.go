@@ -0,0 +1,40 @@
+package residencypolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	rdom "github.com/eu-sovereign-cloud/ecp/resource/region/v1"
+)
+
+func newRegion(name string, providers []string, zones ...rdom.Zone) *rdom.Region {
+	r := &rdom.Region{Zones: zones}
+	r.Name = name
+	for _, p := range providers {
+		r.Providers = append(r.Providers, rdom.Provider{Name: p})
+	}
+	return r
+}
+
+func TestViolations(t *testing.T) {
+	bergamo := newRegion("itbg-bergamo", []string{"seca.compute", "seca.storage"}, "itbg-1", "itbg-2")
+
+	assert.Empty(t, ResidencyPolicySpec{}.Violations(bergamo, "seca.compute", "itbg-1"), "an empty policy allows everything")
+
+	italy := ResidencyPolicySpec{Regions: []string{"itbg-bergamo"}, Providers: []string{"seca.compute"}, Zones: []string{"itbg-1"}}
+	assert.Empty(t, italy.Violations(bergamo, "seca.compute", "itbg-1"))
+	assert.Empty(t, italy.Violations(bergamo, "", ""), "tenant-level writes name no provider or zone")
+	assert.Equal(t, []string{"provider seca.storage is not allowed"}, italy.Violations(bergamo, "seca.storage", ""))
+	assert.Equal(t, []string{"zone itbg-2 is not allowed"}, italy.Violations(bergamo, "seca.compute", "itbg-2"))
+	assert.Equal(t, []string{"region region-two is not allowed", "region region-two offers no allowed provider", "region region-two offers no allowed zone"},
+		italy.Violations(newRegion("region-two", []string{"seca.storage"}, "region-two-a"), "", ""))
+}
+
+func TestAllowsRegion(t *testing.T) {
+	compute := ResidencyPolicySpec{Providers: []string{"seca.compute"}}
+	assert.True(t, compute.AllowsRegion(newRegion("itbg-bergamo", []string{"seca.compute"})))
+	assert.False(t, compute.AllowsRegion(newRegion("region-two", []string{"seca.storage"})), "a region offering no allowed provider is ruled out")
+	assert.True(t, compute.AllowsRegion(newRegion("unlisted", nil)), "a region without catalog entries is only checked by name")
+	assert.False(t, ResidencyPolicySpec{Zones: []string{"itbg-1"}}.AllowsRegion(newRegion("region-two", nil, "region-two-a")))
+}
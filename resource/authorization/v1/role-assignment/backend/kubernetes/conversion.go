@@ -58,6 +58,12 @@ func RoleAssignmentFromCR(obj client.Object) (*radom.RoleAssignment, error) {
 	ra.Annotations = cr.CommonData.Annotations
 	ra.Extensions = cr.CommonData.Extensions
 
+	constraints, err := radom.ConstraintsFromExtensions(cr.CommonData.Extensions)
+	if err != nil {
+		return nil, fmt.Errorf("role assignment %s: invalid constraints: %w", cr.GetName(), err)
+	}
+	ra.Spec.Constraints = constraints
+
 	if ts := cr.GetDeletionTimestamp(); ts != nil {
 		ra.DeletedAt = &ts.Time
 	}
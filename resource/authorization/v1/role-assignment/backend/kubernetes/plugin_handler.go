@@ -43,7 +43,7 @@ func (h *RoleAssignmentPluginHandler) HandleReconcile(ctx context.Context, resou
 	// An active resource has no lifecycle transition left to make, so it takes the update
 	// path instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isRoleAssignmentActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*radom.RoleAssignment]
@@ -57,13 +57,13 @@ func (h *RoleAssignmentPluginHandler) HandleReconcile(ctx context.Context, resou
 		delegate = frameworkbackend.BypassDelegated[*radom.RoleAssignment]
 
 	case isRoleAssignmentCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 
 	case wantRoleAssignmentDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*radom.RoleAssignment]
 
 	case isRoleAssignmentDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 
 	case wantRoleAssignmentRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*radom.RoleAssignment]
@@ -136,7 +136,7 @@ func (h *RoleAssignmentPluginHandler) setResourceErrorState(ctx context.Context,
 		resource.Status = &radom.RoleAssignmentStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -190,5 +190,6 @@ func wantRoleAssignmentRetryCreate(resource *radom.RoleAssignment) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
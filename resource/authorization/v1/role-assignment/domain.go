@@ -1,7 +1,14 @@
 // Package roleassignment defines the role assignment resource domain model and identity constants.
 package roleassignment
 
-import "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
 
 // Identity constants for the role assignment resource.
 const (
@@ -12,6 +19,20 @@ const (
 	ProviderID = "seca.authorization/v1"
 )
 
+// Extension keys that constrain when and how a RoleAssignment grants. The SECA schema has
+// no fields for them, so they travel in the resource's extensions map.
+const (
+	// ExtensionNotBefore is an RFC 3339 timestamp before which the assignment grants nothing.
+	ExtensionNotBefore = "notBefore"
+	// ExtensionNotAfter is an RFC 3339 timestamp after which the assignment grants nothing.
+	ExtensionNotAfter = "notAfter"
+	// ExtensionSourceCIDRs is a comma-separated list of CIDRs the request must come from.
+	ExtensionSourceCIDRs = "sourceCIDRs"
+	// ExtensionResourceLabels is a comma-separated list of key=value pairs the target
+	// resource must carry.
+	ExtensionResourceLabels = "resourceLabels"
+)
+
 // RoleAssignment represents the domain model for a role assignment.
 type RoleAssignment struct {
 	domain.GlobalTenantMetadata
@@ -24,6 +45,99 @@ type RoleAssignmentSpec struct {
 	Subs   []string
 	Scopes []RoleAssignmentScope
 	Roles  []string
+
+	// Constraints narrows when and for which requests the assignment grants. Nil grants
+	// unconditionally. It is parsed from the resource's extensions, see ConstraintsFromExtensions.
+	Constraints *RoleAssignmentConstraints
+}
+
+// RoleAssignmentConstraints restricts a RoleAssignment to a validity window and, optionally,
+// to requests matching conditions. Every set field must hold for the assignment to grant.
+type RoleAssignmentConstraints struct {
+	NotBefore      *time.Time
+	NotAfter       *time.Time
+	SourceCIDRs    []netip.Prefix
+	ResourceLabels map[string]string
+}
+
+// ActiveAt reports whether t lies within the validity window.
+func (c *RoleAssignmentConstraints) ActiveAt(t time.Time) bool {
+	if c == nil {
+		return true
+	}
+	if c.NotBefore != nil && t.Before(*c.NotBefore) {
+		return false
+	}
+	if c.NotAfter != nil && !t.Before(*c.NotAfter) {
+		return false
+	}
+	return true
+}
+
+// Expired reports whether the validity window closed before t.
+func (c *RoleAssignmentConstraints) Expired(t time.Time) bool {
+	return c != nil && c.NotAfter != nil && !t.Before(*c.NotAfter)
+}
+
+// TimeBound reports whether the assignment only grants within a validity window.
+func (c *RoleAssignmentConstraints) TimeBound() bool {
+	return c != nil && (c.NotBefore != nil || c.NotAfter != nil)
+}
+
+// Conditional reports whether the assignment only grants for requests matching conditions.
+func (c *RoleAssignmentConstraints) Conditional() bool {
+	return c != nil && (len(c.SourceCIDRs) > 0 || len(c.ResourceLabels) > 0)
+}
+
+// ConstraintsFromExtensions parses the constraint extension keys. It returns nil when none
+// is set, and an error naming the first malformed key otherwise.
+func ConstraintsFromExtensions(extensions map[string]string) (*RoleAssignmentConstraints, error) {
+	var c RoleAssignmentConstraints
+	set := false
+	for _, key := range []string{ExtensionNotBefore, ExtensionNotAfter} {
+		raw, ok := extensions[key]
+		if !ok {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, fmt.Errorf("extension %s: %w", key, err)
+		}
+		if key == ExtensionNotBefore {
+			c.NotBefore = &t
+		} else {
+			c.NotAfter = &t
+		}
+		set = true
+	}
+	if c.NotBefore != nil && c.NotAfter != nil && !c.NotBefore.Before(*c.NotAfter) {
+		return nil, fmt.Errorf("extension %s: must be after %s", ExtensionNotAfter, ExtensionNotBefore)
+	}
+	if raw, ok := extensions[ExtensionSourceCIDRs]; ok {
+		for _, item := range strings.Split(raw, ",") {
+			prefix, err := netip.ParsePrefix(strings.TrimSpace(item))
+			if err != nil {
+				return nil, fmt.Errorf("extension %s: %w", ExtensionSourceCIDRs, err)
+			}
+			c.SourceCIDRs = append(c.SourceCIDRs, prefix.Masked())
+		}
+		set = true
+	}
+	if raw, ok := extensions[ExtensionResourceLabels]; ok {
+		c.ResourceLabels = map[string]string{}
+		for _, item := range strings.Split(raw, ",") {
+			key, value, ok := strings.Cut(strings.TrimSpace(item), "=")
+			if !ok || key == "" {
+				return nil, fmt.Errorf("extension %s: %q is not key=value", ExtensionResourceLabels, item)
+			}
+			c.ResourceLabels[key] = value
+		}
+		set = true
+	}
+	if !set {
+		return nil, nil
+	}
+	return &c, nil
 }
 
 // RoleAssignmentScope defines a single scope (tenants, regions, workspaces) for a role assignment.
@@ -33,7 +33,9 @@ func NewController(
 		RoleFromCR,
 	)
 	handler := NewRolePluginHandler(repo, plugin, options.MaxConditions)
-	return &Controller{
+	handler.SetTimeouts(options.Plugin, options.Timeouts)
+	handler.MaxAttempts = options.MaxAttempts
+	c := &Controller{
 		GenericController: frameworkcontroller.NewGenericController[*roledom.Role](
 			ctrlClient,
 			RoleFromCR,
@@ -44,4 +46,9 @@ func NewController(
 			options.MaxConditions,
 		),
 	}
+	c.RecordBackend(options.Plugin, plugin)
+	c.ConfigureQueue(options.Queue)
+	c.CheckDrift(options.DriftCheckInterval)
+	c.Shard(options.Sharder)
+	return c
 }
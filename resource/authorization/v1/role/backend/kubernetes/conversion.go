@@ -53,6 +53,7 @@ func RoleFromCR(obj client.Object) (*roledom.Role, error) {
 	r.Labels = k8slabels.KeyedToOriginal(keyedLabels, cr.CommonData.Labels)
 	r.Annotations = cr.CommonData.Annotations
 	r.Extensions = cr.CommonData.Extensions
+	r.SystemManaged = internalLabels[k8slabels.InternalSystemManagedLabel] == "true"
 
 	if ts := cr.GetDeletionTimestamp(); ts != nil {
 		r.DeletedAt = &ts.Time
@@ -78,6 +79,9 @@ func RoleToCR(r *roledom.Role) (client.Object, error) {
 	crLabels := k8slabels.OriginalToKeyed(r.Labels)
 	crLabels[k8slabels.InternalTenantLabel] = r.Tenant
 	crLabels[k8slabels.InternalProviderLabel] = strings.ReplaceAll(r.Provider, "/", "_")
+	if r.SystemManaged {
+		crLabels[k8slabels.InternalSystemManagedLabel] = "true"
+	}
 
 	cr := &Role{
 		ObjectMeta: v1.ObjectMeta{
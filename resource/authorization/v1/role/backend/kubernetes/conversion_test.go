@@ -15,14 +15,16 @@ import (
 // Invariants:
 //   - Name, Provider, and Tenant are stable after one round-trip (domain2 == domain3).
 //   - Permissions are stable after one round-trip.
+//   - SystemManaged survives the round-trip unchanged.
 func FuzzRoleSpecRoundTrip(f *testing.F) {
-	f.Add("my-role", "seca.authorization/v1", "t-1", "seca.authorization", "roles", "get")
-	f.Add("", "", "", "", "", "")
-	f.Add("admin-role", "ionos/v1", "tenant-42", "seca.compute", "instances,block-storages", "get,list,create")
+	f.Add("my-role", "seca.authorization/v1", "t-1", "seca.authorization", "roles", "get", false)
+	f.Add("", "", "", "", "", "", false)
+	f.Add("admin-role", "ionos/v1", "tenant-42", "seca.compute", "instances,block-storages", "get,list,create", true)
 
 	f.Fuzz(func(t *testing.T,
 		name, provider, tenant string,
 		permProvider, permResource, permVerb string,
+		systemManaged bool,
 	) {
 		domain := &roledom.Role{
 			GlobalTenantMetadata: commondomain.GlobalTenantMetadata{
@@ -41,6 +43,7 @@ func FuzzRoleSpecRoundTrip(f *testing.F) {
 					},
 				},
 			},
+			SystemManaged: systemManaged,
 		}
 
 		cr1, err := RoleToCR(domain)
@@ -77,6 +80,10 @@ func FuzzRoleSpecRoundTrip(f *testing.F) {
 			t.Errorf("Tenant not stable: %q → %q", domain2.Tenant, domain3.Tenant)
 		}
 
+		if domain3.SystemManaged != systemManaged {
+			t.Errorf("SystemManaged not preserved: %v → %v", systemManaged, domain3.SystemManaged)
+		}
+
 		// Spec stability.
 		if len(domain2.Spec.Permissions) != len(domain3.Spec.Permissions) {
 			t.Errorf("Permissions length not stable: %d → %d", len(domain2.Spec.Permissions), len(domain3.Spec.Permissions))
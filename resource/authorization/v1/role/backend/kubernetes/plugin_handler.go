@@ -44,7 +44,7 @@ func (h *RolePluginHandler) HandleReconcile(ctx context.Context, resource *roled
 	// An active resource has no lifecycle transition left to make, so it takes the update
 	// path instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isRoleActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*roledom.Role]
@@ -58,13 +58,13 @@ func (h *RolePluginHandler) HandleReconcile(ctx context.Context, resource *roled
 		delegate = frameworkbackend.BypassDelegated[*roledom.Role]
 
 	case isRoleCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 
 	case wantRoleDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*roledom.Role]
 
 	case isRoleDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 
 	case wantRoleRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*roledom.Role]
@@ -137,7 +137,7 @@ func (h *RolePluginHandler) setResourceErrorState(ctx context.Context, resource
 		resource.Status = &roledom.RoleStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -191,5 +191,6 @@ func wantRoleRetryCreate(resource *roledom.Role) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
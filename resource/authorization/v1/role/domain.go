@@ -14,6 +14,21 @@ const (
 	ProviderID           = "seca.authorization/v1"
 )
 
+// Providers lists the provider IDs a Permission may name. Authorization matches the
+// provider exactly, so a permission for any other provider would never grant anything.
+var Providers = []string{
+	"seca.authorization",
+	"seca.compute",
+	"seca.network",
+	"seca.region",
+	"seca.storage",
+	"seca.workspace",
+}
+
+// Verbs lists the base verbs a Permission may name besides "*". A base verb may be
+// narrowed to a single action as "<verb>.<action>" (e.g. "post.start").
+var Verbs = []string{"get", "list", "put", "delete", "post", "bind", "escalate"}
+
 // Permission represents a single access control permission.
 type Permission struct {
 	Provider  string
@@ -36,4 +51,8 @@ type Role struct {
 	domain.GlobalTenantMetadata
 	Spec   RoleSpec
 	Status *RoleStatus
+
+	// SystemManaged marks a built-in role created by the control plane when the tenant
+	// was provisioned. The authorization API refuses to update or delete it.
+	SystemManaged bool
 }
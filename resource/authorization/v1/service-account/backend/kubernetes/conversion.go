@@ -0,0 +1,125 @@
+package kubernetes
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// ServiceAccountFromCR converts either a concrete *ServiceAccount or
+// *unstructured.Unstructured into a *sadom.ServiceAccount.
+func ServiceAccountFromCR(obj client.Object) (*sadom.ServiceAccount, error) {
+	var cr ServiceAccount
+
+	switch t := obj.(type) {
+	case *ServiceAccount:
+		cr = *t
+	case *unstructured.Unstructured:
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(t.Object, &cr); err != nil {
+			return nil, fmt.Errorf("failed to convert unstructured to ServiceAccount: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	crLabels := cr.GetLabels()
+	internalLabels := k8slabels.GetInternalLabels(crLabels)
+	keyedLabels := k8slabels.GetKeyedLabels(crLabels)
+
+	sa := &sadom.ServiceAccount{
+		Spec: sadom.ServiceAccountSpec{
+			Description: cr.Spec.Description,
+			Disabled:    cr.Spec.Disabled,
+		},
+	}
+	if cr.Spec.TokensNotBefore != nil {
+		t := cr.Spec.TokensNotBefore.UTC()
+		sa.Spec.TokensNotBefore = &t
+	}
+	sa.Name = cr.GetName()
+	sa.UID = string(cr.GetUID())
+	sa.ResourceVersion = cr.GetResourceVersion()
+	sa.CreatedAt = cr.GetCreationTimestamp().Time
+	sa.UpdatedAt = cr.GetCreationTimestamp().Time
+	sa.Provider = strings.ReplaceAll(internalLabels[k8slabels.InternalProviderLabel], "_", "/")
+	sa.Tenant = internalLabels[k8slabels.InternalTenantLabel]
+	sa.Labels = k8slabels.KeyedToOriginal(keyedLabels, cr.CommonData.Labels)
+	sa.Annotations = cr.CommonData.Annotations
+	sa.Extensions = cr.CommonData.Extensions
+
+	if ts := cr.GetDeletionTimestamp(); ts != nil {
+		sa.DeletedAt = &ts.Time
+	}
+
+	sa.Status = &sadom.ServiceAccountStatus{}
+	if cr.Status != nil {
+		sa.Status.State = commonbackend.ResourceStateFromCR(cr.Status.State)
+		sa.Status.Conditions = commonbackend.ConditionsFromCR(cr.Status.Conditions)
+	} else {
+		sa.Status.PushCondition(commondomain.DefaultPendingCondition)
+	}
+
+	return sa, nil
+}
+
+// ServiceAccountToCR converts a *sadom.ServiceAccount to a Kubernetes ServiceAccount CR.
+func ServiceAccountToCR(sa *sadom.ServiceAccount) (client.Object, error) {
+	if sa == nil {
+		return nil, fmt.Errorf("service account is nil")
+	}
+
+	crLabels := k8slabels.OriginalToKeyed(sa.Labels)
+	crLabels[k8slabels.InternalTenantLabel] = sa.Tenant
+	crLabels[k8slabels.InternalProviderLabel] = strings.ReplaceAll(sa.Provider, "/", "_")
+
+	cr := &ServiceAccount{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            sa.Name,
+			Namespace:       k8sadapter.ComputeNamespace(&kernelresource.Scope{Tenant: sa.Tenant}),
+			Labels:          crLabels,
+			ResourceVersion: sa.ResourceVersion,
+		},
+		CommonData: schemav1.CommonData{
+			Annotations: sa.Annotations,
+			Extensions:  sa.Extensions,
+			Labels:      slices.Sorted(maps.Keys(sa.Labels)),
+		},
+		Spec: ServiceAccountSpec{
+			Description: sa.Spec.Description,
+			Disabled:    sa.Spec.Disabled,
+		},
+	}
+	if sa.Spec.TokensNotBefore != nil {
+		t := v1.NewTime(*sa.Spec.TokensNotBefore)
+		cr.Spec.TokensNotBefore = &t
+	}
+	cr.SetGroupVersionKind(ServiceAccountGVK)
+
+	if sa.Status != nil && len(sa.Status.Conditions) > 0 {
+		state := commonbackend.ResourceStateToCR(sa.Status.State)
+		if state == nil {
+			return nil, fmt.Errorf("service account %s: failed to map resource state domain to CR", sa.Name)
+		}
+		cr.Status = &ServiceAccountStatus{
+			State:      *state,
+			Conditions: commonbackend.ConditionsToCR(sa.Status.Conditions),
+		}
+	}
+
+	return cr, nil
+}
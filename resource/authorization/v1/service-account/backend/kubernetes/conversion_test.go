@@ -0,0 +1,87 @@
+package kubernetes_test
+
+import (
+	"testing"
+	"time"
+
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	sadom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account"
+	. "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/service-account/backend/kubernetes"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// FuzzServiceAccountRoundTrip verifies that a ServiceAccount domain value survives a
+// domain→CR→domain→CR→domain round-trip.
+//
+// Invariants:
+//   - Name, Provider, and Tenant are stable after one round-trip (domain2 == domain3).
+//   - Description and Disabled survive unchanged.
+//   - TokensNotBefore survives at second precision, the resolution of metav1.Time.
+func FuzzServiceAccountRoundTrip(f *testing.F) {
+	f.Add("ci", "seca.authorization/v1", "t-1", "deploys from main", false, int64(0))
+	f.Add("", "", "", "", false, int64(-1))
+	f.Add("nightly", "seca.authorization/v1", "tenant-42", "", true, int64(1767225600))
+
+	f.Fuzz(func(t *testing.T, name, provider, tenant, description string, disabled bool, notBefore int64) {
+		domain := &sadom.ServiceAccount{
+			GlobalTenantMetadata: commondomain.GlobalTenantMetadata{
+				CommonMetadata: commondomain.CommonMetadata{
+					Name:     name,
+					Provider: provider,
+				},
+				Scope: kernelresource.Scope{Tenant: tenant},
+			},
+			Spec: sadom.ServiceAccountSpec{
+				Description: description,
+				Disabled:    disabled,
+			},
+		}
+		if notBefore >= 0 {
+			ts := time.Unix(notBefore, 0).UTC()
+			domain.Spec.TokensNotBefore = &ts
+		}
+
+		cr1, err := ServiceAccountToCR(domain)
+		if err != nil {
+			return
+		}
+
+		domain2, err := ServiceAccountFromCR(cr1)
+		if err != nil {
+			t.Errorf("CR→domain failed after successful domain→CR: %v", err)
+			return
+		}
+
+		cr2, err := ServiceAccountToCR(domain2)
+		if err != nil {
+			t.Errorf("second domain→CR failed: %v", err)
+			return
+		}
+
+		domain3, err := ServiceAccountFromCR(cr2)
+		if err != nil {
+			t.Errorf("second CR→domain failed: %v", err)
+			return
+		}
+
+		if domain2.Name != domain3.Name {
+			t.Errorf("Name not stable: %q → %q", domain2.Name, domain3.Name)
+		}
+		if domain2.Provider != domain3.Provider {
+			t.Errorf("Provider not stable: %q → %q", domain2.Provider, domain3.Provider)
+		}
+		if domain2.Tenant != domain3.Tenant {
+			t.Errorf("Tenant not stable: %q → %q", domain2.Tenant, domain3.Tenant)
+		}
+
+		if domain3.Spec.Description != description || domain3.Spec.Disabled != disabled {
+			t.Errorf("Spec not preserved: %+v → %+v", domain.Spec, domain3.Spec)
+		}
+		switch {
+		case domain.Spec.TokensNotBefore == nil && domain3.Spec.TokensNotBefore != nil:
+			t.Errorf("TokensNotBefore appeared: %v", domain3.Spec.TokensNotBefore)
+		case domain.Spec.TokensNotBefore != nil && (domain3.Spec.TokensNotBefore == nil || !domain3.Spec.TokensNotBefore.Equal(*domain.Spec.TokensNotBefore)):
+			t.Errorf("TokensNotBefore not preserved: %v → %v", domain.Spec.TokensNotBefore, domain3.Spec.TokensNotBefore)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+// +kubebuilder:object:generate=true
+// +groupName=authorization.v1.secapi.cloud
+// +versionName=v1
+
+// Package kubernetes holds the ServiceAccount custom resource and its domain conversion.
+//
+// SECA has no service-account schema, so unlike the other resources of the group the spec
+// is written by hand rather than generated from the SDK.
+package kubernetes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+)
+
+const (
+	Group   = "authorization.v1.secapi.cloud"
+	Version = "v1"
+
+	ServiceAccountResource = "service-accounts"
+	ServiceAccountKind     = "ServiceAccount"
+)
+
+var (
+	GroupVersion  = schema.GroupVersion{Group: Group, Version: Version}
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+	AddToScheme   = SchemeBuilder.AddToScheme
+
+	ServiceAccountGVR = schema.GroupVersionResource{
+		Group: Group, Version: Version, Resource: ServiceAccountResource,
+	}
+	ServiceAccountGVK = schema.GroupVersionKind{
+		Group: Group, Version: Version, Kind: ServiceAccountKind,
+	}
+)
+
+// ServiceAccountSpec is the desired state of a service account.
+type ServiceAccountSpec struct {
+	// Description is free text for operators, e.g. the pipeline using the account.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Disabled rejects every token of the account and refuses to issue new ones.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// TokensNotBefore revokes every token issued before this time.
+	// +optional
+	TokensNotBefore *metav1.Time `json:"tokensNotBefore,omitempty"`
+}
+
+// ServiceAccountStatus Current status of the resource
+type ServiceAccountStatus = schemav1.Status
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=service-accounts,scope=Namespaced,shortName=sa
+// +k8s:openapi-gen=true
+
+// ServiceAccount is the API for managing machine principals of a tenant.
+type ServiceAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec       ServiceAccountSpec    `json:"spec,omitempty"`
+	CommonData schemav1.CommonData   `json:"commonData,omitempty"`
+	Status     *ServiceAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type ServiceAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ServiceAccount `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceAccount{}, &ServiceAccountList{})
+}
@@ -0,0 +1,115 @@
+// Package serviceaccount defines the service-account resource domain model and identity constants.
+//
+// A ServiceAccount is a tenant-scoped machine principal. The global gateway issues it
+// short-lived JWTs whose subject is Subject(tenant, name); RoleAssignments grant it
+// permissions like any other subject. Tokens stay valid only while the service account
+// they were issued for exists, is not disabled, and was not revoked after they were issued;
+// an account recreated under the same name does not honour them.
+package serviceaccount
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// Identity constants for the service-account resource.
+const (
+	Kind       = "ServiceAccount"
+	Resource   = "service-accounts"
+	Group      = "authorization.v1.secapi.cloud"
+	Version    = "v1"
+	ProviderID = "seca.authorization/v1"
+)
+
+// SubjectPrefix starts every service-account subject. No other authenticator may assert a
+// subject with this prefix.
+const SubjectPrefix = "serviceaccount:"
+
+// ServiceAccount is the domain model for a service-account resource.
+type ServiceAccount struct {
+	domain.GlobalTenantMetadata
+	// UID identifies the account among those ever named alike: one deleted and recreated
+	// under the same name gets a new UID. Tokens carry the UID of the account they were
+	// issued for.
+	UID    string
+	Spec   ServiceAccountSpec
+	Status *ServiceAccountStatus
+}
+
+// ServiceAccountSpec defines the specification for a service account.
+type ServiceAccountSpec struct {
+	// Description is free text for operators, e.g. the pipeline using the account.
+	Description string
+	// Disabled rejects every token of the account and refuses to issue new ones.
+	Disabled bool
+	// TokensNotBefore revokes every token issued before it. Nil revokes nothing.
+	TokensNotBefore *time.Time
+}
+
+// ServiceAccountStatus defines the status for a service account.
+type ServiceAccountStatus struct {
+	domain.Status
+}
+
+// Subject returns the token subject of the service account name in tenant.
+func Subject(tenant, name string) string {
+	return SubjectPrefix + tenant + ":" + name
+}
+
+// IsSubject reports whether subject names a service account.
+func IsSubject(subject string) bool {
+	return strings.HasPrefix(subject, SubjectPrefix)
+}
+
+// ParseSubject splits a service-account subject into tenant and name.
+func ParseSubject(subject string) (tenant, name string, err error) {
+	rest, ok := strings.CutPrefix(subject, SubjectPrefix)
+	if !ok {
+		return "", "", fmt.Errorf("subject %q is not a service account", subject)
+	}
+	tenant, name, ok = strings.Cut(rest, ":")
+	if !ok || tenant == "" || name == "" || strings.Contains(name, ":") {
+		return "", "", fmt.Errorf("subject %q is not of the form %s<tenant>:<name>", subject, SubjectPrefix)
+	}
+	return tenant, name, nil
+}
+
+// AcceptsToken reports whether the account honours a token issued for the account with uid
+// at iat. A token without a uid, issued before tokens carried one, is honoured only if it was
+// issued after the account was created.
+func (sa *ServiceAccount) AcceptsToken(uid string, iat time.Time) bool {
+	if sa.DeletedAt != nil || iat.Before(sa.CreatedAt) {
+		return false
+	}
+	if uid != "" && uid != sa.UID {
+		return false
+	}
+	return sa.Spec.AcceptsTokenIssuedAt(iat)
+}
+
+// AcceptsTokenIssuedAt reports whether a token issued at iat is still honoured.
+func (s ServiceAccountSpec) AcceptsTokenIssuedAt(iat time.Time) bool {
+	if s.Disabled {
+		return false
+	}
+	return s.TokensNotBefore == nil || !iat.Before(*s.TokensNotBefore)
+}
+
+// TokenRequest asks for a token of a service account.
+type TokenRequest struct {
+	// TTL is the requested lifetime; zero asks for the issuer's default.
+	TTL time.Duration
+	// Scope down-scopes the token below the account's role assignments.
+	Scope resource.TokenScope
+}
+
+// Token is an issued service-account token.
+type Token struct {
+	Token     string
+	Subject   string
+	ExpiresAt time.Time
+}
@@ -0,0 +1,32 @@
+package serviceaccount
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSubject(t *testing.T) {
+	tenant, name, err := ParseSubject(Subject("t1", "ci"))
+	require.NoError(t, err)
+	assert.Equal(t, "t1", tenant)
+	assert.Equal(t, "ci", name)
+
+	for _, subject := range []string{"alice", "serviceaccount:t1", "serviceaccount::ci", "serviceaccount:t1:", "serviceaccount:t1:a:b"} {
+		_, _, err := ParseSubject(subject)
+		require.Error(t, err, subject)
+	}
+}
+
+func TestAcceptsTokenIssuedAt(t *testing.T) {
+	revokedAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.True(t, ServiceAccountSpec{}.AcceptsTokenIssuedAt(revokedAt))
+	assert.False(t, ServiceAccountSpec{Disabled: true}.AcceptsTokenIssuedAt(revokedAt))
+
+	revoked := ServiceAccountSpec{TokensNotBefore: &revokedAt}
+	assert.False(t, revoked.AcceptsTokenIssuedAt(revokedAt.Add(-time.Second)), "token issued before the revocation")
+	assert.True(t, revoked.AcceptsTokenIssuedAt(revokedAt), "token issued at the revocation instant")
+}
@@ -0,0 +1,91 @@
+package kubernetes
+
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	trdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation"
+)
+
+// TokenRevocationFromCR converts either a concrete *TokenRevocation or
+// *unstructured.Unstructured into a *trdom.TokenRevocation.
+func TokenRevocationFromCR(obj client.Object) (*trdom.TokenRevocation, error) {
+	var cr TokenRevocation
+
+	switch t := obj.(type) {
+	case *TokenRevocation:
+		cr = *t
+	case *unstructured.Unstructured:
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(t.Object, &cr); err != nil {
+			return nil, fmt.Errorf("failed to convert unstructured to TokenRevocation: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	tr := &trdom.TokenRevocation{
+		Spec: trdom.TokenRevocationSpec{
+			JTI:          cr.Spec.JTI,
+			Subject:      cr.Spec.Subject,
+			IssuedBefore: timeFromCR(cr.Spec.IssuedBefore),
+			ExpiresAt:    timeFromCR(cr.Spec.ExpiresAt),
+			Reason:       cr.Spec.Reason,
+		},
+	}
+	tr.Name = cr.GetName()
+	tr.Provider = trdom.ProviderID
+	tr.ResourceVersion = cr.GetResourceVersion()
+	tr.CreatedAt = cr.GetCreationTimestamp().Time
+	tr.UpdatedAt = cr.GetCreationTimestamp().Time
+	if ts := cr.GetDeletionTimestamp(); ts != nil {
+		tr.DeletedAt = &ts.Time
+	}
+
+	return tr, nil
+}
+
+// TokenRevocationToCR converts a *trdom.TokenRevocation to a cluster-scoped Kubernetes
+// TokenRevocation CR.
+func TokenRevocationToCR(tr *trdom.TokenRevocation) (client.Object, error) {
+	if tr == nil {
+		return nil, fmt.Errorf("token revocation is nil")
+	}
+
+	cr := &TokenRevocation{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            tr.Name,
+			ResourceVersion: tr.ResourceVersion,
+		},
+		Spec: TokenRevocationSpec{
+			JTI:          tr.Spec.JTI,
+			Subject:      tr.Spec.Subject,
+			IssuedBefore: timeToCR(tr.Spec.IssuedBefore),
+			ExpiresAt:    timeToCR(tr.Spec.ExpiresAt),
+			Reason:       tr.Spec.Reason,
+		},
+	}
+	cr.SetGroupVersionKind(TokenRevocationGVK)
+
+	return cr, nil
+}
+
+func timeFromCR(t *v1.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	u := t.UTC()
+	return &u
+}
+
+func timeToCR(t *time.Time) *v1.Time {
+	if t == nil {
+		return nil
+	}
+	m := v1.NewTime(*t)
+	return &m
+}
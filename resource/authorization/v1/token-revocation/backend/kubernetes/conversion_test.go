@@ -0,0 +1,63 @@
+package kubernetes_test
+
+import (
+	"testing"
+	"time"
+
+	trdom "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation"
+	. "github.com/eu-sovereign-cloud/ecp/resource/authorization/v1/token-revocation/backend/kubernetes"
+)
+
+// FuzzTokenRevocationRoundTrip verifies that a TokenRevocation domain value survives a
+// domain→CR→domain round-trip.
+//
+// Invariants:
+//   - Name and every string field of the spec survive unchanged.
+//   - IssuedBefore and ExpiresAt survive at second precision, the resolution of metav1.Time.
+func FuzzTokenRevocationRoundTrip(f *testing.F) {
+	f.Add("jti-1", "abc", "", int64(-1), int64(1767225600), "leaked in CI logs")
+	f.Add("subject-1", "", "serviceaccount:t1:ci", int64(1767225600), int64(-1), "")
+	f.Add("", "", "", int64(-1), int64(-1), "")
+
+	f.Fuzz(func(t *testing.T, name, jti, subject string, issuedBefore, expiresAt int64, reason string) {
+		domain := &trdom.TokenRevocation{
+			Spec: trdom.TokenRevocationSpec{JTI: jti, Subject: subject, Reason: reason},
+		}
+		domain.Name = name
+		if issuedBefore >= 0 {
+			ts := time.Unix(issuedBefore, 0).UTC()
+			domain.Spec.IssuedBefore = &ts
+		}
+		if expiresAt >= 0 {
+			ts := time.Unix(expiresAt, 0).UTC()
+			domain.Spec.ExpiresAt = &ts
+		}
+
+		cr, err := TokenRevocationToCR(domain)
+		if err != nil {
+			t.Fatalf("domain→CR failed: %v", err)
+		}
+		got, err := TokenRevocationFromCR(cr)
+		if err != nil {
+			t.Fatalf("CR→domain failed: %v", err)
+		}
+
+		if got.Name != name {
+			t.Errorf("Name not preserved: %q → %q", name, got.Name)
+		}
+		if got.Spec.JTI != jti || got.Spec.Subject != subject || got.Spec.Reason != reason {
+			t.Errorf("Spec not preserved: %+v → %+v", domain.Spec, got.Spec)
+		}
+		for _, ts := range []struct {
+			field     string
+			want, got *time.Time
+		}{
+			{"IssuedBefore", domain.Spec.IssuedBefore, got.Spec.IssuedBefore},
+			{"ExpiresAt", domain.Spec.ExpiresAt, got.Spec.ExpiresAt},
+		} {
+			if (ts.want == nil) != (ts.got == nil) || (ts.want != nil && !ts.want.Equal(*ts.got)) {
+				t.Errorf("%s not preserved: %v → %v", ts.field, ts.want, ts.got)
+			}
+		}
+	})
+}
@@ -0,0 +1,86 @@
+// +kubebuilder:object:generate=true
+// +groupName=authorization.v1.secapi.cloud
+// +versionName=v1
+
+// Package kubernetes holds the TokenRevocation custom resource and its domain conversion.
+//
+// Like the service account, the revocation has no SECA schema, so the spec is written by
+// hand rather than generated from the SDK.
+package kubernetes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+const (
+	Group   = "authorization.v1.secapi.cloud"
+	Version = "v1"
+
+	TokenRevocationResource = "token-revocations"
+	TokenRevocationKind     = "TokenRevocation"
+)
+
+var (
+	GroupVersion  = schema.GroupVersion{Group: Group, Version: Version}
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+	AddToScheme   = SchemeBuilder.AddToScheme
+
+	TokenRevocationGVR = schema.GroupVersionResource{
+		Group: Group, Version: Version, Resource: TokenRevocationResource,
+	}
+	TokenRevocationGVK = schema.GroupVersionKind{
+		Group: Group, Version: Version, Kind: TokenRevocationKind,
+	}
+)
+
+// TokenRevocationSpec names the revoked tokens: one token by jti, or every token of a
+// subject issued before issuedBefore.
+type TokenRevocationSpec struct {
+	// JTI is the "jti" claim of the revoked token.
+	// +optional
+	JTI string `json:"jti,omitempty"`
+
+	// Subject is the "sub" claim of the revoked tokens.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// IssuedBefore revokes the subject's tokens issued before this time.
+	// +optional
+	IssuedBefore *metav1.Time `json:"issuedBefore,omitempty"`
+
+	// ExpiresAt is when the revocation stops mattering; past it the entry matches nothing.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Reason is free text for the audit trail.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=token-revocations,scope=Cluster,shortName=trev
+// +k8s:openapi-gen=true
+
+// TokenRevocation is an entry of the gateways' token revocation list.
+type TokenRevocation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TokenRevocationSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type TokenRevocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TokenRevocation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&TokenRevocation{}, &TokenRevocationList{})
+}
@@ -0,0 +1,55 @@
+package tokenrevocation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+)
+
+func TestValidate(t *testing.T) {
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, TokenRevocationSpec{JTI: "abc"}.Validate())
+	require.NoError(t, TokenRevocationSpec{Subject: "alice", IssuedBefore: &at}.Validate())
+
+	for _, spec := range []TokenRevocationSpec{
+		{},
+		{JTI: "abc", Subject: "alice", IssuedBefore: &at},
+		{Subject: "alice"},
+	} {
+		err := spec.Validate()
+		require.True(t, errors.Is(err, kernel.ErrValidation), "%+v: %v", spec, err)
+	}
+}
+
+func TestRevokes(t *testing.T) {
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	before, after := at.Add(-time.Second), at.Add(time.Second)
+
+	byID := TokenRevocationSpec{JTI: "abc"}
+	assert.True(t, byID.Revokes("abc", "alice", &after, at))
+	assert.False(t, byID.Revokes("def", "alice", &before, at))
+
+	bySubject := TokenRevocationSpec{Subject: "alice", IssuedBefore: &at}
+	assert.True(t, bySubject.Revokes("", "alice", &before, at), "token issued before the revocation")
+	assert.False(t, bySubject.Revokes("", "alice", &at, at), "token issued at the revocation")
+	assert.True(t, bySubject.Revokes("", "alice", nil, at), "token without an issue time")
+	assert.False(t, bySubject.Revokes("", "bob", &before, at))
+
+	expired := TokenRevocationSpec{JTI: "abc", ExpiresAt: &at}
+	assert.True(t, expired.Revokes("abc", "", nil, at))
+	assert.False(t, expired.Revokes("abc", "", nil, after), "entry past its expiry")
+}
+
+func TestName(t *testing.T) {
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, TokenRevocationSpec{JTI: "abc"}.Name(), TokenRevocationSpec{JTI: "abc", Reason: "leaked"}.Name())
+	assert.NotEqual(t, TokenRevocationSpec{JTI: "abc"}.Name(), TokenRevocationSpec{Subject: "abc", IssuedBefore: &at}.Name())
+	assert.Regexp(t, `^[a-z0-9-]{1,63}$`, TokenRevocationSpec{Subject: "serviceaccount:t1:ci"}.Name())
+}
@@ -1,8 +1,10 @@
 package backend
 
 import (
+	"errors"
 	"time"
 
+	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 )
 
@@ -32,12 +34,17 @@ func ConditionFromState(state domain.ResourceState) domain.StatusCondition {
 }
 
 // ConditionFromError creates a domain.StatusCondition representing a reconciliation error.
+// An operation cut off at its deadline is told apart by the OperationTimedOut reason.
 func ConditionFromError(err error) domain.StatusCondition {
+	reason := "ReconcileError"
+	if errors.Is(err, backendport.ErrTimeout) {
+		reason = "OperationTimedOut"
+	}
 	return domain.StatusCondition{
 		LastTransitionAt: time.Now(),
 		Type:             "ReconcileError",
 		State:            domain.ResourceStateError,
-		Reason:           "ReconcileError",
+		Reason:           reason,
 		Message:          err.Error(),
 	}
 }
@@ -14,21 +14,35 @@ import (
 
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
 	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
-	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 )
 
 // ReferenceTarget identifies a single resource resolved from a domain.Reference:
-// the namespace scope (tenant/workspace) and the resource name.
+// the namespace scope (tenant/workspace, and network for network-scoped resources)
+// and the resource name.
 type ReferenceTarget struct {
 	Tenant    string
 	Workspace string
+	Network   string
 	Name      string
 }
 
-// ParseReference resolves a domain.Reference into its tenant, workspace, and name.
+func (t ReferenceTarget) GetTenant() string    { return t.Tenant }
+func (t ReferenceTarget) GetWorkspace() string { return t.Workspace }
+func (t ReferenceTarget) GetNetwork() string   { return t.Network }
+
+// Namespace returns the namespace of the CR the target identifies.
+func (t ReferenceTarget) Namespace() string {
+	if t.Network != "" {
+		return k8sadapter.ComputeNetworkNamespace(t)
+	}
+	return k8sadapter.ComputeNamespace(t)
+}
+
+// ParseReference resolves a domain.Reference into its tenant, workspace, network, and name.
 // Tenant and workspace are read from the reference, whether carried as explicit
 // fields or embedded in the resource path; an empty tenant falls back to defaultTenant.
+// The network is read from a nested resource path (e.g. "networks/n1/subnets/sn1" -> "n1").
 // The name is the last segment of the resource path (e.g. "block-storages/web" -> "web").
 func ParseReference(ref domain.Reference, defaultTenant string) ReferenceTarget {
 	cr := ReferenceToCR(ref)
@@ -38,12 +52,14 @@ func ParseReference(ref domain.Reference, defaultTenant string) ReferenceTarget
 		tenant = defaultTenant
 	}
 
+	network, _ := extractAndStripSegment(cr.Resource, "networks/")
+
 	name := cr.Resource
 	if idx := strings.LastIndex(name, "/"); idx >= 0 {
 		name = name[idx+1:]
 	}
 
-	return ReferenceTarget{Tenant: tenant, Workspace: cr.Workspace, Name: name}
+	return ReferenceTarget{Tenant: tenant, Workspace: cr.Workspace, Network: network, Name: name}
 }
 
 // ReferenceResolver resolves cross-resource dependencies against the Kubernetes API
@@ -69,9 +85,8 @@ func (rr *ReferenceResolver) State(
 	defaultTenant string,
 ) (bool, domain.ResourceState, error) {
 	target := ParseReference(ref, defaultTenant)
-	namespace := k8sadapter.ComputeNamespace(&kernelresource.Scope{Tenant: target.Tenant, Workspace: target.Workspace})
 
-	obj, err := rr.client.Resource(gvr).Namespace(namespace).Get(ctx, target.Name, metav1.GetOptions{})
+	obj, err := rr.client.Resource(gvr).Namespace(target.Namespace()).Get(ctx, target.Name, metav1.GetOptions{})
 	if err != nil {
 		if kerrs.IsNotFound(err) {
 			return false, "", nil
@@ -87,6 +102,24 @@ func (rr *ReferenceResolver) State(
 	return true, ResourceStateFromCR(schemav1.ResourceState(raw)), nil
 }
 
+// AnyActive reports whether any resource of the given GVR in the workspace of tenant is
+// active.
+func (rr *ReferenceResolver) AnyActive(ctx context.Context, gvr schema.GroupVersionResource, tenant, workspace string) (bool, error) {
+	namespace := ReferenceTarget{Tenant: tenant, Workspace: workspace}.Namespace()
+	list, err := rr.client.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return false, fmt.Errorf("%s: failed to list: %w", gvr.Resource, err)
+	}
+
+	for i := range list.Items {
+		raw, _, _ := unstructured.NestedString(list.Items[i].Object, "status", "state")
+		if ResourceStateFromCR(schemav1.ResourceState(raw)) == domain.ResourceStateActive {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // Referrers lists resources of the given GVR in namespace and returns the names of
 // those whose reference at fieldPath (e.g. {"spec","blockStorageRef"}) points at the
 // target. defaultTenant is used when a listed reference omits its tenant.
@@ -121,7 +154,7 @@ func (rr *ReferenceResolver) Referrers(
 		}
 
 		got := ParseReference(ReferenceFromCR(crRef), defaultTenant)
-		if got.Tenant == target.Tenant && got.Workspace == target.Workspace && got.Name == target.Name {
+		if got == target {
 			names = append(names, item.GetName())
 		}
 	}
@@ -52,6 +52,14 @@ func TestParseReference(t *testing.T) {
 			defaultTenant: "t1",
 			want:          commonbackend.ReferenceTarget{Tenant: "t1", Workspace: "", Name: "img1"},
 		},
+		{
+			name: "network-scoped reference",
+			// Reference.resource: networks/{network}/{collection}/{name}
+			// Spec: https://spec.secapi.cloud/docs/content/Architecture/resource-model#metadata
+			ref:           commondomain.Reference{Workspace: "w1", Resource: "networks/n1/subnets/sn1"},
+			defaultTenant: "t1",
+			want:          commonbackend.ReferenceTarget{Tenant: "t1", Workspace: "w1", Network: "n1", Name: "sn1"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -89,6 +97,26 @@ func TestReferenceResolver_State(t *testing.T) {
 	})
 }
 
+func TestReferenceResolver_AnyActive(t *testing.T) {
+	tenant := "t1"
+	namespace := k8sadapter.ComputeNamespace(&kernelresource.Scope{Tenant: tenant, Workspace: "w1"})
+	other := k8sadapter.ComputeNamespace(&kernelresource.Scope{Tenant: tenant, Workspace: "w2"})
+
+	dynFake := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds(),
+		newRefObject("storage.test/v1", "BlockStorage", namespace, "bs1", "creating", nil),
+		newRefObject("storage.test/v1", "BlockStorage", other, "bs2", "active", nil),
+	)
+	resolver := commonbackend.NewReferenceResolver(dynFake)
+
+	active, err := resolver.AnyActive(context.Background(), bsGVR, tenant, "w1")
+	require.NoError(t, err)
+	require.False(t, active, "only a creating one in the workspace, the active one is in another")
+
+	active, err = resolver.AnyActive(context.Background(), bsGVR, tenant, "w2")
+	require.NoError(t, err)
+	require.True(t, active)
+}
+
 func TestReferenceResolver_Referrers(t *testing.T) {
 	tenant, workspace := "t1", "w1"
 	imageNamespace := k8sadapter.ComputeNamespace(&kernelresource.Scope{Tenant: tenant})
@@ -0,0 +1,141 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// StateResolver resolves a reference to whether its target exists and its lifecycle
+// state. ReferenceResolver implements it.
+type StateResolver interface {
+	State(ctx context.Context, gvr schema.GroupVersionResource, ref domain.Reference, defaultTenant string) (bool, domain.ResourceState, error)
+}
+
+// WorkspaceStateResolver resolves whether any resource of a kind in a workspace is active.
+// ReferenceResolver implements it. A resolver handed Dependencies with an AnyInWorkspace
+// dependency must implement it.
+type WorkspaceStateResolver interface {
+	AnyActive(ctx context.Context, gvr schema.GroupVersionResource, tenant, workspace string) (bool, error)
+}
+
+// Dependency declares references a resource of type D must see active before it is
+// created.
+type Dependency[D any] struct {
+	// Name names the referenced resources in DependencyPending messages, e.g. "source image".
+	Name string
+	// GVR is the GroupVersionResource of the referenced kind.
+	GVR schema.GroupVersionResource
+	// Workspaced reports whether the referenced kind is workspace-scoped: a reference to it
+	// that omits its workspace points into the workspace of the resource.
+	Workspaced bool
+	// Refs returns the references of a resource to the kind, leaving out optional references
+	// the resource does not set.
+	Refs func(resource D) []domain.Reference
+	// AnyInWorkspace, set instead of Refs, makes the resource wait on any resource of the kind
+	// in its workspace being active rather than on a referenced one, e.g. a network on an
+	// internet gateway to egress through. A resource outside any workspace waits on none.
+	AnyInWorkspace bool
+}
+
+// Dependencies declares everything a resource of type D waits on before it is created.
+// A slice declares them once, next to its plugin handler, and gates the pending to
+// creating transition on Pending, so that no plugin has to.
+type Dependencies[D persistence.IdentifiableResource] []Dependency[D]
+
+// Pending returns a DependencyPending condition naming every dependency of resource that
+// does not exist or is not active, or nil once all of them are active. The condition
+// keeps the resource pending.
+func (deps Dependencies[D]) Pending(ctx context.Context, resolver StateResolver, resource D) (*domain.StatusCondition, error) {
+	var missing []string
+	for _, dep := range deps {
+		if dep.AnyInWorkspace {
+			if resource.GetWorkspace() == "" {
+				continue
+			}
+			active, err := anyActive(ctx, resolver, dep.GVR, resource)
+			if err != nil {
+				return nil, err
+			}
+			if !active {
+				missing = append(missing, fmt.Sprintf("%s in workspace %q (none active)", dep.Name, resource.GetWorkspace()))
+			}
+			continue
+		}
+		for _, ref := range dep.Refs(resource) {
+			if dep.Workspaced && ReferenceToCR(ref).Workspace == "" {
+				ref.Workspace = resource.GetWorkspace()
+			}
+
+			exists, state, err := resolver.State(ctx, dep.GVR, ref, resource.GetTenant())
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case !exists:
+				missing = append(missing, fmt.Sprintf("%s %q (not found)", dep.Name, ref.Resource))
+			case state == "":
+				missing = append(missing, fmt.Sprintf("%s %q (not reconciled yet)", dep.Name, ref.Resource))
+			case state != domain.ResourceStateActive:
+				missing = append(missing, fmt.Sprintf("%s %q (%s)", dep.Name, ref.Resource, state))
+			}
+		}
+	}
+	if len(missing) == 0 {
+		return nil, nil
+	}
+
+	c := DependencyPendingCondition(domain.ResourceStatePending, "waiting for dependencies to be active: "+strings.Join(missing, ", "))
+	return &c, nil
+}
+
+// anyActive reports whether any resource of gvr in the workspace of resource is active.
+func anyActive[D persistence.IdentifiableResource](ctx context.Context, resolver StateResolver, gvr schema.GroupVersionResource, resource D) (bool, error) {
+	r, ok := resolver.(WorkspaceStateResolver)
+	if !ok {
+		return false, fmt.Errorf("%s: the dependency resolver cannot look up a workspace", gvr.Resource)
+	}
+	return r.AnyActive(ctx, gvr, resource.GetTenant(), resource.GetWorkspace())
+}
+
+// AwaitDependencies gates the pending to creating transition of resource, whose status is
+// status, on deps, and reports whether all of them are active. While one is not, it records
+// the DependencyPending condition from Pending and persists it; a dependency that cannot be
+// resolved is recorded as an error, counted against maxAttempts, and requeued.
+//
+// The pending condition is written once: the controller watches its own writes, so
+// re-writing an unchanged wait on every pass would keep the resource reconciling. Nor is the
+// resource requeued while it waits, the controller watching the kinds it references.
+func AwaitDependencies[D persistence.IdentifiableResource](
+	ctx context.Context,
+	resource D,
+	status *domain.Status,
+	deps Dependencies[D],
+	resolver StateResolver,
+	repo persistence.WriterRepo[D],
+	maxConditions int,
+	maxAttempts int,
+) (ready, requeue bool, err error) {
+	c, err := deps.Pending(ctx, resolver, resource)
+	if err != nil {
+		PushErrorCondition(status, err, maxAttempts)
+		TrimConditions(status, maxConditions)
+		return false, true, persistIgnoringMissing(ctx, resource, repo)
+	}
+	if c == nil {
+		return true, false, nil
+	}
+
+	if previous := status.PeekConditions(); previous != nil && domain.EqualStatusConditions(*previous, *c) {
+		return false, false, nil
+	}
+	status.PushCondition(*c)
+	TrimConditions(status, maxConditions)
+	return false, false, persistIgnoringMissing(ctx, resource, repo)
+}
@@ -0,0 +1,195 @@
+package backend_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// server is a minimal resource waiting on a boot volume and on data volumes.
+type server struct {
+	commondomain.RegionalMetadata
+	Boot commondomain.Reference
+	Data []commondomain.Reference
+}
+
+var serverDependencies = commonbackend.Dependencies[*server]{
+	{
+		Name:       "block storage",
+		GVR:        bsGVR,
+		Workspaced: true,
+		Refs: func(s *server) []commondomain.Reference {
+			return append([]commondomain.Reference{s.Boot}, s.Data...)
+		},
+	},
+}
+
+// stateResolver resolves references from a fixed map of "workspace/resource" to state; a
+// missing entry is a resource that does not exist.
+type stateResolver struct {
+	states map[string]commondomain.ResourceState
+	err    error
+}
+
+func (r *stateResolver) State(_ context.Context, _ schema.GroupVersionResource, ref commondomain.Reference, _ string) (bool, commondomain.ResourceState, error) {
+	if r.err != nil {
+		return false, "", r.err
+	}
+	state, ok := r.states[ref.Workspace+"/"+ref.Resource]
+	return ok, state, nil
+}
+
+// workspaceResolver reports the "tenant/workspace" scopes in active as having an active
+// resource of every kind.
+type workspaceResolver struct {
+	stateResolver
+	active map[string]bool
+}
+
+func (r *workspaceResolver) AnyActive(_ context.Context, _ schema.GroupVersionResource, tenant, workspace string) (bool, error) {
+	return r.active[tenant+"/"+workspace], nil
+}
+
+func newServer(boot string, data ...string) *server {
+	s := &server{
+		RegionalMetadata: commondomain.RegionalMetadata{
+			CommonMetadata: commondomain.CommonMetadata{Name: "vm"},
+			Scope:          kernelresource.Scope{Tenant: "t1", Workspace: "w1"},
+		},
+		Boot: commondomain.Reference{Resource: boot},
+	}
+	for _, d := range data {
+		s.Data = append(s.Data, commondomain.Reference{Resource: d})
+	}
+	return s
+}
+
+func TestDependencies_Pending(t *testing.T) {
+	t.Run("returns nil once every dependency is active", func(t *testing.T) {
+		resolver := &stateResolver{states: map[string]commondomain.ResourceState{
+			"w1/block-storages/boot": commondomain.ResourceStateActive,
+			"w1/block-storages/data": commondomain.ResourceStateActive,
+		}}
+
+		c, err := serverDependencies.Pending(context.Background(), resolver, newServer("block-storages/boot", "block-storages/data"))
+
+		require.NoError(t, err)
+		require.Nil(t, c)
+	})
+
+	t.Run("names every missing or inactive dependency", func(t *testing.T) {
+		resolver := &stateResolver{states: map[string]commondomain.ResourceState{
+			"w1/block-storages/boot": commondomain.ResourceStateCreating,
+			"w1/block-storages/new":  "",
+		}}
+
+		c, err := serverDependencies.Pending(context.Background(), resolver, newServer("block-storages/boot", "block-storages/gone", "block-storages/new"))
+
+		require.NoError(t, err)
+		require.NotNil(t, c)
+		require.Equal(t, "DependencyPending", c.Type)
+		require.Equal(t, commondomain.ResourceStatePending, c.State)
+		require.Equal(t, `waiting for dependencies to be active: `+
+			`block storage "block-storages/boot" (creating), `+
+			`block storage "block-storages/gone" (not found), `+
+			`block storage "block-storages/new" (not reconciled yet)`, c.Message)
+	})
+
+	t.Run("keeps a workspace embedded in the resource path", func(t *testing.T) {
+		resolver := &stateResolver{states: map[string]commondomain.ResourceState{
+			"/workspaces/w2/block-storages/boot": commondomain.ResourceStateActive,
+		}}
+
+		c, err := serverDependencies.Pending(context.Background(), resolver, newServer("workspaces/w2/block-storages/boot"))
+
+		require.NoError(t, err)
+		require.Nil(t, c)
+	})
+
+	t.Run("waits on any active resource of a kind in the workspace", func(t *testing.T) {
+		deps := commonbackend.Dependencies[*server]{{Name: "block storage", GVR: bsGVR, AnyInWorkspace: true}}
+
+		c, err := deps.Pending(context.Background(), &workspaceResolver{}, newServer(""))
+		require.NoError(t, err)
+		require.NotNil(t, c)
+		require.Equal(t, `waiting for dependencies to be active: block storage in workspace "w1" (none active)`, c.Message)
+
+		c, err = deps.Pending(context.Background(), &workspaceResolver{active: map[string]bool{"t1/w1": true}}, newServer(""))
+		require.NoError(t, err)
+		require.Nil(t, c)
+
+		_, err = deps.Pending(context.Background(), &stateResolver{}, newServer(""))
+		require.Error(t, err, "a resolver that cannot look up a workspace")
+
+		outside := newServer("")
+		outside.Workspace = ""
+		c, err = deps.Pending(context.Background(), &workspaceResolver{}, outside)
+		require.NoError(t, err)
+		require.Nil(t, c, "a resource outside any workspace waits on none")
+	})
+
+	t.Run("returns resolver errors", func(t *testing.T) {
+		_, err := serverDependencies.Pending(context.Background(), &stateResolver{err: errors.New("boom")}, newServer("block-storages/boot"))
+
+		require.EqualError(t, err, "boom")
+	})
+}
+
+// statusWrites counts the status writes of servers, the half of AwaitDependencies' contract
+// the conditions alone do not show.
+type statusWrites struct{ n int }
+
+func (r *statusWrites) Delete(context.Context, *server) error { return nil }
+func (r *statusWrites) Create(_ context.Context, s *server) (**server, error) {
+	return &s, nil
+}
+
+func (r *statusWrites) Update(_ context.Context, s *server) (**server, error) {
+	return &s, nil
+}
+
+func (r *statusWrites) UpdateStatus(_ context.Context, s *server) (**server, error) {
+	r.n++
+	return &s, nil
+}
+
+func TestAwaitDependencies(t *testing.T) {
+	resolver := &stateResolver{states: map[string]commondomain.ResourceState{
+		"w1/block-storages/boot": commondomain.ResourceStateCreating,
+	}}
+	s := newServer("block-storages/boot")
+	status := &commondomain.Status{State: commondomain.ResourceStatePending}
+	repo := &statusWrites{}
+
+	ready, requeue, err := commonbackend.AwaitDependencies(context.Background(), s, status, serverDependencies, resolver, repo, 5, 3)
+	require.NoError(t, err)
+	require.False(t, ready)
+	require.False(t, requeue, "the controller watches the dependencies instead")
+	require.Equal(t, "DependencyPending", status.Conditions[0].Type)
+	require.Equal(t, 1, repo.n)
+
+	_, _, err = commonbackend.AwaitDependencies(context.Background(), s, status, serverDependencies, resolver, repo, 5, 3)
+	require.NoError(t, err)
+	require.Equal(t, 1, repo.n, "an unchanged wait is not written again")
+	require.Equal(t, 1, status.Conditions[0].Occurrences)
+
+	resolver.states["w1/block-storages/boot"] = commondomain.ResourceStateActive
+	ready, _, err = commonbackend.AwaitDependencies(context.Background(), s, status, serverDependencies, resolver, repo, 5, 3)
+	require.NoError(t, err)
+	require.True(t, ready)
+	require.Equal(t, 1, repo.n, "the caller writes the transition to creating")
+
+	ready, requeue, err = commonbackend.AwaitDependencies(context.Background(), s, status, serverDependencies, &stateResolver{err: errors.New("boom")}, repo, 5, 3)
+	require.NoError(t, err)
+	require.False(t, ready)
+	require.True(t, requeue)
+	require.Equal(t, commondomain.ResourceStateError, status.State)
+	require.Equal(t, 2, repo.n)
+}
@@ -0,0 +1,155 @@
+package backend
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/refgraph"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+	igwdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway"
+	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
+	nicdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic"
+	publicipdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/public-ip"
+	routetabledom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/route-table"
+	securitygroupdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group"
+	securitygroupruledom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group-rule"
+	subnetdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/subnet"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+	imgdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/image"
+	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
+)
+
+// The reference graph of the SECA resources: the kinds a reference can point to, and the
+// reference fields of every kind that has some. They are declared here, from the identity
+// constants of the domain packages, rather than in each slice, because slices reference each
+// other both ways (a block storage its source image, an image its block storage) and their
+// backend packages cannot import one another.
+//
+// SKU references are left out: SKUs are the provider's catalog, never deleted through the API
+// and never waited on.
+
+// Reference targets.
+var (
+	InstanceTarget          = workspaced(instancedom.Group, instancedom.Version, instancedom.Kind, instancedom.Resource)
+	NICTarget               = workspaced(nicdom.Group, nicdom.Version, nicdom.Kind, nicdom.Resource)
+	PublicIPTarget          = workspaced(publicipdom.Group, publicipdom.Version, publicipdom.Kind, publicipdom.Resource)
+	SecurityGroupTarget     = workspaced(securitygroupdom.Group, securitygroupdom.Version, securitygroupdom.Kind, securitygroupdom.Resource)
+	SecurityGroupRuleTarget = workspaced(securitygroupruledom.Group, securitygroupruledom.Version, securitygroupruledom.Kind, securitygroupruledom.Resource)
+	InternetGatewayTarget   = workspaced(igwdom.Group, igwdom.Version, igwdom.Kind, igwdom.Resource)
+	SubnetTarget            = networked(subnetdom.Group, subnetdom.Version, subnetdom.Kind, subnetdom.Resource)
+	RouteTableTarget        = networked(routetabledom.Group, routetabledom.Version, routetabledom.Kind, routetabledom.Resource)
+	BlockStorageTarget      = workspaced(bsdom.Group, bsdom.Version, bsdom.Kind, bsdom.Resource)
+	ImageTarget             = refgraph.Target{
+		GVK:        schema.GroupVersionKind{Group: imgdom.Group, Version: imgdom.Version, Kind: imgdom.Kind},
+		Collection: imgdom.Resource,
+	}
+	NetworkTarget   = workspaced(netdom.Group, netdom.Version, netdom.Kind, netdom.Resource)
+	WorkspaceTarget = refgraph.Target{
+		GVK:        schema.GroupVersionKind{Group: wsdom.Group, Version: wsdom.Version, Kind: wsdom.Kind},
+		Collection: wsdom.Resource,
+	}
+)
+
+// Referrers: the kinds whose resources reference others.
+var (
+	InstanceReferrer = referrer(instancedom.Group, instancedom.Version, instancedom.Kind, instancedom.Resource,
+		refgraph.Reference{Path: []string{"spec", "primaryNicRef"}, Targets: []refgraph.Target{NICTarget}},
+		refgraph.Reference{Path: []string{"spec", "additionalNicRefs", "*"}, Targets: []refgraph.Target{NICTarget}},
+		refgraph.Reference{Path: []string{"spec", "bootVolume", "deviceRef"}, Targets: []refgraph.Target{BlockStorageTarget}},
+		refgraph.Reference{Path: []string{"spec", "dataVolumes", "*", "deviceRef"}, Targets: []refgraph.Target{BlockStorageTarget}},
+		refgraph.Reference{Path: []string{"spec", "securityGroupRef"}, Targets: []refgraph.Target{SecurityGroupTarget}},
+	)
+	NICReferrer = referrer(nicdom.Group, nicdom.Version, nicdom.Kind, nicdom.Resource,
+		refgraph.Reference{Path: []string{"spec", "subnetRef"}, Targets: []refgraph.Target{SubnetTarget}},
+		refgraph.Reference{Path: []string{"spec", "publicIpRefs", "*"}, Targets: []refgraph.Target{PublicIPTarget}},
+		refgraph.Reference{Path: []string{"spec", "securityGroupRefs", "*"}, Targets: []refgraph.Target{SecurityGroupTarget}},
+	)
+	SubnetReferrer = referrer(subnetdom.Group, subnetdom.Version, subnetdom.Kind, subnetdom.Resource,
+		refgraph.Reference{Path: []string{"spec", "routeTableRef"}, Targets: []refgraph.Target{RouteTableTarget}},
+	)
+	RouteTableReferrer = referrer(routetabledom.Group, routetabledom.Version, routetabledom.Kind, routetabledom.Resource,
+		refgraph.Reference{Path: []string{"spec", "routes", "*", "targetRef"}, Targets: []refgraph.Target{InstanceTarget, InternetGatewayTarget}},
+	)
+	SecurityGroupReferrer = referrer(securitygroupdom.Group, securitygroupdom.Version, securitygroupdom.Kind, securitygroupdom.Resource,
+		refgraph.Reference{Path: []string{"spec", "ruleRefs", "*"}, Targets: []refgraph.Target{SecurityGroupRuleTarget}},
+		refgraph.Reference{Path: []string{"spec", "rules", "*", "sourceRef", "*"}, Targets: []refgraph.Target{SecurityGroupTarget}},
+	)
+	SecurityGroupRuleReferrer = referrer(securitygroupruledom.Group, securitygroupruledom.Version, securitygroupruledom.Kind, securitygroupruledom.Resource,
+		refgraph.Reference{Path: []string{"spec", "sourceRef", "*"}, Targets: []refgraph.Target{SecurityGroupTarget}},
+	)
+	BlockStorageReferrer = referrer(bsdom.Group, bsdom.Version, bsdom.Kind, bsdom.Resource,
+		refgraph.Reference{Path: []string{"spec", "sourceImageRef"}, Targets: []refgraph.Target{ImageTarget}},
+	)
+	ImageReferrer = referrer(imgdom.Group, imgdom.Version, imgdom.Kind, imgdom.Resource,
+		refgraph.Reference{Path: []string{"spec", "blockStorageRef"}, Targets: []refgraph.Target{BlockStorageTarget}},
+	)
+)
+
+// Enclosed: the kinds a workspace, or a network, holds. They reference it through their scope
+// rather than a field, and hold its deletion all the same. They are kept apart from the
+// referrers above, whose references the controllers watch: a resource does not wait on the
+// state of its workspace or network, a network aside (see NetworkWaitsOn).
+var Enclosed = []refgraph.Referrer{
+	enclosed(instancedom.Group, instancedom.Version, instancedom.Kind, instancedom.Resource, false),
+	enclosed(nicdom.Group, nicdom.Version, nicdom.Kind, nicdom.Resource, false),
+	enclosed(publicipdom.Group, publicipdom.Version, publicipdom.Kind, publicipdom.Resource, false),
+	enclosed(securitygroupdom.Group, securitygroupdom.Version, securitygroupdom.Kind, securitygroupdom.Resource, false),
+	enclosed(securitygroupruledom.Group, securitygroupruledom.Version, securitygroupruledom.Kind, securitygroupruledom.Resource, false),
+	enclosed(igwdom.Group, igwdom.Version, igwdom.Kind, igwdom.Resource, false),
+	enclosed(bsdom.Group, bsdom.Version, bsdom.Kind, bsdom.Resource, false),
+	enclosed(netdom.Group, netdom.Version, netdom.Kind, netdom.Resource, false),
+	enclosed(subnetdom.Group, subnetdom.Version, subnetdom.Kind, subnetdom.Resource, true),
+	enclosed(routetabledom.Group, routetabledom.Version, routetabledom.Kind, routetabledom.Resource, true),
+}
+
+// NetworkWaitsOn are the references a network waits on before it is created: its workspace,
+// and an internet gateway in it to egress through. The network controller watches them; they
+// are left out of Referrers, so neither holds the deletion of a workspace or gateway.
+var NetworkWaitsOn = []refgraph.Reference{
+	{Enclosing: refgraph.InWorkspace, Targets: []refgraph.Target{WorkspaceTarget}},
+	{Enclosing: refgraph.AnyInWorkspace, Targets: []refgraph.Target{InternetGatewayTarget}},
+}
+
+// Referrers lists every referrer kind, to build the refgraph.Graph of a process.
+var Referrers = append([]refgraph.Referrer{
+	InstanceReferrer,
+	NICReferrer,
+	SubnetReferrer,
+	RouteTableReferrer,
+	SecurityGroupReferrer,
+	SecurityGroupRuleReferrer,
+	BlockStorageReferrer,
+	ImageReferrer,
+}, Enclosed...)
+
+func workspaced(group, version, kind, collection string) refgraph.Target {
+	return refgraph.Target{
+		GVK:        schema.GroupVersionKind{Group: group, Version: version, Kind: kind},
+		Collection: collection,
+		Workspaced: true,
+	}
+}
+
+func networked(group, version, kind, collection string) refgraph.Target {
+	target := workspaced(group, version, kind, collection)
+	target.Networked = true
+	return target
+}
+
+func referrer(group, version, kind, collection string, refs ...refgraph.Reference) refgraph.Referrer {
+	return refgraph.Referrer{
+		GVR:        schema.GroupVersionResource{Group: group, Version: version, Resource: collection},
+		Kind:       kind,
+		Collection: collection,
+		References: refs,
+	}
+}
+
+// enclosed returns the referrer of a workspaced kind to its workspace and, when networked, to
+// its network.
+func enclosed(group, version, kind, collection string, networked bool) refgraph.Referrer {
+	refs := []refgraph.Reference{{Enclosing: refgraph.InWorkspace, Targets: []refgraph.Target{WorkspaceTarget}}}
+	if networked {
+		refs = append(refs, refgraph.Reference{Enclosing: refgraph.InNetwork, Targets: []refgraph.Target{NetworkTarget}})
+	}
+	return referrer(group, version, kind, collection, refs...)
+}
@@ -0,0 +1,46 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// PushErrorCondition pushes the condition of a failed operation onto status, counting the
+// attempts in its Occurrences.
+//
+// A failed operation is retried by stepping back into the state it failed in, so the status
+// alternates between that state and the error: pushed as is, every failure would start over at
+// one occurrence. A failure that repeats the previous one, with only the retry in between,
+// therefore replaces the retry and bumps the previous failure instead. Once it has occurred
+// maxAttempts times, it is marked as exhausted and no longer retried automatically (see
+// domain.Status.RetriesExhausted). A maxAttempts of zero or less retries forever.
+//
+// A failed deletion is never exhausted: the resource would otherwise be held by its finalizer
+// forever, while its owner asked for it to be gone.
+func PushErrorCondition(status *domain.Status, err error, maxAttempts int) {
+	condition := ConditionFromError(err)
+
+	if c := status.Conditions; len(c) > 2 && isRetry(c[0]) && c[2].State == c[0].State &&
+		c[1].Type == condition.Type && c[1].Reason == condition.Reason {
+		condition.LastTransitionAt = time.Now()
+		condition.Occurrences = c[1].Occurrences + 1
+		status.Conditions = c[1:]
+		status.Conditions[0] = condition
+		status.State = condition.State
+	} else {
+		status.PushCondition(condition)
+	}
+
+	if maxAttempts <= 0 || len(status.Conditions) < 2 || status.Conditions[1].State == domain.ResourceStateDeleting {
+		return
+	}
+	if head := status.PeekConditions(); head.Occurrences >= maxAttempts {
+		head.Reason = domain.RetriesExhaustedReason
+	}
+}
+
+// isRetry reports whether c is the plain state transition a failed operation is retried with.
+func isRetry(c domain.StatusCondition) bool {
+	return c.State != domain.ResourceStateError && c.Reason == string(c.State)
+}
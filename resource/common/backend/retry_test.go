@@ -0,0 +1,92 @@
+package backend
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// attempt replays what a reconciler does for one failed attempt of the operation of state: it
+// steps back into state, as the want*Retry* arms do, and records the failure.
+func attempt(status *domain.Status, state domain.ResourceState, err error, maxAttempts int) {
+	if status.State == domain.ResourceStateError {
+		status.PushCondition(ConditionFromState(state))
+	}
+	PushErrorCondition(status, err, maxAttempts)
+	TrimConditions(status, maxConditions)
+}
+
+func TestPushErrorCondition_CountsAttempts(t *testing.T) {
+	status := &domain.Status{}
+	status.PushCondition(ConditionFromState(domain.ResourceStateCreating))
+
+	for range 3 {
+		attempt(status, domain.ResourceStateCreating, errors.New("unknown image"), 0)
+	}
+
+	head := status.PeekConditions()
+	assert.Equal(t, domain.ResourceStateError, status.State)
+	assert.Equal(t, 3, head.Occurrences)
+	assert.Len(t, status.Conditions, 2, "the retries in between are folded away")
+	assert.Equal(t, domain.ResourceStateCreating, status.Conditions[1].State)
+	assert.False(t, status.RetriesExhausted(), "zero attempts retry forever")
+}
+
+func TestPushErrorCondition_ExhaustsBudget(t *testing.T) {
+	status := &domain.Status{}
+	status.PushCondition(ConditionFromState(domain.ResourceStateCreating))
+
+	attempt(status, domain.ResourceStateCreating, errors.New("unknown image"), 3)
+	attempt(status, domain.ResourceStateCreating, errors.New("unknown image"), 3)
+	require.False(t, status.RetriesExhausted())
+
+	attempt(status, domain.ResourceStateCreating, errors.New("still unknown"), 3)
+	assert.True(t, status.RetriesExhausted())
+	assert.Equal(t, "still unknown", status.PeekConditions().Message, "the last failure is reported")
+}
+
+func TestPushErrorCondition_NeverExhaustsDeletion(t *testing.T) {
+	status := &domain.Status{}
+	status.PushCondition(ConditionFromState(domain.ResourceStateDeleting))
+
+	for range 5 {
+		attempt(status, domain.ResourceStateDeleting, errors.New("busy"), 2)
+	}
+
+	assert.Equal(t, 5, status.PeekConditions().Occurrences)
+	assert.False(t, status.RetriesExhausted())
+}
+
+func TestPushErrorCondition_OtherOperationStartsOver(t *testing.T) {
+	status := &domain.Status{}
+	status.PushCondition(ConditionFromState(domain.ResourceStateCreating))
+	attempt(status, domain.ResourceStateCreating, errors.New("boom"), 0)
+	attempt(status, domain.ResourceStateCreating, errors.New("boom"), 0)
+
+	status.PushCondition(ConditionFromState(domain.ResourceStateDeleting))
+	PushErrorCondition(status, errors.New("boom"), 0)
+
+	assert.Equal(t, 1, status.PeekConditions().Occurrences)
+}
+
+func TestRearm(t *testing.T) {
+	status := &domain.Status{}
+	status.PushCondition(ConditionFromState(domain.ResourceStateCreating))
+	assert.False(t, status.Rearm(), "nothing failed")
+
+	attempt(status, domain.ResourceStateCreating, errors.New("unknown image"), 1)
+	require.True(t, status.RetriesExhausted())
+
+	require.True(t, status.Rearm())
+	assert.Equal(t, domain.ResourceStateCreating, status.State)
+	assert.False(t, status.RetriesExhausted())
+
+	// The re-armed operation has a fresh budget.
+	PushErrorCondition(status, errors.New("unknown image"), 2)
+	assert.Equal(t, 1, status.PeekConditions().Occurrences)
+	assert.False(t, status.RetriesExhausted())
+}
@@ -29,9 +29,18 @@ const updateFailedConditionType = "UpdateFailed"
 // recoverable - an "error" state matches no arm of the reconciler, so the resource would be
 // stranded there and a corrected spec would never be retried.
 //
+// A failing update is retried until it has failed maxAttempts times in a row, counted in the
+// Occurrences of its UpdateFailed condition; the condition then takes the RetriesExhausted reason
+// and the plugin is not called again until the retry action re-arms the resource (see
+// domain.Status.Rearm). A maxAttempts of zero or less retries forever.
+//
 // Status is written only when what it reports actually changes. The controller watches its own
 // writes, so a status write on every pass would feed itself: an unchanged failure re-written each
 // reconcile would keep the resource reconciling forever.
+//
+// Level-triggered does not mean the provider is called on every pass, though. When the controller
+// tracks the spec last applied (see backendport.AppliedSpec) and reports it current, the plugin is
+// not called at all; a successful update is reported back so the controller can record the spec.
 func HandleUpdate[D persistence.IdentifiableResource](
 	ctx context.Context,
 	resource D,
@@ -39,44 +48,87 @@ func HandleUpdate[D persistence.IdentifiableResource](
 	update backendport.DelegatedFunc[D],
 	repo persistence.WriterRepo[D],
 	maxConditions int,
+	maxAttempts int,
 ) (requeue bool, err error) {
+	if status.RetriesExhausted() {
+		// The update failed maxAttempts times. It waits for the retry action.
+		return false, nil
+	}
+
+	applied := backendport.AppliedSpecFromContext(ctx)
+	if applied != nil && applied.Current {
+		// Nothing changed since the plugin last applied the spec successfully.
+		return false, nil
+	}
+
 	switch updateErr := update(ctx, resource); {
 	case updateErr == nil:
-		return false, clearUpdateFailure(ctx, resource, status, repo, maxConditions)
+		if err := clearUpdateFailure(ctx, resource, status, repo, maxConditions); err != nil {
+			return false, err
+		}
+		if applied != nil {
+			applied.Applied = true
+		}
+		return false, nil
 
 	case errors.Is(updateErr, backendport.ErrStillProcessing):
 		// In flight, not failed. Leave the status alone and come back to it.
 		return true, nil
 
-	default:
+	case errors.Is(updateErr, backendport.ErrNotSupported):
 		// A provider that cannot apply the change at all is not retried: re-issuing an operation
 		// it has already refused would spin forever, and the reason it gave is more useful to the
-		// user than another attempt. Every other failure is assumed transient and requeued.
-		return !errors.Is(updateErr, backendport.ErrNotSupported),
-			recordUpdateFailure(ctx, resource, status, repo, maxConditions, updateErr)
+		// user than another attempt. Not being retried, it does not count against the budget.
+		return false, recordUpdateFailure(ctx, resource, status, repo, maxConditions, 0, updateErr)
+
+	default:
+		// Every other failure is assumed transient and requeued, until the budget runs out.
+		if err := recordUpdateFailure(ctx, resource, status, repo, maxConditions, maxAttempts, updateErr); err != nil {
+			return true, err
+		}
+		return !status.RetriesExhausted(), nil
 	}
 }
 
 // recordUpdateFailure surfaces why the provider would not apply the change, keeping the resource
-// active. It writes nothing when the same failure is already the most recent condition.
+// active, and counts the attempt against maxAttempts. Without a budget to count against, it
+// writes nothing when the same failure is already the most recent condition.
 func recordUpdateFailure[D persistence.IdentifiableResource](
 	ctx context.Context,
 	resource D,
 	status *domain.Status,
 	repo persistence.WriterRepo[D],
 	maxConditions int,
+	maxAttempts int,
 	updateErr error,
 ) error {
 	condition := UpdateFailedCondition(domain.ResourceStateActive, updateErr.Error())
+	if errors.Is(updateErr, backendport.ErrTimeout) {
+		condition.Reason = "OperationTimedOut"
+	}
 
-	// PushCondition would still bump LastTransitionAt and Occurrences for an identical condition,
-	// and that is enough of a change to trigger another reconcile. Report a stable failure once.
-	if previous := status.PeekConditions(); previous != nil && domain.EqualStatusConditions(*previous, condition) {
+	head := status.PeekConditions()
+	switch {
+	case maxAttempts > 0 && head != nil && head.Type == condition.Type && head.Reason == condition.Reason:
+		// Another attempt failing the same way, whatever the provider said this time.
+		head.Message, head.LastTransitionAt = condition.Message, condition.LastTransitionAt
+		head.Occurrences++
+
+	case maxAttempts <= 0 && head != nil && domain.EqualStatusConditions(*head, condition):
+		// PushCondition would still bump LastTransitionAt and Occurrences for an identical
+		// condition, and that is enough of a change to trigger another reconcile. Report a stable
+		// failure once.
 		return nil
+
+	default:
+		status.PushCondition(condition)
+		TrimConditions(status, maxConditions)
+		head = status.PeekConditions()
 	}
 
-	status.PushCondition(condition)
-	TrimConditions(status, maxConditions)
+	if maxAttempts > 0 && head.Occurrences >= maxAttempts {
+		head.Reason = domain.RetriesExhaustedReason
+	}
 
 	return persistIgnoringMissing(ctx, resource, repo)
 }
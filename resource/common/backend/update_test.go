@@ -56,7 +56,7 @@ func TestHandleUpdate_ClearsBuriedFailure(t *testing.T) {
 	repo := &countingRepo{}
 
 	// A failed update, then a lifecycle transition on top of it, exactly as a resize would leave it.
-	requeue, err := HandleUpdate(context.Background(), resource, &resource.status, fails, repo, maxConditions)
+	requeue, err := HandleUpdate(context.Background(), resource, &resource.status, fails, repo, maxConditions, 0)
 	require.NoError(t, err)
 	require.True(t, requeue, "a transient failure must be retried")
 	require.Equal(t, updateFailedConditionType, resource.status.Conditions[0].Type)
@@ -66,7 +66,7 @@ func TestHandleUpdate_ClearsBuriedFailure(t *testing.T) {
 	require.NotEqual(t, updateFailedConditionType, resource.status.Conditions[0].Type,
 		"the failure must be buried for this test to mean anything")
 
-	_, err = HandleUpdate(context.Background(), resource, &resource.status, succeeds, repo, maxConditions)
+	_, err = HandleUpdate(context.Background(), resource, &resource.status, succeeds, repo, maxConditions, 0)
 	require.NoError(t, err)
 
 	for _, c := range resource.status.Conditions {
@@ -83,13 +83,13 @@ func TestHandleUpdate_SuccessIsQuiet(t *testing.T) {
 	resource := &updatable{name: "r1"}
 	repo := &countingRepo{}
 
-	_, err := HandleUpdate(context.Background(), resource, &resource.status, fails, repo, maxConditions)
+	_, err := HandleUpdate(context.Background(), resource, &resource.status, fails, repo, maxConditions, 0)
 	require.NoError(t, err)
 	require.Equal(t, 1, repo.statusWrites, "the failure is reported once")
 
 	// First success retracts it and writes; every later one must be silent.
 	for range 5 {
-		_, err = HandleUpdate(context.Background(), resource, &resource.status, succeeds, repo, maxConditions)
+		_, err = HandleUpdate(context.Background(), resource, &resource.status, succeeds, repo, maxConditions, 0)
 		require.NoError(t, err)
 	}
 
@@ -97,14 +97,15 @@ func TestHandleUpdate_SuccessIsQuiet(t *testing.T) {
 		"one write to retract the failure, and nothing after it")
 }
 
-// TestHandleUpdate_StableFailureReportedOnce pins that a provider failing the same way on every
-// pass is reported once rather than re-written each reconcile.
+// TestHandleUpdate_StableFailureReportedOnce pins that, without a retry budget to count against,
+// a provider failing the same way on every pass is reported once rather than re-written each
+// reconcile.
 func TestHandleUpdate_StableFailureReportedOnce(t *testing.T) {
 	resource := &updatable{name: "r1"}
 	repo := &countingRepo{}
 
 	for range 5 {
-		requeue, err := HandleUpdate(context.Background(), resource, &resource.status, fails, repo, maxConditions)
+		requeue, err := HandleUpdate(context.Background(), resource, &resource.status, fails, repo, maxConditions, 0)
 		require.NoError(t, err)
 		require.True(t, requeue)
 	}
@@ -121,7 +122,7 @@ func TestHandleUpdate_NotSupportedIsNotRetried(t *testing.T) {
 	requeue, err := HandleUpdate(context.Background(), resource, &resource.status,
 		func(context.Context, *updatable) error {
 			return errors.Join(backendport.ErrNotSupported, errors.New("region is immutable"))
-		}, repo, maxConditions)
+		}, repo, maxConditions, 0)
 
 	require.NoError(t, err)
 	require.False(t, requeue, "a refused change must not be re-issued")
@@ -138,10 +139,82 @@ func TestHandleUpdate_StillProcessingLeavesStatusAlone(t *testing.T) {
 
 	requeue, err := HandleUpdate(context.Background(), resource, &resource.status,
 		func(context.Context, *updatable) error { return backendport.ErrStillProcessing },
-		repo, maxConditions)
+		repo, maxConditions, 0)
 
 	require.NoError(t, err)
 	require.True(t, requeue)
 	require.Empty(t, resource.status.Conditions)
 	require.Zero(t, repo.statusWrites)
 }
+
+// TestHandleUpdate_CurrentSpecSkipsThePlugin pins that a spec the plugin already applied is not
+// handed to it again, and that a successful update is reported back for the controller to record.
+func TestHandleUpdate_CurrentSpecSkipsThePlugin(t *testing.T) {
+	resource := &updatable{name: "r1"}
+	repo := &countingRepo{}
+	calls := 0
+	update := func(context.Context, *updatable) error { calls++; return nil }
+
+	applied := &backendport.AppliedSpec{}
+	requeue, err := HandleUpdate(backendport.ContextWithAppliedSpec(context.Background(), applied),
+		resource, &resource.status, update, repo, maxConditions, 0)
+	require.NoError(t, err)
+	require.False(t, requeue)
+	require.Equal(t, 1, calls)
+	require.True(t, applied.Applied)
+
+	applied = &backendport.AppliedSpec{Current: true}
+	requeue, err = HandleUpdate(backendport.ContextWithAppliedSpec(context.Background(), applied),
+		resource, &resource.status, update, repo, maxConditions, 0)
+	require.NoError(t, err)
+	require.False(t, requeue)
+	require.Equal(t, 1, calls, "an unchanged spec must not reach the provider")
+	require.Zero(t, repo.statusWrites)
+}
+
+// TestHandleUpdate_FailureIsNotApplied pins that a failed update is not recorded as applied, so
+// the same spec is handed to the plugin again.
+func TestHandleUpdate_FailureIsNotApplied(t *testing.T) {
+	resource := &updatable{name: "r1"}
+	applied := &backendport.AppliedSpec{}
+
+	_, err := HandleUpdate(backendport.ContextWithAppliedSpec(context.Background(), applied),
+		resource, &resource.status, fails, &countingRepo{}, maxConditions, 0)
+
+	require.NoError(t, err)
+	require.False(t, applied.Applied)
+}
+
+// TestHandleUpdate_ExhaustsBudget pins that a failing update is not requeued forever: the
+// attempts are counted on the UpdateFailed condition, the plugin is left alone once they reach
+// maxAttempts, and the retry action re-arms it with a fresh budget.
+func TestHandleUpdate_ExhaustsBudget(t *testing.T) {
+	resource := &updatable{name: "r1"}
+	repo := &countingRepo{}
+	calls := 0
+	update := func(ctx context.Context, u *updatable) error { calls++; return fails(ctx, u) }
+
+	for i := range 3 {
+		requeue, err := HandleUpdate(context.Background(), resource, &resource.status, update, repo, maxConditions, 3)
+		require.NoError(t, err)
+		require.Equal(t, i < 2, requeue, "attempt %d", i+1)
+		require.Equal(t, i+1, resource.status.Conditions[0].Occurrences)
+	}
+	require.True(t, resource.status.RetriesExhausted())
+	require.Equal(t, domain.ResourceStateActive, resource.status.State)
+	require.Equal(t, 3, repo.statusWrites, "every counted attempt is persisted")
+
+	requeue, err := HandleUpdate(context.Background(), resource, &resource.status, update, repo, maxConditions, 3)
+	require.NoError(t, err)
+	require.False(t, requeue)
+	require.Equal(t, 3, calls, "an exhausted update must not reach the provider")
+
+	require.True(t, resource.status.Rearm())
+	require.Equal(t, domain.ResourceStateActive, resource.status.State)
+
+	requeue, err = HandleUpdate(context.Background(), resource, &resource.status, update, repo, maxConditions, 3)
+	require.NoError(t, err)
+	require.True(t, requeue)
+	require.Equal(t, 4, calls)
+	require.Equal(t, 1, resource.status.Conditions[0].Occurrences, "a re-armed update has a fresh budget")
+}
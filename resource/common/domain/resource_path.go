@@ -39,6 +39,10 @@ func collectionType(kind string) string {
 		return "role-assignments"
 	case "region":
 		return "regions"
+	case "admission-policy":
+		return "admission-policies"
+	case "residency-policy":
+		return "residency-policies"
 	default:
 		// Fallback: append "s" (legacy ResourceFormat behaviour).
 		if kind != "" && kind[len(kind)-1] != 's' {
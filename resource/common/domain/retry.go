@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"time"
+)
+
+const (
+	// RetriesExhaustedReason marks the failure a resource is no longer retried from.
+	RetriesExhaustedReason = "RetriesExhausted"
+	// RetryRequestedReason marks a failed operation re-armed through the retry action.
+	RetryRequestedReason = "RetryRequested"
+)
+
+// RetriesExhausted reports whether the last operation of the resource failed more times than it
+// is retried. Every want*Retry* predicate of the reconcilers checks it: the resource stays in
+// error until Rearm is called. A failed update of an active resource leaves it active rather than
+// in error, and is not attempted again either until Rearm is called.
+func (s *Status) RetriesExhausted() bool {
+	head := s.PeekConditions()
+	return head != nil && head.Reason == RetriesExhaustedReason
+}
+
+// Rearm steps a resource whose retries are exhausted back into the state its operation failed
+// in, so that the operation is attempted again with a fresh budget. It reports false, changing
+// nothing, when the retries of the resource are not exhausted.
+func (s *Status) Rearm() bool {
+	if !s.RetriesExhausted() {
+		return false
+	}
+	// A failed lifecycle operation put the resource in error, right after the state it failed
+	// in; a failed update left it in its state.
+	state := s.Conditions[0].State
+	if state == ResourceStateError {
+		if len(s.Conditions) < 2 {
+			return false
+		}
+		state = s.Conditions[1].State
+	}
+	s.PushCondition(StatusCondition{
+		LastTransitionAt: time.Now(),
+		Type:             "Reconcile",
+		State:            state,
+		Reason:           RetryRequestedReason,
+		Message:          "Retry of the failed operation requested.",
+	})
+	return true
+}
@@ -0,0 +1,94 @@
+package frontend
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+)
+
+// EventsAction is the action segment of the events routes, GET .../{name}/events.
+const EventsAction = "events"
+
+// Event types of the API representation of an event.
+const (
+	EventTypeNormal  = "normal"
+	EventTypeWarning = "warning"
+)
+
+// EventList is the API representation of the events of a resource, oldest first.
+type EventList struct {
+	Items []Event `json:"items"`
+}
+
+// Event is the API representation of one event of a resource.
+type Event struct {
+	// Type is EventTypeWarning for a failure, or anything else holding the resource back,
+	// and EventTypeNormal otherwise.
+	Type    string `json:"type"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+	// State is the SECA state the event left the resource in.
+	State           string    `json:"state,omitempty"`
+	Occurrences     int       `json:"occurrences"`
+	FirstOccurredAt time.Time `json:"firstOccurredAt"`
+	LastOccurredAt  time.Time `json:"lastOccurredAt"`
+}
+
+// HandleEvents is a generic helper for the events routes. It answers the events recorded for
+// the resource of kind identified by ir, or 404 Not Found when the resource does not exist:
+// the events of a deleted resource outlive it for a while, but are not served.
+func HandleEvents[D persistence.IdentifiableResource](
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *slog.Logger,
+	ir persistence.IdentifiableResource,
+	reader persistence.ReaderRepo[D],
+	newWithIdentity func(persistence.IdentifiableResource) D,
+	events backendport.EventReader,
+	kind string,
+) {
+	getter := &eventsGetter[D]{reader: reader, newWithIdentity: newWithIdentity, events: events, kind: kind}
+	rest.HandleGet(w, r, logger.With("op", EventsAction), ir, getter, eventsToAPI)
+}
+
+// eventsGetter reads the events of a resource once it has loaded the resource.
+type eventsGetter[D persistence.IdentifiableResource] struct {
+	reader          persistence.ReaderRepo[D]
+	newWithIdentity func(persistence.IdentifiableResource) D
+	events          backendport.EventReader
+	kind            string
+}
+
+func (g *eventsGetter[D]) Do(ctx context.Context, ir persistence.IdentifiableResource) ([]backendport.Event, error) {
+	d := g.newWithIdentity(ir)
+	if err := g.reader.Load(ctx, &d); err != nil {
+		return nil, err
+	}
+	return g.events.Events(ctx, g.kind, ir)
+}
+
+// eventsToAPI converts the events of a resource to their API representation.
+func eventsToAPI(events []backendport.Event) *EventList {
+	list := &EventList{Items: make([]Event, len(events))}
+	for i, e := range events {
+		eventType := EventTypeNormal
+		if e.Warning {
+			eventType = EventTypeWarning
+		}
+		list.Items[i] = Event{
+			Type:            eventType,
+			Reason:          e.Reason,
+			Message:         e.Message,
+			State:           e.State,
+			Occurrences:     e.Count,
+			FirstOccurredAt: e.FirstAt,
+			LastOccurredAt:  e.LastAt,
+		}
+	}
+	return list
+}
@@ -0,0 +1,50 @@
+package frontend
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// RetryAction is the action segment of the retry routes, POST .../{name}/retry.
+const RetryAction = "retry"
+
+// HandleRetry is a generic helper for the retry action. It re-arms a resource whose failed
+// operation has exhausted its attempts, and answers 202 Accepted: the delegator attempts the
+// operation again with a fresh budget. A resource that is not in that state is a 409 Conflict.
+// status returns the status of the resource, nil when it has none.
+func HandleRetry[D persistence.IdentifiableResource](
+	w http.ResponseWriter,
+	r *http.Request,
+	logger *slog.Logger,
+	ir persistence.IdentifiableResource,
+	reader persistence.ReaderRepo[D],
+	writer persistence.WriterRepo[D],
+	newWithIdentity func(persistence.IdentifiableResource) D,
+	status func(D) *domain.Status,
+) {
+	logger = logger.With("name", ir.GetName(), "tenant", ir.GetTenant(), "workspace", ir.GetWorkspace(), "op", RetryAction)
+
+	d := newWithIdentity(ir)
+	if err := reader.Load(r.Context(), &d); err != nil {
+		rest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+
+	if s := status(d); s == nil || !s.Rearm() {
+		rest.WriteErrorResponse(w, r, logger, kernel.NewError(kernel.KindConflict,
+			fmt.Errorf("%q has no failed operation to retry", ir.GetName())))
+		return
+	}
+
+	if _, err := writer.UpdateStatus(r.Context(), d); err != nil {
+		rest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
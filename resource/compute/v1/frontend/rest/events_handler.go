@@ -0,0 +1,27 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+)
+
+// RegisterEventRoutes registers the events of the instances, GET .../{name}/events, on mux
+// under baseURL, wrapped in middlewares. The SECA spec has no such route, so it is not part of
+// the generated server.
+func (h *Handler) RegisterEventRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	var handler http.Handler = http.HandlerFunc(h.GetInstanceEvents)
+	for _, mw := range middlewares {
+		handler = mw(handler)
+	}
+	mux.Handle("GET "+baseURL+"/v1/tenants/{tenant}/workspaces/{workspace}/"+instancedom.Resource+"/{name}/"+commonfrontend.EventsAction, handler)
+}
+
+// GetInstanceEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/instances/{name}/events.
+func (h *Handler) GetInstanceEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "compute", "resource", "instance")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.InstanceReader, newInstanceWithIdentity, h.Events, instancedom.Kind)
+}
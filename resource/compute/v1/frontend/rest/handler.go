@@ -6,6 +6,9 @@ import (
 
 	sdkcompute "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.compute.v1"
 
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/integrity"
 	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
 	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
 	skudom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/sku"
@@ -18,7 +21,13 @@ type Handler struct {
 	InstanceReader persistencepkg.ReaderRepo[*instancedom.Instance]
 	InstanceWriter persistencepkg.WriterRepo[*instancedom.Instance]
 	SKUReader      persistencepkg.ReaderRepo[*skudom.InstanceSKU]
-	Logger         *slog.Logger
+	// Admission reviews created and updated resources; nil admits every write.
+	Admission admission.Reviewer
+	// Integrity refuses the deletion of resources others still reference; nil checks nothing.
+	Integrity integrity.Checker
+	// Events reads the events served by RegisterEventRoutes.
+	Events backendport.EventReader
+	Logger *slog.Logger
 }
 
 var _ sdkcompute.ServerInterface = (*Handler)(nil)
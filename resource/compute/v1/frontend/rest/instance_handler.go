@@ -34,7 +34,7 @@ func (h *Handler) DeleteInstance(w http.ResponseWriter, r *http.Request, tenant
 	if params.IfUnmodifiedSince != nil {
 		id.Version = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.InstanceWriter, newInstanceWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, instancedom.Resource, frest.DeleterFromRepo(h.InstanceWriter, newInstanceWithIdentity)))
 }
 
 // GetInstance handles GET /v1/tenants/{tenant}/workspaces/{workspace}/instances/{name}.
@@ -79,6 +79,8 @@ func (h *Handler) CreateOrUpdateInstance(w http.ResponseWriter, r *http.Request,
 			return dom
 		},
 		DomainToAPI: instanceToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    instancedom.Resource,
 	})
 }
 
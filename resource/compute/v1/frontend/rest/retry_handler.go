@@ -0,0 +1,34 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+)
+
+// RegisterRetryRoutes registers the retry action of the instances, POST .../{name}/retry, on
+// mux under baseURL, wrapped in middlewares. The SECA spec has no such action, so the route is
+// not part of the generated server.
+func (h *Handler) RegisterRetryRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	var handler http.Handler = http.HandlerFunc(h.RetryInstance)
+	for _, mw := range middlewares {
+		handler = mw(handler)
+	}
+	mux.Handle("POST "+baseURL+"/v1/tenants/{tenant}/workspaces/{workspace}/"+instancedom.Resource+"/{name}/"+commonfrontend.RetryAction, handler)
+}
+
+// RetryInstance handles POST /v1/tenants/{tenant}/workspaces/{workspace}/instances/{name}/retry.
+func (h *Handler) RetryInstance(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "compute", "resource", "instance")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.InstanceReader, h.InstanceWriter, newInstanceWithIdentity,
+		func(inst *instancedom.Instance) *commondomain.Status {
+			if inst.Status == nil {
+				return nil
+			}
+			return &inst.Status.Status
+		})
+}
@@ -7,6 +7,7 @@ import (
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
 	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/builder"
 	frameworkcontroller "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/controller"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
 )
 
@@ -27,14 +28,17 @@ func NewController(
 		dynClient,
 		InstanceGVR,
 		options.Logger,
-		InstanceToCR,
-		InstanceFromCR,
+		InstanceToCR(options.Sealer),
+		InstanceFromCR(options.Sealer),
 	)
-	handler := NewInstancePluginHandler(repo, plugin, options.MaxConditions)
-	return &Controller{
+	deps := commonbackend.NewReferenceResolver(dynClient)
+	handler := NewInstancePluginHandler(repo, plugin, options.MaxConditions, deps)
+	handler.SetTimeouts(options.Plugin, options.Timeouts)
+	handler.MaxAttempts = options.MaxAttempts
+	c := &Controller{
 		GenericController: frameworkcontroller.NewGenericController[*instancedom.Instance](
 			ctrlClient,
-			InstanceFromCR,
+			InstanceFromCR(options.Sealer),
 			handler,
 			&Instance{},
 			options.RequeueAfter,
@@ -42,4 +46,11 @@ func NewController(
 			options.MaxConditions,
 		),
 	}
+	c.RecordBackend(options.Plugin, plugin)
+	c.ConfigureQueue(options.Queue)
+	c.CheckDrift(options.DriftCheckInterval)
+	c.Shard(options.Sharder)
+	c.WatchReferences(commonbackend.InstanceReferrer.References...)
+	c.BlockReferencedDeletion(options.ReferenceGraph)
+	return c
 }
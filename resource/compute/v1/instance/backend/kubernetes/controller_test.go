@@ -77,10 +77,10 @@ func TestInstanceController_Reconcile(t *testing.T) {
 		var buf bytes.Buffer
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 1)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 1, nil)
 		gc := frameworkcontroller.NewGenericController[*instancedom.Instance](
 			fakeClient,
-			InstanceFromCR,
+			InstanceFromCR(nil),
 			handler,
 			&Instance{},
 			0,
@@ -108,10 +108,10 @@ func TestInstanceController_Reconcile(t *testing.T) {
 		var buf bytes.Buffer
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 1)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 1, nil)
 		gc := frameworkcontroller.NewGenericController[*instancedom.Instance](
 			fakeClient,
-			InstanceFromCR,
+			InstanceFromCR(nil),
 			handler,
 			&Instance{},
 			0,
@@ -143,10 +143,10 @@ func TestInstanceController_Reconcile(t *testing.T) {
 		logger := slog.New(slog.NewTextHandler(&buf, nil))
 
 		requeueAfter := 5 * time.Minute
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 1)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 1, nil)
 		gc := frameworkcontroller.NewGenericController[*instancedom.Instance](
 			fakeClient,
-			InstanceFromCR,
+			InstanceFromCR(nil),
 			handler,
 			&Instance{},
 			requeueAfter,
@@ -1,6 +1,7 @@
 package kubernetes
 
 import (
+	"encoding/json"
 	"fmt"
 	"maps"
 	"slices"
@@ -11,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/envelope"
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
 	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
 	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
@@ -30,8 +32,57 @@ const (
 	restartIDAnnotation = k8slabels.InternalLabelPrefix + "restart-id"
 	// restartPhaseAnnotation carries the durable restart phase (power-off|power-on).
 	restartPhaseAnnotation = k8slabels.InternalLabelPrefix + "restart-phase"
+	// sealedSpecAnnotation carries spec.userData and spec.sshKeys, envelope-encrypted, when
+	// the converters are given a sealer. The spec fields are then left empty.
+	// An annotation rather than the spec fields themselves, because a sealed value is longer
+	// than the spec's maxLength allows.
+	sealedSpecAnnotation = k8slabels.SealedSpecAnnotation
 )
 
+// sealedSpec is the plaintext of sealedSpecAnnotation.
+type sealedSpec struct {
+	UserData string   `json:"userData,omitempty"`
+	SshKeys  []string `json:"sshKeys,omitempty"`
+}
+
+// sealSpec seals the sensitive fields of spec with s into the value of sealedSpecAnnotation,
+// bound to identity (the instance it belongs to, so that it does not open when copied onto
+// another instance), and clears them from spec. It returns "" and leaves spec alone when s is
+// nil or there is nothing to seal.
+func sealSpec(s *envelope.Sealer, identity []byte, spec *InstanceSpec) (string, error) {
+	if s == nil || (spec.UserData == "" && len(spec.SshKeys) == 0) {
+		return "", nil
+	}
+	plaintext, err := json.Marshal(sealedSpec{UserData: spec.UserData, SshKeys: spec.SshKeys})
+	if err != nil {
+		return "", err
+	}
+	value, err := s.Seal(plaintext, identity)
+	if err != nil {
+		return "", fmt.Errorf("failed to seal instance spec: %w", err)
+	}
+	spec.UserData, spec.SshKeys = "", nil
+	return value, nil
+}
+
+// openSpec restores into spec the sensitive fields sealed in value, the content of
+// sealedSpecAnnotation, sealed for identity.
+func openSpec(s *envelope.Sealer, identity []byte, value string, spec *instancedom.InstanceSpec) error {
+	if s == nil {
+		return fmt.Errorf("instance spec is sealed but no encryption key is configured")
+	}
+	plaintext, err := s.Open(value, identity)
+	if err != nil {
+		return fmt.Errorf("failed to open sealed instance spec: %w", err)
+	}
+	var sealed sealedSpec
+	if err := json.Unmarshal(plaintext, &sealed); err != nil {
+		return fmt.Errorf("failed to decode sealed instance spec: %w", err)
+	}
+	spec.UserData, spec.SshKeys = sealed.UserData, sealed.SshKeys
+	return nil
+}
+
 // volumeReferenceFromCR converts a schemav1.VolumeReference into an instancedom.VolumeReference.
 func volumeReferenceFromCR(ref schemav1.VolumeReference) instancedom.VolumeReference {
 	return instancedom.VolumeReference{
@@ -48,8 +99,16 @@ func volumeReferenceToCR(ref instancedom.VolumeReference) schemav1.VolumeReferen
 	}
 }
 
-// InstanceFromCR converts either a concrete *Instance or *unstructured.Unstructured into an *instancedom.Instance.
-func InstanceFromCR(obj client.Object) (*instancedom.Instance, error) {
+// InstanceFromCR returns the converter of either a concrete *Instance or
+// *unstructured.Unstructured into an *instancedom.Instance. Sealed fields are opened with s,
+// so the domain object always carries them in plain text; a nil s fails on a sealed instance.
+func InstanceFromCR(s *envelope.Sealer) func(client.Object) (*instancedom.Instance, error) {
+	return func(obj client.Object) (*instancedom.Instance, error) {
+		return instanceFromCR(s, obj)
+	}
+}
+
+func instanceFromCR(s *envelope.Sealer, obj client.Object) (*instancedom.Instance, error) {
 	var cr Instance
 
 	switch t := obj.(type) {
@@ -108,6 +167,12 @@ func InstanceFromCR(obj client.Object) (*instancedom.Instance, error) {
 	}
 
 	crAnnotations := cr.GetAnnotations()
+	if sealed, ok := crAnnotations[sealedSpecAnnotation]; ok {
+		identity := envelope.Identity(inst.Tenant, inst.Workspace, inst.Name)
+		if err := openSpec(s, identity, sealed, &inst.Spec); err != nil {
+			return nil, fmt.Errorf("instance %s: %w", cr.GetName(), err)
+		}
+	}
 	inst.DesiredPowerState = instancedom.PowerState(crAnnotations[desiredPowerStateAnnotation])
 	inst.RestartID = crAnnotations[restartIDAnnotation]
 	inst.RestartPhase = instancedom.RestartPhase(crAnnotations[restartPhaseAnnotation])
@@ -127,8 +192,16 @@ func InstanceFromCR(obj client.Object) (*instancedom.Instance, error) {
 	return inst, nil
 }
 
-// InstanceToCR converts an *instancedom.Instance to a Kubernetes Instance CR.
-func InstanceToCR(inst *instancedom.Instance) (client.Object, error) {
+// InstanceToCR returns the converter of an *instancedom.Instance to a Kubernetes Instance CR.
+// When s is not nil, spec.userData and spec.sshKeys are sealed into an annotation instead of
+// being stored in plain text.
+func InstanceToCR(s *envelope.Sealer) func(*instancedom.Instance) (client.Object, error) {
+	return func(inst *instancedom.Instance) (client.Object, error) {
+		return instanceToCR(s, inst)
+	}
+}
+
+func instanceToCR(s *envelope.Sealer, inst *instancedom.Instance) (client.Object, error) {
 	if inst == nil {
 		return nil, fmt.Errorf("instance is nil")
 	}
@@ -180,6 +253,11 @@ func InstanceToCR(inst *instancedom.Instance) (client.Object, error) {
 	setAnnotation(desiredPowerStateAnnotation, string(inst.DesiredPowerState))
 	setAnnotation(restartIDAnnotation, inst.RestartID)
 	setAnnotation(restartPhaseAnnotation, string(inst.RestartPhase))
+	sealed, err := sealSpec(s, envelope.Identity(inst.Tenant, inst.Workspace, inst.Name), &spec)
+	if err != nil {
+		return nil, err
+	}
+	setAnnotation(sealedSpecAnnotation, sealed)
 
 	cr := &Instance{
 		ObjectMeta: v1.ObjectMeta{
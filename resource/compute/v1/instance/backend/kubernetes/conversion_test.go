@@ -1,10 +1,13 @@
 package kubernetes_test
 
 import (
+	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/eu-sovereign-cloud/ecp/framework/backend/envelope"
 	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
 	. "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance/backend/kubernetes"
@@ -53,10 +56,10 @@ func TestInstanceConversionRoundTrip(t *testing.T) {
 	}
 	in.Status.PushCondition(commondomain.StatusCondition{State: commondomain.ResourceStateActive})
 
-	cr, err := InstanceToCR(in)
+	cr, err := InstanceToCR(nil)(in)
 	require.NoError(t, err)
 
-	out, err := InstanceFromCR(cr)
+	out, err := InstanceFromCR(nil)(cr)
 	require.NoError(t, err)
 
 	require.Equal(t, in.Name, out.Name)
@@ -91,10 +94,10 @@ func TestInstanceToCR_OptionalFieldsUnset(t *testing.T) {
 	}
 	in.Name = testInstanceName
 
-	cr, err := InstanceToCR(in)
+	cr, err := InstanceToCR(nil)(in)
 	require.NoError(t, err)
 
-	out, err := InstanceFromCR(cr)
+	out, err := InstanceFromCR(nil)(cr)
 	require.NoError(t, err)
 	require.Nil(t, out.Spec.PrimaryNicRef)
 	require.Nil(t, out.Spec.SecurityGroupRef)
@@ -103,7 +106,7 @@ func TestInstanceToCR_OptionalFieldsUnset(t *testing.T) {
 }
 
 func TestInstanceToCR_Nil(t *testing.T) {
-	_, err := InstanceToCR(nil)
+	_, err := InstanceToCR(nil)(nil)
 	require.Error(t, err)
 }
 
@@ -120,10 +123,10 @@ func TestInstancePowerIntentRoundTrip(t *testing.T) {
 	}
 	in.Name = testInstanceName
 
-	cr, err := InstanceToCR(in)
+	cr, err := InstanceToCR(nil)(in)
 	require.NoError(t, err)
 
-	out, err := InstanceFromCR(cr)
+	out, err := InstanceFromCR(nil)(cr)
 	require.NoError(t, err)
 	require.Equal(t, instancedom.PowerStateOn, out.DesiredPowerState)
 	require.Equal(t, "abc123", out.RestartID)
@@ -140,10 +143,10 @@ func TestInstanceToCR_NoPowerIntentByDefault(t *testing.T) {
 	}
 	in.Name = testInstanceName
 
-	cr, err := InstanceToCR(in)
+	cr, err := InstanceToCR(nil)(in)
 	require.NoError(t, err)
 
-	out, err := InstanceFromCR(cr)
+	out, err := InstanceFromCR(nil)(cr)
 	require.NoError(t, err)
 	require.Empty(t, out.DesiredPowerState)
 	require.Empty(t, out.RestartID)
@@ -163,10 +166,63 @@ func TestInstanceToCR_PowerStateDefaultsOff(t *testing.T) {
 	in.Status = &instancedom.InstanceStatus{Status: commondomain.Status{State: commondomain.ResourceStateActive}}
 	in.Status.PushCondition(commondomain.StatusCondition{State: commondomain.ResourceStateActive})
 
-	cr, err := InstanceToCR(in)
+	cr, err := InstanceToCR(nil)(in)
 	require.NoError(t, err)
 
-	out, err := InstanceFromCR(cr)
+	out, err := InstanceFromCR(nil)(cr)
 	require.NoError(t, err)
 	require.Equal(t, instancedom.PowerStateOff, out.Status.PowerState)
 }
+
+func TestInstanceSealedSpecRoundTrip(t *testing.T) {
+	kf, err := envelope.ParseKeyFile([]byte("k1:" + base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))))
+	require.NoError(t, err)
+	sealer := envelope.NewSealer(kf)
+
+	in := &instancedom.Instance{
+		Spec: instancedom.InstanceSpec{
+			BootVolume: instancedom.VolumeReference{DeviceRef: commondomain.Reference{Resource: testBootDevice}},
+			SkuRef:     commondomain.Reference{Resource: testSku},
+			SshKeys:    []string{"ssh-ed25519 AAAA"},
+			UserData:   "#cloud-config\npassword: hunter2",
+			Zone:       testZone,
+		},
+	}
+	in.Name = testInstanceName
+
+	obj, err := InstanceToCR(sealer)(in)
+	require.NoError(t, err)
+	cr := obj.(*Instance)
+	require.Empty(t, cr.Spec.UserData, "the plain-text fields are not stored")
+	require.Empty(t, cr.Spec.SshKeys)
+	sealed := cr.GetAnnotations()["secapi.cloud/sealed-spec"]
+	require.True(t, envelope.IsSealed(sealed))
+	require.NotContains(t, sealed, "hunter2")
+
+	out, err := InstanceFromCR(sealer)(cr)
+	require.NoError(t, err)
+	require.Equal(t, in.Spec.UserData, out.Spec.UserData)
+	require.Equal(t, in.Spec.SshKeys, out.Spec.SshKeys)
+
+	again, err := InstanceToCR(sealer)(out)
+	require.NoError(t, err)
+	require.Equal(t, sealed, again.GetAnnotations()["secapi.cloud/sealed-spec"], "an unchanged spec keeps its sealed form")
+
+	copied := cr.DeepCopy()
+	copied.Name = "inst2"
+	_, err = InstanceFromCR(sealer)(copied)
+	require.ErrorContains(t, err, "failed to open sealed instance spec", "a sealed spec copied onto another instance does not open")
+
+	_, err = InstanceFromCR(nil)(cr)
+	require.ErrorContains(t, err, "no encryption key is configured")
+}
+
+func TestInstanceFromCR_PlainSpecStillReads(t *testing.T) {
+	cr := &Instance{Spec: InstanceSpec{UserData: "#cloud-config", SshKeys: []string{"key-ref-1"}}}
+	cr.Name = testInstanceName
+
+	out, err := InstanceFromCR(nil)(cr)
+	require.NoError(t, err)
+	require.Equal(t, "#cloud-config", out.Spec.UserData, "CRs written before sealing was enabled read as they are")
+	require.Equal(t, []string{"key-ref-1"}, out.Spec.SshKeys)
+}
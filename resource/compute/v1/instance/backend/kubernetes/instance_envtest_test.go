@@ -70,15 +70,15 @@ func TestInstanceBackend_CreateAndGetInstance(t *testing.T) {
 		dynClient,
 		InstanceGVR,
 		slog.Default(),
-		InstanceToCR,
-		InstanceFromCR,
+		InstanceToCR(nil),
+		InstanceFromCR(nil),
 	)
 
 	readerRepo := k8sadapter.NewReaderAdapter[*instancedom.Instance](
 		dynClient,
 		InstanceGVR,
 		slog.Default(),
-		InstanceFromCR,
+		InstanceFromCR(nil),
 	)
 
 	newInstanceDomain := func() *instancedom.Instance {
@@ -6,6 +6,8 @@ import (
 	"log"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
 	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
@@ -14,13 +16,60 @@ import (
 	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 	instancedom "github.com/eu-sovereign-cloud/ecp/resource/compute/v1/instance"
+	nicdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+)
+
+var (
+	// nicGVR is the GroupVersionResource of the NICs an instance is attached to.
+	nicGVR = schema.GroupVersionResource{Group: nicdom.Group, Version: nicdom.Version, Resource: nicdom.Resource}
+	// blockStorageGVR is the GroupVersionResource of the volumes an instance boots from and mounts.
+	blockStorageGVR = schema.GroupVersionResource{Group: bsdom.Group, Version: bsdom.Version, Resource: bsdom.Resource}
 )
 
+// DependencyResolver resolves the NICs and volumes an instance is created with.
+type DependencyResolver interface {
+	State(ctx context.Context, gvr schema.GroupVersionResource, ref commondomain.Reference, defaultTenant string) (bool, commondomain.ResourceState, error)
+}
+
+// instanceDependencies are the resources an instance waits on before it is created: its
+// NICs and its volumes.
+var instanceDependencies = commonbackend.Dependencies[*instancedom.Instance]{
+	{
+		Name:       "nic",
+		GVR:        nicGVR,
+		Workspaced: true,
+		Refs: func(instance *instancedom.Instance) []commondomain.Reference {
+			var refs []commondomain.Reference
+			if instance.Spec.PrimaryNicRef != nil {
+				refs = append(refs, *instance.Spec.PrimaryNicRef)
+			}
+			return append(refs, instance.Spec.AdditionalNicRefs...)
+		},
+	},
+	{
+		Name:       "block storage",
+		GVR:        blockStorageGVR,
+		Workspaced: true,
+		Refs: func(instance *instancedom.Instance) []commondomain.Reference {
+			var refs []commondomain.Reference
+			if instance.Spec.BootVolume.DeviceRef.Resource != "" {
+				refs = append(refs, instance.Spec.BootVolume.DeviceRef)
+			}
+			for _, volume := range instance.Spec.DataVolumes {
+				refs = append(refs, volume.DeviceRef)
+			}
+			return refs
+		},
+	},
+}
+
 // InstancePluginHandler drives the Instance reconciliation state machine.
 type InstancePluginHandler struct {
 	frameworkbackend.GenericPluginHandler[*instancedom.Instance]
 	repo   persistence.Repo[*instancedom.Instance]
 	plugin InstancePlugin
+	deps   DependencyResolver
 }
 
 var _ backendport.PluginHandler[*instancedom.Instance] = (*InstancePluginHandler)(nil)
@@ -30,10 +79,12 @@ func NewInstancePluginHandler(
 	repo persistence.Repo[*instancedom.Instance],
 	plugin InstancePlugin,
 	maxConditions int,
+	deps DependencyResolver,
 ) *InstancePluginHandler {
 	handler := &InstancePluginHandler{
 		repo:   repo,
 		plugin: plugin,
+		deps:   deps,
 	}
 	handler.MaxConditions = maxConditions
 
@@ -51,7 +102,7 @@ func (h *InstancePluginHandler) HandleReconcile(ctx context.Context, resource *i
 		// No power transition is pending, so the instance has no lifecycle edge left to fire and
 		// takes the update path instead of the create/delete state machine below. Power ordering
 		// matters: a start or stop is an explicit request and outranks reconciling the spec.
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*instancedom.Instance]
@@ -62,11 +113,11 @@ func (h *InstancePluginHandler) HandleReconcile(ctx context.Context, resource *i
 	case isInstancePending(resource):
 		delegate = frameworkbackend.BypassDelegated[*instancedom.Instance]
 	case isInstanceCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 	case wantInstanceDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*instancedom.Instance]
 	case isInstanceDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 	case wantInstanceRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*instancedom.Instance]
 	default:
@@ -87,7 +138,7 @@ func (h *InstancePluginHandler) HandleReconcile(ctx context.Context, resource *i
 	case isInstanceAccepted(resource):
 		return h.setResourceState(ctx, resource, commondomain.ResourceStatePending, false)
 	case isInstancePending(resource):
-		return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
+		return h.ensureDependenciesReady(ctx, resource)
 	case isInstanceCreating(resource):
 		return h.setResourceState(ctx, resource, commondomain.ResourceStateActive, false)
 	case wantInstanceDelete(resource):
@@ -136,10 +187,10 @@ func (h *InstancePluginHandler) handlePowerReconcile(ctx context.Context, resour
 		requeue, err = h.runRestartPowerOn(ctx, resource)
 		return true, requeue, err
 	case resource.DesiredPowerState == instancedom.PowerStateOn && powerState == instancedom.PowerStateOff:
-		done, opErr := h.runPowerOp(ctx, resource, h.plugin.PowerOn, instancedom.PowerStateOn)
+		done, opErr := h.runPowerOp(ctx, resource, h.Delegate(backendport.OperationPowerOn, h.plugin.PowerOn), instancedom.PowerStateOn)
 		return true, !done, opErr
 	case resource.DesiredPowerState == instancedom.PowerStateOff && powerState == instancedom.PowerStateOn:
-		done, opErr := h.runPowerOp(ctx, resource, h.plugin.PowerOff, instancedom.PowerStateOff)
+		done, opErr := h.runPowerOp(ctx, resource, h.Delegate(backendport.OperationPowerOff, h.plugin.PowerOff), instancedom.PowerStateOff)
 		return true, !done, opErr
 	default:
 		return false, false, nil
@@ -172,7 +223,7 @@ func validatePowerIntent(resource *instancedom.Instance) string {
 // PowerState=off, then advance the phase to power-on. The caller always requeues to run the
 // next phase.
 func (h *InstancePluginHandler) runRestartPowerOff(ctx context.Context, resource *instancedom.Instance) error {
-	done, err := h.runPowerOp(ctx, resource, h.plugin.PowerOff, instancedom.PowerStateOff)
+	done, err := h.runPowerOp(ctx, resource, h.Delegate(backendport.OperationPowerOff, h.plugin.PowerOff), instancedom.PowerStateOff)
 	if !done {
 		return err
 	}
@@ -187,7 +238,7 @@ func (h *InstancePluginHandler) runRestartPowerOff(ctx context.Context, resource
 // then clear the restart annotations. Cleanup is conditional on the restart id so a superseding
 // restart is not clobbered; it never powers off again in this phase.
 func (h *InstancePluginHandler) runRestartPowerOn(ctx context.Context, resource *instancedom.Instance) (requeue bool, err error) {
-	done, err := h.runPowerOp(ctx, resource, h.plugin.PowerOn, instancedom.PowerStateOn)
+	done, err := h.runPowerOp(ctx, resource, h.Delegate(backendport.OperationPowerOn, h.plugin.PowerOn), instancedom.PowerStateOn)
 	if !done {
 		return true, err
 	}
@@ -289,7 +340,11 @@ func (h *InstancePluginHandler) updateRestartIfCurrent(ctx context.Context, reso
 // original error, so the controller requeues (with backoff) while the failure is surfaced on the
 // resource. If recording itself fails, that error is returned instead.
 func (h *InstancePluginHandler) recordPowerError(ctx context.Context, resource *instancedom.Instance, opErr error) error {
-	if err := h.recordPowerCondition(ctx, resource, "PowerOperationFailed", opErr.Error()); err != nil {
+	reason := "PowerOperationFailed"
+	if errors.Is(opErr, backendport.ErrTimeout) {
+		reason = "OperationTimedOut"
+	}
+	if err := h.recordPowerCondition(ctx, resource, reason, opErr.Error()); err != nil {
 		return err
 	}
 	return opErr
@@ -343,12 +398,29 @@ func (h *InstancePluginHandler) setResourceState(ctx context.Context, resource *
 	return requeue, nil
 }
 
+// ensureDependenciesReady gates the instance's transition to creating on its
+// instanceDependencies being active. While they are not, the instance stays pending without
+// a requeue: the controller watches NICs and block storages and reconciles the instance
+// again as soon as one of them changes state.
+func (h *InstancePluginHandler) ensureDependenciesReady(ctx context.Context, resource *instancedom.Instance) (bool, error) {
+	if resource.Status == nil {
+		resource.Status = &instancedom.InstanceStatus{}
+	}
+
+	ready, requeue, err := commonbackend.AwaitDependencies(ctx, resource, &resource.Status.Status, instanceDependencies, h.deps, h.repo, h.MaxConditions, h.MaxAttempts)
+	if !ready {
+		return requeue, err
+	}
+
+	return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
+}
+
 func (h *InstancePluginHandler) setResourceErrorState(ctx context.Context, resource *instancedom.Instance, err error, requeue bool) (bool, error) {
 	if resource.Status == nil {
 		resource.Status = &instancedom.InstanceStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -395,5 +467,6 @@ func wantInstanceRetryCreate(resource *instancedom.Instance) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
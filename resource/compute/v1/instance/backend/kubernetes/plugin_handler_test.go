@@ -48,7 +48,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOn(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 		require.False(t, requeue)
@@ -71,7 +71,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOff(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 		require.False(t, requeue)
@@ -88,7 +88,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 		require.False(t, requeue)
@@ -126,7 +126,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOff(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 		require.True(t, requeue, "restart cycle should requeue to complete")
@@ -164,7 +164,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOn(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 		require.False(t, requeue)
@@ -191,7 +191,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOn(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 		require.False(t, requeue)
@@ -220,7 +220,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOff(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 		require.True(t, requeue)
@@ -254,7 +254,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		// PowerOn (not PowerOff), proving the phase wins over desired=off.
 		mockPlugin.EXPECT().PowerOn(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 		require.False(t, requeue)
@@ -274,7 +274,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOn(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.ErrorIs(t, err, errStatus)
 		require.True(t, requeue)
@@ -301,7 +301,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOn(gomock.Any(), resource).Return(errProvider).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.ErrorIs(t, err, errProvider)
 		require.True(t, requeue)
@@ -338,7 +338,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOn(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		_, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 	})
@@ -372,7 +372,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 			// No plugin calls for malformed intent.
 			mockPlugin := NewMockInstancePlugin(ctrl)
 
-			handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+			handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 			requeue, err := handler.HandleReconcile(context.Background(), resource)
 			require.NoError(t, err)
 			require.True(t, requeue)
@@ -390,7 +390,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().PowerOn(gomock.Any(), resource).Return(backendport.ErrStillProcessing).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
 		require.True(t, requeue)
@@ -413,7 +413,7 @@ func TestInstancePluginHandler_PowerReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().Create(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		// Creating state → falls through to lifecycle (create), no power op invoked.
 		_, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
@@ -441,7 +441,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 		mockRepo := NewMockRepo[*instancedom.Instance](ctrl)
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -469,7 +469,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 			}).Times(1)
 
 		mockPlugin := NewMockInstancePlugin(ctrl)
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -477,6 +477,49 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 		require.True(t, requeue)
 	})
 
+	t.Run("should stay pending without a requeue while a NIC is not yet active", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+		// Given a pending instance attached to a NIC
+		resource := &instancedom.Instance{
+			Spec: instancedom.InstanceSpec{
+				PrimaryNicRef: &commondomain.Reference{Resource: "nics/nic-1"},
+			},
+			Status: &instancedom.InstanceStatus{
+				Status: commondomain.Status{
+					State: commondomain.ResourceStatePending,
+				},
+			},
+		}
+
+		//
+		// And a deps resolver reporting the NIC is still creating
+		mockDeps := NewMockDependencyResolver(ctrl)
+		mockDeps.EXPECT().State(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, commondomain.ResourceStateCreating, nil).Times(1)
+
+		//
+		// And a repo expected to record a dependency-pending condition naming the NIC
+		mockRepo := NewMockRepo[*instancedom.Instance](ctrl)
+		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, res *instancedom.Instance) (*instancedom.Instance, error) {
+				require.Equal(t, commondomain.ResourceStatePending, res.Status.State)
+				require.Equal(t, "DependencyPending", res.Status.Conditions[0].Type)
+				require.Contains(t, res.Status.Conditions[0].Message, `nic "nics/nic-1" (creating)`)
+				return nil, nil
+			}).Times(1)
+
+		mockPlugin := NewMockInstancePlugin(ctrl)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, mockDeps)
+
+		requeue, err := handler.HandleReconcile(context.Background(), resource)
+
+		require.NoError(t, err)
+		require.False(t, requeue)
+	})
+
 	t.Run("should call plugin create and set state to active when resource is creating", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -499,7 +542,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().Create(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -529,7 +572,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().Delete(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -561,7 +604,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().Create(gomock.Any(), resource).Return(errPlugin).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		handler.MaxConditions = 1
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
@@ -588,7 +631,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 		mockRepo := NewMockRepo[*instancedom.Instance](ctrl)
 		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil, errRepo)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		_, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -625,7 +668,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockInstancePlugin(ctrl)
 		mockPlugin.EXPECT().Delete(gomock.Any(), resource).Return(errPlugin).Times(1)
 
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 		handler.MaxConditions = 1
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
@@ -659,7 +702,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 			}).Times(1)
 
 		mockPlugin := NewMockInstancePlugin(ctrl)
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -681,7 +724,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 
 		mockRepo := NewMockRepo[*instancedom.Instance](ctrl)
 		mockPlugin := NewMockInstancePlugin(ctrl)
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -705,7 +748,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil, errRepo).Times(1)
 
 		mockPlugin := NewMockInstancePlugin(ctrl)
-		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewInstancePluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		_, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -732,7 +775,7 @@ func TestInstancePluginHandler_HandleReconcile(t *testing.T) {
 					return nil
 				})
 
-			handler := NewInstancePluginHandler(NewMockRepo[*instancedom.Instance](ctrl), mockPlugin, 0)
+			handler := NewInstancePluginHandler(NewMockRepo[*instancedom.Instance](ctrl), mockPlugin, 0, nil)
 			handler.HandleReconcile(context.Background(), resource) //nolint:errcheck
 			return
 		}
@@ -0,0 +1,96 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+	internetgatewaydom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway"
+	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
+	nicdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic"
+	publicipdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/public-ip"
+	routetabledom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/route-table"
+	securitygroupdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group"
+	securitygroupruledom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group-rule"
+	subnetdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/subnet"
+)
+
+// RegisterEventRoutes registers the events, GET .../{name}/events, of every network resource
+// kind on mux under baseURL, wrapped in middlewares. The SECA spec has no such route, so the
+// routes are not part of the generated server.
+func (h *Handler) RegisterEventRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	workspace := baseURL + "/v1/tenants/{tenant}/workspaces/{workspace}"
+	network := workspace + "/" + netdom.Resource + "/{network}"
+	for collection, fn := range map[string]http.HandlerFunc{
+		workspace + "/" + netdom.Resource:               h.GetNetworkEvents,
+		workspace + "/" + nicdom.Resource:               h.GetNicEvents,
+		workspace + "/" + publicipdom.Resource:          h.GetPublicIpEvents,
+		workspace + "/" + internetgatewaydom.Resource:   h.GetInternetGatewayEvents,
+		workspace + "/" + securitygroupdom.Resource:     h.GetSecurityGroupEvents,
+		workspace + "/" + securitygroupruledom.Resource: h.GetSecurityGroupRuleEvents,
+		network + "/" + routetabledom.Resource:          h.GetRouteTableEvents,
+		network + "/" + subnetdom.Resource:              h.GetSubnetEvents,
+	} {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle("GET "+collection+"/{name}/"+commonfrontend.EventsAction, handler)
+	}
+}
+
+// GetNetworkEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/networks/{name}/events.
+func (h *Handler) GetNetworkEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "network")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.NetworkReader, newNetworkWithIdentity, h.Events, netdom.Kind)
+}
+
+// GetNicEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/nics/{name}/events.
+func (h *Handler) GetNicEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "nic")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.NicReader, newNicWithIdentity, h.Events, nicdom.Kind)
+}
+
+// GetPublicIpEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/public-ips/{name}/events.
+func (h *Handler) GetPublicIpEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "public-ip")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.PublicIpReader, newPublicIpWithIdentity, h.Events, publicipdom.Kind)
+}
+
+// GetInternetGatewayEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/internet-gateways/{name}/events.
+func (h *Handler) GetInternetGatewayEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "internet-gateway")
+	ir := &InternetGatewayIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace")}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.InternetGatewayReader, newInternetGatewayWithIdentity, h.Events, internetgatewaydom.Kind)
+}
+
+// GetSecurityGroupEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/security-groups/{name}/events.
+func (h *Handler) GetSecurityGroupEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "security-group")
+	ir := &SecurityGroupIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace")}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.SecurityGroupReader, newSecurityGroupWithIdentity, h.Events, securitygroupdom.Kind)
+}
+
+// GetSecurityGroupRuleEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/security-group-rules/{name}/events.
+func (h *Handler) GetSecurityGroupRuleEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "security-group-rule")
+	ir := &SecurityGroupRuleIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace")}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.SecurityGroupRuleReader, newSecurityGroupRuleWithIdentity, h.Events, securitygroupruledom.Kind)
+}
+
+// GetRouteTableEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/networks/{network}/route-tables/{name}/events.
+func (h *Handler) GetRouteTableEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "route-table")
+	ir := &RouteTableIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace"), network: r.PathValue("network")}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.RouteTableReader, newRouteTableWithIdentity, h.Events, routetabledom.Kind)
+}
+
+// GetSubnetEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/networks/{network}/subnets/{name}/events.
+func (h *Handler) GetSubnetEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "subnet")
+	ir := &SubnetIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace"), network: r.PathValue("network")}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.SubnetReader, newSubnetWithIdentity, h.Events, subnetdom.Kind)
+}
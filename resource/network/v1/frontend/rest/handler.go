@@ -6,6 +6,9 @@ import (
 
 	sdknetwork "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.network.v1"
 
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/integrity"
 	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
 	internetgatewaydom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway"
 	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
@@ -45,7 +48,13 @@ type Handler struct {
 	SecurityGroupWriter     persistencepkg.WriterRepo[*securitygroupdom.SecurityGroup]
 	SecurityGroupRuleReader persistencepkg.ReaderRepo[*securitygroupruledom.SecurityGroupRule]
 	SecurityGroupRuleWriter persistencepkg.WriterRepo[*securitygroupruledom.SecurityGroupRule]
-	Logger                  *slog.Logger
+	// Admission reviews created and updated resources; nil admits every write.
+	Admission admission.Reviewer
+	// Integrity refuses the deletion of resources others still reference; nil checks nothing.
+	Integrity integrity.Checker
+	// Events reads the events served by RegisterEventRoutes.
+	Events backendport.EventReader
+	Logger *slog.Logger
 }
 
 var _ sdknetwork.ServerInterface = (*Handler)(nil)
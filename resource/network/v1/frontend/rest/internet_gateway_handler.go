@@ -26,7 +26,7 @@ func (h *Handler) DeleteInternetGateway(w http.ResponseWriter, r *http.Request,
 	if params.IfUnmodifiedSince != nil {
 		id.resourceVersion = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.InternetGatewayWriter, newInternetGatewayWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, internetgatewaydom.Resource, frest.DeleterFromRepo(h.InternetGatewayWriter, newInternetGatewayWithIdentity)))
 }
 
 // GetInternetGateway handles GET /v1/tenants/{tenant}/workspaces/{workspace}/internet-gateways/{name}.
@@ -52,5 +52,7 @@ func (h *Handler) CreateOrUpdateInternetGateway(w http.ResponseWriter, r *http.R
 			return internetGatewayFromAPI(sdk, p.(*InternetGatewayIdentity), region)
 		},
 		DomainToAPI: internetGatewayToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    internetgatewaydom.Resource,
 	})
 }
@@ -27,7 +27,7 @@ func (h *Handler) DeleteNetwork(w http.ResponseWriter, r *http.Request, tenant s
 	if params.IfUnmodifiedSince != nil {
 		id.Version = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.NetworkWriter, newNetworkWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, netdom.Resource, frest.DeleterFromRepo(h.NetworkWriter, newNetworkWithIdentity)))
 }
 
 // GetNetwork handles GET /v1/tenants/{tenant}/workspaces/{workspace}/networks/{name}.
@@ -53,6 +53,8 @@ func (h *Handler) CreateOrUpdateNetwork(w http.ResponseWriter, r *http.Request,
 			return networkFromAPI(sdk, p.(*resource.Identity), region)
 		},
 		DomainToAPI: networkToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    netdom.Resource,
 	})
 }
 
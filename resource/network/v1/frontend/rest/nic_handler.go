@@ -27,7 +27,7 @@ func (h *Handler) DeleteNic(w http.ResponseWriter, r *http.Request, tenant sdksc
 	if params.IfUnmodifiedSince != nil {
 		id.Version = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.NicWriter, newNicWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, nicdom.Resource, frest.DeleterFromRepo(h.NicWriter, newNicWithIdentity)))
 }
 
 // GetNic handles GET /v1/tenants/{tenant}/workspaces/{workspace}/nics/{name}.
@@ -53,5 +53,7 @@ func (h *Handler) CreateOrUpdateNic(w http.ResponseWriter, r *http.Request, tena
 			return nicFromAPI(sdk, p.(*resource.Identity), region)
 		},
 		DomainToAPI: nicToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    nicdom.Resource,
 	})
 }
@@ -27,7 +27,7 @@ func (h *Handler) DeletePublicIp(w http.ResponseWriter, r *http.Request, tenant
 	if params.IfUnmodifiedSince != nil {
 		id.Version = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.PublicIpWriter, newPublicIpWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, publicipdom.Resource, frest.DeleterFromRepo(h.PublicIpWriter, newPublicIpWithIdentity)))
 }
 
 // GetPublicIp handles GET /v1/tenants/{tenant}/workspaces/{workspace}/public-ips/{name}.
@@ -53,5 +53,7 @@ func (h *Handler) CreateOrUpdatePublicIp(w http.ResponseWriter, r *http.Request,
 			return publicIpFromAPI(sdk, p.(*resource.Identity), region)
 		},
 		DomainToAPI: publicIpToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    publicipdom.Resource,
 	})
 }
@@ -0,0 +1,145 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+	internetgatewaydom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway"
+	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
+	nicdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic"
+	publicipdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/public-ip"
+	routetabledom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/route-table"
+	securitygroupdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group"
+	securitygroupruledom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/security-group-rule"
+	subnetdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/subnet"
+)
+
+// RegisterRetryRoutes registers the retry action, POST .../{name}/retry, of every network
+// resource kind on mux under baseURL, wrapped in middlewares. The SECA spec has no such action,
+// so the routes are not part of the generated server.
+func (h *Handler) RegisterRetryRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	workspace := baseURL + "/v1/tenants/{tenant}/workspaces/{workspace}"
+	network := workspace + "/" + netdom.Resource + "/{network}"
+	for collection, fn := range map[string]http.HandlerFunc{
+		workspace + "/" + netdom.Resource:               h.RetryNetwork,
+		workspace + "/" + nicdom.Resource:               h.RetryNic,
+		workspace + "/" + publicipdom.Resource:          h.RetryPublicIp,
+		workspace + "/" + internetgatewaydom.Resource:   h.RetryInternetGateway,
+		workspace + "/" + securitygroupdom.Resource:     h.RetrySecurityGroup,
+		workspace + "/" + securitygroupruledom.Resource: h.RetrySecurityGroupRule,
+		network + "/" + routetabledom.Resource:          h.RetryRouteTable,
+		network + "/" + subnetdom.Resource:              h.RetrySubnet,
+	} {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle("POST "+collection+"/{name}/"+commonfrontend.RetryAction, handler)
+	}
+}
+
+// RetryNetwork handles POST /v1/tenants/{tenant}/workspaces/{workspace}/networks/{name}/retry.
+func (h *Handler) RetryNetwork(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "network")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.NetworkReader, h.NetworkWriter, newNetworkWithIdentity,
+		func(n *netdom.Network) *commondomain.Status {
+			if n.Status == nil {
+				return nil
+			}
+			return &n.Status.Status
+		})
+}
+
+// RetryNic handles POST /v1/tenants/{tenant}/workspaces/{workspace}/nics/{name}/retry.
+func (h *Handler) RetryNic(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "nic")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.NicReader, h.NicWriter, newNicWithIdentity,
+		func(n *nicdom.Nic) *commondomain.Status {
+			if n.Status == nil {
+				return nil
+			}
+			return &n.Status.Status
+		})
+}
+
+// RetryPublicIp handles POST /v1/tenants/{tenant}/workspaces/{workspace}/public-ips/{name}/retry.
+func (h *Handler) RetryPublicIp(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "public-ip")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.PublicIpReader, h.PublicIpWriter, newPublicIpWithIdentity,
+		func(p *publicipdom.PublicIp) *commondomain.Status {
+			if p.Status == nil {
+				return nil
+			}
+			return &p.Status.Status
+		})
+}
+
+// RetryInternetGateway handles POST /v1/tenants/{tenant}/workspaces/{workspace}/internet-gateways/{name}/retry.
+func (h *Handler) RetryInternetGateway(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "internet-gateway")
+	ir := &InternetGatewayIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace")}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.InternetGatewayReader, h.InternetGatewayWriter, newInternetGatewayWithIdentity,
+		func(ig *internetgatewaydom.InternetGateway) *commondomain.Status {
+			if ig.Status == nil {
+				return nil
+			}
+			return &ig.Status.Status
+		})
+}
+
+// RetrySecurityGroup handles POST /v1/tenants/{tenant}/workspaces/{workspace}/security-groups/{name}/retry.
+func (h *Handler) RetrySecurityGroup(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "security-group")
+	ir := &SecurityGroupIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace")}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.SecurityGroupReader, h.SecurityGroupWriter, newSecurityGroupWithIdentity,
+		func(sg *securitygroupdom.SecurityGroup) *commondomain.Status {
+			if sg.Status == nil {
+				return nil
+			}
+			return &sg.Status.Status
+		})
+}
+
+// RetrySecurityGroupRule handles POST /v1/tenants/{tenant}/workspaces/{workspace}/security-group-rules/{name}/retry.
+func (h *Handler) RetrySecurityGroupRule(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "security-group-rule")
+	ir := &SecurityGroupRuleIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace")}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.SecurityGroupRuleReader, h.SecurityGroupRuleWriter, newSecurityGroupRuleWithIdentity,
+		func(rule *securitygroupruledom.SecurityGroupRule) *commondomain.Status {
+			if rule.Status == nil {
+				return nil
+			}
+			return &rule.Status.Status
+		})
+}
+
+// RetryRouteTable handles POST /v1/tenants/{tenant}/workspaces/{workspace}/networks/{network}/route-tables/{name}/retry.
+func (h *Handler) RetryRouteTable(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "route-table")
+	ir := &RouteTableIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace"), network: r.PathValue("network")}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.RouteTableReader, h.RouteTableWriter, newRouteTableWithIdentity,
+		func(rt *routetabledom.RouteTable) *commondomain.Status {
+			if rt.Status == nil {
+				return nil
+			}
+			return &rt.Status.Status
+		})
+}
+
+// RetrySubnet handles POST /v1/tenants/{tenant}/workspaces/{workspace}/networks/{network}/subnets/{name}/retry.
+func (h *Handler) RetrySubnet(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "network", "resource", "subnet")
+	ir := &SubnetIdentity{name: r.PathValue("name"), tenant: r.PathValue("tenant"), workspace: r.PathValue("workspace"), network: r.PathValue("network")}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.SubnetReader, h.SubnetWriter, newSubnetWithIdentity,
+		func(s *subnetdom.Subnet) *commondomain.Status {
+			if s.Status == nil {
+				return nil
+			}
+			return &s.Status.Status
+		})
+}
@@ -26,7 +26,7 @@ func (h *Handler) DeleteRouteTable(w http.ResponseWriter, r *http.Request, tenan
 	if params.IfUnmodifiedSince != nil {
 		id.resourceVersion = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.RouteTableWriter, newRouteTableWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, routetabledom.Resource, frest.DeleterFromRepo(h.RouteTableWriter, newRouteTableWithIdentity)))
 }
 
 // GetRouteTable handles GET /v1/tenants/{tenant}/workspaces/{workspace}/networks/{network}/route-tables/{name}.
@@ -52,5 +52,7 @@ func (h *Handler) CreateOrUpdateRouteTable(w http.ResponseWriter, r *http.Reques
 			return routeTableFromAPI(sdk, p.(*RouteTableIdentity), region)
 		},
 		DomainToAPI: routeTableToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    routetabledom.Resource,
 	})
 }
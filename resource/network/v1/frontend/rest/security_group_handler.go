@@ -26,7 +26,7 @@ func (h *Handler) DeleteSecurityGroup(w http.ResponseWriter, r *http.Request, te
 	if params.IfUnmodifiedSince != nil {
 		id.resourceVersion = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.SecurityGroupWriter, newSecurityGroupWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, securitygroupdom.Resource, frest.DeleterFromRepo(h.SecurityGroupWriter, newSecurityGroupWithIdentity)))
 }
 
 // GetSecurityGroup handles GET /v1/tenants/{tenant}/workspaces/{workspace}/security-groups/{name}.
@@ -52,5 +52,7 @@ func (h *Handler) CreateOrUpdateSecurityGroup(w http.ResponseWriter, r *http.Req
 			return securityGroupFromAPI(sdk, p.(*SecurityGroupIdentity), region)
 		},
 		DomainToAPI: securityGroupToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    securitygroupdom.Resource,
 	})
 }
@@ -26,7 +26,7 @@ func (h *Handler) DeleteSecurityGroupRule(w http.ResponseWriter, r *http.Request
 	if params.IfUnmodifiedSince != nil {
 		id.resourceVersion = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.SecurityGroupRuleWriter, newSecurityGroupRuleWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, securitygroupruledom.Resource, frest.DeleterFromRepo(h.SecurityGroupRuleWriter, newSecurityGroupRuleWithIdentity)))
 }
 
 // GetSecurityGroupRule handles GET /v1/tenants/{tenant}/workspaces/{workspace}/security-group-rules/{name}.
@@ -52,5 +52,7 @@ func (h *Handler) CreateOrUpdateSecurityGroupRule(w http.ResponseWriter, r *http
 			return securityGroupRuleFromAPI(sdk, p.(*SecurityGroupRuleIdentity), region)
 		},
 		DomainToAPI: securityGroupRuleToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    securitygroupruledom.Resource,
 	})
 }
@@ -26,7 +26,7 @@ func (h *Handler) DeleteSubnet(w http.ResponseWriter, r *http.Request, tenant sd
 	if params.IfUnmodifiedSince != nil {
 		id.resourceVersion = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.SubnetWriter, newSubnetWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, subnetdom.Resource, frest.DeleterFromRepo(h.SubnetWriter, newSubnetWithIdentity)))
 }
 
 // GetSubnet handles GET /v1/tenants/{tenant}/workspaces/{workspace}/networks/{network}/subnets/{name}.
@@ -52,5 +52,7 @@ func (h *Handler) CreateOrUpdateSubnet(w http.ResponseWriter, r *http.Request, t
 			return subnetFromAPI(sdk, p.(*SubnetIdentity), region)
 		},
 		DomainToAPI: subnetToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    subnetdom.Resource,
 	})
 }
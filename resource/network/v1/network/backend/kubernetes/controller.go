@@ -7,6 +7,7 @@ import (
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
 	builder "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/builder"
 	frameworkcontroller "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/controller"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
 )
 
@@ -32,8 +33,11 @@ func NewController(
 		NetworkToCR,
 		NetworkFromCR,
 	)
-	handler := NewNetworkPluginHandler(repo, plugin, options.MaxConditions)
-	return &Controller{
+	deps := commonbackend.NewReferenceResolver(dynClient)
+	handler := NewNetworkPluginHandler(repo, plugin, options.MaxConditions, deps)
+	handler.SetTimeouts(options.Plugin, options.Timeouts)
+	handler.MaxAttempts = options.MaxAttempts
+	c := &Controller{
 		GenericController: frameworkcontroller.NewGenericController[*netdom.Network](
 			ctrlClient,
 			NetworkFromCR,
@@ -44,4 +48,11 @@ func NewController(
 			options.MaxConditions,
 		),
 	}
+	c.RecordBackend(options.Plugin, plugin)
+	c.ConfigureQueue(options.Queue)
+	c.CheckDrift(options.DriftCheckInterval)
+	c.Shard(options.Sharder)
+	c.WatchReferences(commonbackend.NetworkWaitsOn...)
+	c.BlockReferencedDeletion(options.ReferenceGraph)
+	return c
 }
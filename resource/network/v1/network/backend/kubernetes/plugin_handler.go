@@ -5,6 +5,8 @@ import (
 	"errors"
 	"log"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
 	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
@@ -12,14 +14,54 @@ import (
 	frameworkbackend "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
 	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	igwdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/internet-gateway"
 	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
+	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
+)
+
+var (
+	// workspaceGVR is the GroupVersionResource of the workspace a network is created in.
+	workspaceGVR = schema.GroupVersionResource{Group: wsdom.Group, Version: wsdom.Version, Resource: wsdom.Resource}
+	// internetGatewayGVR is the GroupVersionResource of the internet gateways a network may
+	// egress through.
+	internetGatewayGVR = schema.GroupVersionResource{Group: igwdom.Group, Version: igwdom.Version, Resource: igwdom.Resource}
 )
 
+// DependencyResolver resolves the workspace a network is created in and the internet
+// gateways of that workspace.
+type DependencyResolver interface {
+	State(ctx context.Context, gvr schema.GroupVersionResource, ref commondomain.Reference, defaultTenant string) (bool, commondomain.ResourceState, error)
+	AnyActive(ctx context.Context, gvr schema.GroupVersionResource, tenant, workspace string) (bool, error)
+}
+
+// networkDependencies are the resources a network waits on before it is created: its
+// workspace, and an internet gateway in that workspace, which some providers need to give the
+// network internet egress from the start.
+var networkDependencies = commonbackend.Dependencies[*netdom.Network]{
+	{
+		Name: "workspace",
+		GVR:  workspaceGVR,
+		// A workspace is tenant-scoped: its bare name resolves in the tenant's namespace.
+		Refs: func(network *netdom.Network) []commondomain.Reference {
+			if network.GetWorkspace() == "" {
+				return nil
+			}
+			return []commondomain.Reference{{Resource: network.GetWorkspace()}}
+		},
+	},
+	{
+		Name:           "internet gateway",
+		GVR:            internetGatewayGVR,
+		AnyInWorkspace: true,
+	},
+}
+
 // NetworkPluginHandler drives the network reconciliation state machine.
 type NetworkPluginHandler struct {
 	frameworkbackend.GenericPluginHandler[*netdom.Network]
 	repo   persistence.Repo[*netdom.Network]
 	plugin NetworkPlugin
+	deps   DependencyResolver
 }
 
 var _ backendport.PluginHandler[*netdom.Network] = (*NetworkPluginHandler)(nil)
@@ -29,10 +71,12 @@ func NewNetworkPluginHandler(
 	repo persistence.Repo[*netdom.Network],
 	plugin NetworkPlugin,
 	maxConditions int,
+	deps DependencyResolver,
 ) *NetworkPluginHandler {
 	handler := &NetworkPluginHandler{
 		repo:   repo,
 		plugin: plugin,
+		deps:   deps,
 	}
 	handler.MaxConditions = maxConditions
 
@@ -43,7 +87,7 @@ func (h *NetworkPluginHandler) HandleReconcile(ctx context.Context, resource *ne
 	// An active resource has no lifecycle transition left to make, so it takes the update path
 	// instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isNetworkActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*netdom.Network]
@@ -57,13 +101,13 @@ func (h *NetworkPluginHandler) HandleReconcile(ctx context.Context, resource *ne
 		delegate = frameworkbackend.BypassDelegated[*netdom.Network]
 
 	case isNetworkCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 
 	case wantNetworkDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*netdom.Network]
 
 	case isNetworkDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 
 	case wantNetworkRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*netdom.Network]
@@ -90,7 +134,7 @@ func (h *NetworkPluginHandler) HandleReconcile(ctx context.Context, resource *ne
 		return h.setResourceState(ctx, resource, commondomain.ResourceStatePending, false)
 
 	case isNetworkPending(resource):
-		return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
+		return h.ensureDependenciesReady(ctx, resource)
 
 	case isNetworkCreating(resource):
 		return h.setResourceState(ctx, resource, commondomain.ResourceStateActive, false)
@@ -131,12 +175,29 @@ func (h *NetworkPluginHandler) setResourceState(ctx context.Context, resource *n
 	return requeue, nil
 }
 
+// ensureDependenciesReady gates the network's transition to creating on its
+// networkDependencies being active. While they are not, the network stays pending without a
+// requeue: the controller watches its workspace and the internet gateways of the workspace,
+// and reconciles the network again as soon as one of them changes state.
+func (h *NetworkPluginHandler) ensureDependenciesReady(ctx context.Context, resource *netdom.Network) (bool, error) {
+	if resource.Status == nil {
+		resource.Status = &netdom.NetworkStatus{}
+	}
+
+	ready, requeue, err := commonbackend.AwaitDependencies(ctx, resource, &resource.Status.Status, networkDependencies, h.deps, h.repo, h.MaxConditions, h.MaxAttempts)
+	if !ready {
+		return requeue, err
+	}
+
+	return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
+}
+
 func (h *NetworkPluginHandler) setResourceErrorState(ctx context.Context, resource *netdom.Network, err error, requeue bool) (bool, error) {
 	if resource.Status == nil {
 		resource.Status = &netdom.NetworkStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -190,5 +251,6 @@ func wantNetworkRetryCreate(resource *netdom.Network) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 	netdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/network"
@@ -47,7 +48,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		mockRepo := NewMockRepo[*netdom.Network](ctrl)
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), activeNetwork())
 
@@ -62,7 +63,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		mockRepo := NewMockRepo[*netdom.Network](ctrl)
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Update(gomock.Any(), gomock.Any()).Return(backendport.ErrStillProcessing).Times(1)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), activeNetwork())
 
@@ -90,7 +91,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Update(gomock.Any(), gomock.Any()).
 			Return(fmt.Errorf("%w: region is immutable", backendport.ErrNotSupported)).Times(1)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -112,7 +113,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Update(gomock.Any(), gomock.Any()).Return(failure).Times(2)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		_, err := handler.HandleReconcile(context.Background(), resource)
 		require.NoError(t, err)
@@ -132,7 +133,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errPlugin).Times(1)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), activeNetwork())
 
@@ -158,7 +159,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -183,7 +184,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 			}).Times(1)
 
 		mockPlugin := NewMockNetworkPlugin(ctrl)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -191,17 +192,34 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		require.True(t, requeue)
 	})
 
-	t.Run("should set state to creating and requeue when resource is pending", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		resource := &netdom.Network{
+	pendingNetwork := func() *netdom.Network {
+		return &netdom.Network{
+			RegionalMetadata: commondomain.RegionalMetadata{
+				Scope: kernelresource.Scope{Tenant: "t1", Workspace: "ws-1"},
+			},
 			Status: &netdom.NetworkStatus{
 				Status: commondomain.Status{
 					State: commondomain.ResourceStatePending,
 				},
 			},
 		}
+	}
+
+	// activeDeps resolves the workspace of a pending network, and an internet gateway in it,
+	// as active.
+	activeDeps := func(ctrl *gomock.Controller) *MockDependencyResolver {
+		mockDeps := NewMockDependencyResolver(ctrl)
+		mockDeps.EXPECT().State(gomock.Any(), gomock.Any(), commondomain.Reference{Resource: "ws-1"}, "t1").
+			Return(true, commondomain.ResourceStateActive, nil).Times(1)
+		mockDeps.EXPECT().AnyActive(gomock.Any(), gomock.Any(), "t1", "ws-1").Return(true, nil).Times(1)
+		return mockDeps
+	}
+
+	t.Run("should set state to creating and requeue when resource is pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resource := pendingNetwork()
 
 		mockRepo := NewMockRepo[*netdom.Network](ctrl)
 		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(
@@ -211,7 +229,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 			}).Times(1)
 
 		mockPlugin := NewMockNetworkPlugin(ctrl)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, activeDeps(ctrl))
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -219,6 +237,35 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		require.True(t, requeue)
 	})
 
+	t.Run("should stay pending without requeue while the workspace has no active internet gateway", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resource := pendingNetwork()
+
+		mockDeps := NewMockDependencyResolver(ctrl)
+		mockDeps.EXPECT().State(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(true, commondomain.ResourceStateActive, nil).Times(1)
+		mockDeps.EXPECT().AnyActive(gomock.Any(), gomock.Any(), "t1", "ws-1").Return(false, nil).Times(1)
+
+		mockRepo := NewMockRepo[*netdom.Network](ctrl)
+		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, res *netdom.Network) (*netdom.Network, error) {
+				require.Equal(t, commondomain.ResourceStatePending, res.Status.State)
+				require.Equal(t, "DependencyPending", res.Status.Conditions[0].Type)
+				require.Contains(t, res.Status.Conditions[0].Message, `internet gateway in workspace "ws-1" (none active)`)
+				return nil, nil
+			}).Times(1)
+
+		mockPlugin := NewMockNetworkPlugin(ctrl)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, mockDeps)
+
+		requeue, err := handler.HandleReconcile(context.Background(), resource)
+
+		require.NoError(t, err)
+		require.False(t, requeue)
+	})
+
 	t.Run("should call plugin create and set state to active when resource is creating", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -241,7 +288,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Create(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -271,7 +318,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Delete(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -303,7 +350,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Create(gomock.Any(), resource).Return(errPlugin).Times(1)
 
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 		handler.MaxConditions = 1
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
@@ -330,7 +377,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		mockRepo := NewMockRepo[*netdom.Network](ctrl)
 		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil, errRepo)
 
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		_, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -367,7 +414,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockNetworkPlugin(ctrl)
 		mockPlugin.EXPECT().Delete(gomock.Any(), resource).Return(errPlugin).Times(1)
 
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 		handler.MaxConditions = 1
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
@@ -401,7 +448,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 			}).Times(1)
 
 		mockPlugin := NewMockNetworkPlugin(ctrl)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -423,7 +470,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 
 		mockRepo := NewMockRepo[*netdom.Network](ctrl)
 		mockPlugin := NewMockNetworkPlugin(ctrl)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -435,19 +482,13 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		resource := &netdom.Network{
-			Status: &netdom.NetworkStatus{
-				Status: commondomain.Status{
-					State: commondomain.ResourceStatePending,
-				},
-			},
-		}
+		resource := pendingNetwork()
 
 		mockRepo := NewMockRepo[*netdom.Network](ctrl)
 		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil, errRepo).Times(1)
 
 		mockPlugin := NewMockNetworkPlugin(ctrl)
-		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNetworkPluginHandler(mockRepo, mockPlugin, 0, activeDeps(ctrl))
 
 		_, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -474,7 +515,7 @@ func TestNetworkPluginHandler_HandleReconcile(t *testing.T) {
 					return nil
 				})
 
-			handler := NewNetworkPluginHandler(NewMockRepo[*netdom.Network](ctrl), mockPlugin, 0)
+			handler := NewNetworkPluginHandler(NewMockRepo[*netdom.Network](ctrl), mockPlugin, 0, nil)
 			handler.HandleReconcile(context.Background(), resource) //nolint:errcheck
 			return
 		}
@@ -7,6 +7,7 @@ import (
 	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
 	"github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/builder"
 	frameworkcontroller "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/controller"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	nicdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic"
 )
 
@@ -30,8 +31,11 @@ func NewController(
 		NicToCR,
 		NicFromCR,
 	)
-	handler := NewNicPluginHandler(repo, plugin, options.MaxConditions)
-	return &Controller{
+	deps := commonbackend.NewReferenceResolver(dynClient)
+	handler := NewNicPluginHandler(repo, plugin, options.MaxConditions, deps)
+	handler.SetTimeouts(options.Plugin, options.Timeouts)
+	handler.MaxAttempts = options.MaxAttempts
+	c := &Controller{
 		GenericController: frameworkcontroller.NewGenericController[*nicdom.Nic](
 			ctrlClient,
 			NicFromCR,
@@ -42,4 +46,11 @@ func NewController(
 			options.MaxConditions,
 		),
 	}
+	c.RecordBackend(options.Plugin, plugin)
+	c.ConfigureQueue(options.Queue)
+	c.CheckDrift(options.DriftCheckInterval)
+	c.Shard(options.Sharder)
+	c.WatchReferences(commonbackend.NICReferrer.References...)
+	c.BlockReferencedDeletion(options.ReferenceGraph)
+	return c
 }
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"log"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
 	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
@@ -13,13 +15,38 @@ import (
 	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 	nicdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/nic"
+	subnetdom "github.com/eu-sovereign-cloud/ecp/resource/network/v1/subnet"
 )
 
+// subnetGVR is the GroupVersionResource of the subnet a NIC is attached to.
+var subnetGVR = schema.GroupVersionResource{Group: subnetdom.Group, Version: subnetdom.Version, Resource: subnetdom.Resource}
+
+// DependencyResolver resolves the subnet a NIC is created in.
+type DependencyResolver interface {
+	State(ctx context.Context, gvr schema.GroupVersionResource, ref commondomain.Reference, defaultTenant string) (bool, commondomain.ResourceState, error)
+}
+
+// nicDependencies are the resources a NIC waits on before it is created: its subnet.
+var nicDependencies = commonbackend.Dependencies[*nicdom.Nic]{
+	{
+		Name:       "subnet",
+		GVR:        subnetGVR,
+		Workspaced: true,
+		Refs: func(nic *nicdom.Nic) []commondomain.Reference {
+			if nic.Spec.SubnetRef.Resource == "" {
+				return nil
+			}
+			return []commondomain.Reference{nic.Spec.SubnetRef}
+		},
+	},
+}
+
 // NicPluginHandler drives the NIC reconciliation state machine.
 type NicPluginHandler struct {
 	frameworkbackend.GenericPluginHandler[*nicdom.Nic]
 	repo   persistence.Repo[*nicdom.Nic]
 	plugin NicPlugin
+	deps   DependencyResolver
 }
 
 var _ backendport.PluginHandler[*nicdom.Nic] = (*NicPluginHandler)(nil)
@@ -29,10 +56,12 @@ func NewNicPluginHandler(
 	repo persistence.Repo[*nicdom.Nic],
 	plugin NicPlugin,
 	maxConditions int,
+	deps DependencyResolver,
 ) *NicPluginHandler {
 	handler := &NicPluginHandler{
 		repo:   repo,
 		plugin: plugin,
+		deps:   deps,
 	}
 	handler.MaxConditions = maxConditions
 
@@ -43,7 +72,7 @@ func (h *NicPluginHandler) HandleReconcile(ctx context.Context, resource *nicdom
 	// An active resource has no lifecycle transition left to make, so it takes the update
 	// path instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isNicActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*nicdom.Nic]
@@ -54,11 +83,11 @@ func (h *NicPluginHandler) HandleReconcile(ctx context.Context, resource *nicdom
 	case isNicPending(resource):
 		delegate = frameworkbackend.BypassDelegated[*nicdom.Nic]
 	case isNicCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 	case wantNicDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*nicdom.Nic]
 	case isNicDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 	case wantNicRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*nicdom.Nic]
 	default:
@@ -79,7 +108,7 @@ func (h *NicPluginHandler) HandleReconcile(ctx context.Context, resource *nicdom
 	case isNicAccepted(resource):
 		return h.setResourceState(ctx, resource, commondomain.ResourceStatePending, false)
 	case isNicPending(resource):
-		return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
+		return h.ensureDependenciesReady(ctx, resource)
 	case isNicCreating(resource):
 		return h.setResourceState(ctx, resource, commondomain.ResourceStateActive, false)
 	case wantNicDelete(resource):
@@ -113,12 +142,29 @@ func (h *NicPluginHandler) setResourceState(ctx context.Context, resource *nicdo
 	return requeue, nil
 }
 
+// ensureDependenciesReady gates the NIC's transition to creating on its nicDependencies
+// being active. While they are not, the NIC stays pending without a requeue: the
+// controller watches subnets and reconciles the NIC again as soon as its subnet changes
+// state.
+func (h *NicPluginHandler) ensureDependenciesReady(ctx context.Context, resource *nicdom.Nic) (bool, error) {
+	if resource.Status == nil {
+		resource.Status = &nicdom.NicStatus{}
+	}
+
+	ready, requeue, err := commonbackend.AwaitDependencies(ctx, resource, &resource.Status.Status, nicDependencies, h.deps, h.repo, h.MaxConditions, h.MaxAttempts)
+	if !ready {
+		return requeue, err
+	}
+
+	return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
+}
+
 func (h *NicPluginHandler) setResourceErrorState(ctx context.Context, resource *nicdom.Nic, err error, requeue bool) (bool, error) {
 	if resource.Status == nil {
 		resource.Status = &nicdom.NicStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -171,5 +217,6 @@ func wantNicRetryCreate(resource *nicdom.Nic) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
@@ -38,7 +38,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 		mockRepo := NewMockRepo[*nicdom.Nic](ctrl)
 		mockPlugin := NewMockNicPlugin(ctrl)
 		mockPlugin.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -66,7 +66,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 			}).Times(1)
 
 		mockPlugin := NewMockNicPlugin(ctrl)
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -74,6 +74,49 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 		require.True(t, requeue)
 	})
 
+	t.Run("should stay pending without a requeue while the subnet is not yet active", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+		// Given a pending NIC in a subnet
+		resource := &nicdom.Nic{
+			Spec: nicdom.NicSpec{
+				SubnetRef: commondomain.Reference{Resource: "networks/net-1/subnets/sn-1"},
+			},
+			Status: &nicdom.NicStatus{
+				Status: commondomain.Status{
+					State: commondomain.ResourceStatePending,
+				},
+			},
+		}
+
+		//
+		// And a deps resolver reporting the subnet does not exist yet
+		mockDeps := NewMockDependencyResolver(ctrl)
+		mockDeps.EXPECT().State(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(false, commondomain.ResourceState(""), nil).Times(1)
+
+		//
+		// And a repo expected to record a dependency-pending condition naming the subnet
+		mockRepo := NewMockRepo[*nicdom.Nic](ctrl)
+		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, res *nicdom.Nic) (*nicdom.Nic, error) {
+				require.Equal(t, commondomain.ResourceStatePending, res.Status.State)
+				require.Equal(t, "DependencyPending", res.Status.Conditions[0].Type)
+				require.Contains(t, res.Status.Conditions[0].Message, `subnet "networks/net-1/subnets/sn-1" (not found)`)
+				return nil, nil
+			}).Times(1)
+
+		mockPlugin := NewMockNicPlugin(ctrl)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, mockDeps)
+
+		requeue, err := handler.HandleReconcile(context.Background(), resource)
+
+		require.NoError(t, err)
+		require.False(t, requeue)
+	})
+
 	t.Run("should call plugin create and set state to active when resource is creating", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -96,7 +139,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockNicPlugin(ctrl)
 		mockPlugin.EXPECT().Create(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -126,7 +169,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockNicPlugin(ctrl)
 		mockPlugin.EXPECT().Delete(gomock.Any(), resource).Return(nil).Times(1)
 
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -158,7 +201,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockNicPlugin(ctrl)
 		mockPlugin.EXPECT().Create(gomock.Any(), resource).Return(errPlugin).Times(1)
 
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 		handler.MaxConditions = 1
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
@@ -185,7 +228,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 		mockRepo := NewMockRepo[*nicdom.Nic](ctrl)
 		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil, errRepo)
 
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		_, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -222,7 +265,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 		mockPlugin := NewMockNicPlugin(ctrl)
 		mockPlugin.EXPECT().Delete(gomock.Any(), resource).Return(errPlugin).Times(1)
 
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 		handler.MaxConditions = 1
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
@@ -256,7 +299,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 			}).Times(1)
 
 		mockPlugin := NewMockNicPlugin(ctrl)
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -278,7 +321,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 
 		mockRepo := NewMockRepo[*nicdom.Nic](ctrl)
 		mockPlugin := NewMockNicPlugin(ctrl)
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -302,7 +345,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).Return(nil, errRepo).Times(1)
 
 		mockPlugin := NewMockNicPlugin(ctrl)
-		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0)
+		handler := NewNicPluginHandler(mockRepo, mockPlugin, 0, nil)
 
 		_, err := handler.HandleReconcile(context.Background(), resource)
 
@@ -329,7 +372,7 @@ func TestNicPluginHandler_HandleReconcile(t *testing.T) {
 					return nil
 				})
 
-			handler := NewNicPluginHandler(NewMockRepo[*nicdom.Nic](ctrl), mockPlugin, 0)
+			handler := NewNicPluginHandler(NewMockRepo[*nicdom.Nic](ctrl), mockPlugin, 0, nil)
 			handler.HandleReconcile(context.Background(), resource) //nolint:errcheck
 			return
 		}
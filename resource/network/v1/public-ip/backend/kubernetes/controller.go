@@ -31,7 +31,9 @@ func NewController(
 		PublicIpFromCR,
 	)
 	handler := NewPublicIpPluginHandler(repo, plugin, options.MaxConditions)
-	return &Controller{
+	handler.SetTimeouts(options.Plugin, options.Timeouts)
+	handler.MaxAttempts = options.MaxAttempts
+	c := &Controller{
 		GenericController: frameworkcontroller.NewGenericController[*publicipdom.PublicIp](
 			ctrlClient,
 			PublicIpFromCR,
@@ -42,4 +44,10 @@ func NewController(
 			options.MaxConditions,
 		),
 	}
+	c.RecordBackend(options.Plugin, plugin)
+	c.ConfigureQueue(options.Queue)
+	c.CheckDrift(options.DriftCheckInterval)
+	c.Shard(options.Sharder)
+	c.BlockReferencedDeletion(options.ReferenceGraph)
+	return c
 }
@@ -43,7 +43,7 @@ func (h *PublicIpPluginHandler) HandleReconcile(ctx context.Context, resource *p
 	// An active resource has no lifecycle transition left to make, so it takes the update
 	// path instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isPublicIpActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*publicipdom.PublicIp]
@@ -54,11 +54,11 @@ func (h *PublicIpPluginHandler) HandleReconcile(ctx context.Context, resource *p
 	case isPublicIpPending(resource):
 		delegate = frameworkbackend.BypassDelegated[*publicipdom.PublicIp]
 	case isPublicIpCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 	case wantPublicIpDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*publicipdom.PublicIp]
 	case isPublicIpDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 	case wantPublicIpRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*publicipdom.PublicIp]
 	default:
@@ -118,7 +118,7 @@ func (h *PublicIpPluginHandler) setResourceErrorState(ctx context.Context, resou
 		resource.Status = &publicipdom.PublicIpStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -171,5 +171,6 @@ func wantPublicIpRetryCreate(resource *publicipdom.PublicIp) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
@@ -43,7 +43,7 @@ func (h *RouteTablePluginHandler) HandleReconcile(ctx context.Context, resource
 	// An active resource has no lifecycle transition left to make, so it takes the update
 	// path instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isRouteTableActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*routetabledom.RouteTable]
@@ -54,11 +54,11 @@ func (h *RouteTablePluginHandler) HandleReconcile(ctx context.Context, resource
 	case isRouteTablePending(resource):
 		delegate = frameworkbackend.BypassDelegated[*routetabledom.RouteTable]
 	case isRouteTableCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 	case wantRouteTableDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*routetabledom.RouteTable]
 	case isRouteTableDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 	case wantRouteTableRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*routetabledom.RouteTable]
 	default:
@@ -118,7 +118,7 @@ func (h *RouteTablePluginHandler) setResourceErrorState(ctx context.Context, res
 		resource.Status = &routetabledom.RouteTableStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -171,5 +171,6 @@ func wantRouteTableRetryCreate(resource *routetabledom.RouteTable) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
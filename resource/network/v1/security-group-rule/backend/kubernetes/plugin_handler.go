@@ -43,7 +43,7 @@ func (h *SecurityGroupRulePluginHandler) HandleReconcile(ctx context.Context, re
 	// An active resource has no lifecycle transition left to make, so it takes the update
 	// path instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isSecurityGroupRuleActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*securitygroupruledom.SecurityGroupRule]
@@ -54,11 +54,11 @@ func (h *SecurityGroupRulePluginHandler) HandleReconcile(ctx context.Context, re
 	case isSecurityGroupRulePending(resource):
 		delegate = frameworkbackend.BypassDelegated[*securitygroupruledom.SecurityGroupRule]
 	case isSecurityGroupRuleCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 	case wantSecurityGroupRuleDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*securitygroupruledom.SecurityGroupRule]
 	case isSecurityGroupRuleDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 	case wantSecurityGroupRuleRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*securitygroupruledom.SecurityGroupRule]
 	default:
@@ -118,7 +118,7 @@ func (h *SecurityGroupRulePluginHandler) setResourceErrorState(ctx context.Conte
 		resource.Status = &securitygroupruledom.SecurityGroupRuleStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -171,5 +171,6 @@ func wantSecurityGroupRuleRetryCreate(resource *securitygroupruledom.SecurityGro
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
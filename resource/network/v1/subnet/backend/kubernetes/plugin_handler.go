@@ -43,7 +43,7 @@ func (h *SubnetPluginHandler) HandleReconcile(ctx context.Context, resource *sub
 	// An active resource has no lifecycle transition left to make, so it takes the update
 	// path instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isSubnetActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*subnetdom.Subnet]
@@ -54,11 +54,11 @@ func (h *SubnetPluginHandler) HandleReconcile(ctx context.Context, resource *sub
 	case isSubnetPending(resource):
 		delegate = frameworkbackend.BypassDelegated[*subnetdom.Subnet]
 	case isSubnetCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 	case wantSubnetDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*subnetdom.Subnet]
 	case isSubnetDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 	case wantSubnetRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*subnetdom.Subnet]
 	default:
@@ -118,7 +118,7 @@ func (h *SubnetPluginHandler) setResourceErrorState(ctx context.Context, resourc
 		resource.Status = &subnetdom.SubnetStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -171,5 +171,6 @@ func wantSubnetRetryCreate(resource *subnetdom.Subnet) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 
@@ -8,6 +10,7 @@ import (
 	sdkschema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
 
 	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
 	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 	rdom "github.com/eu-sovereign-cloud/ecp/resource/region/v1"
@@ -17,6 +20,18 @@ import (
 type Handler struct {
 	Repo   persistencepkg.ReaderRepo[*rdom.Region]
 	Logger *slog.Logger
+
+	// Residency, when set, hides the regions the caller's tenants may not use. A nil
+	// Residency lists every region.
+	Residency RegionFilter
+}
+
+// RegionFilter decides which regions a caller may see. The caller is the authenticated
+// identity of ctx (see middleware.IdentityFromContext), never a tenant the request names:
+// the region routes are not tenant-scoped, and a tenant taken from the request would let
+// any caller read another tenant's residency.
+type RegionFilter interface {
+	RegionVisible(ctx context.Context, region *rdom.Region) (bool, error)
 }
 
 var _ regionv1sdk.ServerInterface = (*Handler)(nil)
@@ -24,14 +39,22 @@ var _ regionv1sdk.ServerInterface = (*Handler)(nil)
 // ListRegions handles GET /v1/regions.
 func (h *Handler) ListRegions(w http.ResponseWriter, r *http.Request, params regionv1sdk.ListRegionsParams) {
 	logger := h.Logger.With("resource", "region")
-	frest.HandleList(w, r, logger, listParamsFromAPI(params), frest.ListerFromRepo(h.Repo), regionIteratorToAPI)
+	var lister frest.Lister[*rdom.Region] = frest.ListerFromRepo(h.Repo)
+	if h.Residency != nil {
+		lister = visibleLister{next: lister, filter: h.Residency}
+	}
+	frest.HandleList(w, r, logger, listParamsFromAPI(params), lister, regionIteratorToAPI)
 }
 
 // GetRegion handles GET /v1/regions/{name}.
 func (h *Handler) GetRegion(w http.ResponseWriter, r *http.Request, name sdkschema.ResourcePathParam) {
 	logger := h.Logger.With("resource", "region", "name", name)
 	ir := &resource.Identity{Name: name}
-	frest.HandleGet(w, r, logger, ir, frest.GetterFromRepo(h.Repo, newRegionWithIdentity), regionToAPIForGet)
+	var getter frest.Getter[*rdom.Region] = frest.GetterFromRepo(h.Repo, newRegionWithIdentity)
+	if h.Residency != nil {
+		getter = visibleGetter{next: getter, filter: h.Residency}
+	}
+	frest.HandleGet(w, r, logger, ir, getter, regionToAPIForGet)
 }
 
 // newRegionWithIdentity returns a *rdom.Region populated with identity fields from ir.
@@ -40,3 +63,49 @@ func newRegionWithIdentity(ir persistencepkg.IdentifiableResource) *rdom.Region
 	d.Name = ir.GetName()
 	return d
 }
+
+// visibleLister drops the regions filter hides from a page. A filtered page may hold fewer
+// items than the limit; the skip token still walks the whole catalog.
+type visibleLister struct {
+	next   frest.Lister[*rdom.Region]
+	filter RegionFilter
+}
+
+func (l visibleLister) Do(ctx context.Context, params resource.ListFilter) ([]*rdom.Region, *string, error) {
+	regions, nextSkipToken, err := l.next.Do(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	visible := regions[:0]
+	for _, region := range regions {
+		ok, err := l.filter.RegionVisible(ctx, region)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			visible = append(visible, region)
+		}
+	}
+	return visible, nextSkipToken, nil
+}
+
+// visibleGetter answers 404 for a region filter hides, as if it did not exist.
+type visibleGetter struct {
+	next   frest.Getter[*rdom.Region]
+	filter RegionFilter
+}
+
+func (g visibleGetter) Do(ctx context.Context, ir persistencepkg.IdentifiableResource) (*rdom.Region, error) {
+	region, err := g.next.Do(ctx, ir)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := g.filter.RegionVisible(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, kernel.NewError(kernel.KindNotFound, fmt.Errorf("region %s not found", region.Name))
+	}
+	return region, nil
+}
@@ -0,0 +1,90 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	regionv1sdk "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.region.v1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/frontend/middleware"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	authnport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/authn"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	rdom "github.com/eu-sovereign-cloud/ecp/resource/region/v1"
+)
+
+// fakeRegionRepo serves a fixed region catalog.
+type fakeRegionRepo struct {
+	regions []*rdom.Region
+}
+
+func (f *fakeRegionRepo) List(_ context.Context, _ resource.ListFilter, out *[]*rdom.Region) (*string, error) {
+	*out = append(*out, f.regions...)
+	return nil, nil
+}
+
+func (f *fakeRegionRepo) Load(_ context.Context, m **rdom.Region) error {
+	for _, r := range f.regions {
+		if r.Name == (*m).Name {
+			*m = r
+			return nil
+		}
+	}
+	return kernel.ErrNotFound
+}
+
+// tenantRegions lets each tenant see only the regions listed for it. The tenant is the one
+// the caller's token is scoped to.
+type tenantRegions map[string][]string
+
+func (t tenantRegions) RegionVisible(ctx context.Context, region *rdom.Region) (bool, error) {
+	id, ok := middleware.IdentityFromContext(ctx)
+	if !ok || len(id.TokenScope.Tenants) == 0 {
+		return true, nil
+	}
+	return slices.Contains(t[id.TokenScope.Tenants[0]], region.Name), nil
+}
+
+// asTenant returns r as sent by a caller whose token is scoped to tenant.
+func asTenant(r *http.Request, tenant string) *http.Request {
+	id := &authnport.Identity{Subject: "alice", TokenScope: resource.TokenScope{Tenants: []string{tenant}}}
+	return r.WithContext(middleware.ContextWithIdentity(r.Context(), id))
+}
+
+func TestHandler_Residency(t *testing.T) {
+	repo := &fakeRegionRepo{}
+	for _, name := range []string{"itbg-bergamo", "region-two"} {
+		r := &rdom.Region{}
+		r.Name = name
+		repo.regions = append(repo.regions, r)
+	}
+	h := &Handler{Repo: repo, Logger: slog.Default(), Residency: tenantRegions{"acme": {"itbg-bergamo"}}}
+
+	list := func(r *http.Request) []string {
+		rec := httptest.NewRecorder()
+		h.ListRegions(rec, r, regionv1sdk.ListRegionsParams{})
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		var iter regionv1sdk.RegionIterator
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &iter))
+		var names []string
+		for _, item := range iter.Items {
+			names = append(names, item.Metadata.Name)
+		}
+		return names
+	}
+	request := func(target string) *http.Request { return httptest.NewRequest(http.MethodGet, target, nil) }
+	require.Equal(t, []string{"itbg-bergamo", "region-two"}, list(request("/v1/regions")))
+	require.Equal(t, []string{"itbg-bergamo"}, list(asTenant(request("/v1/regions"), "acme")))
+	require.Equal(t, []string{"itbg-bergamo"}, list(asTenant(request("/v1/regions?tenant=globex"), "acme")),
+		"a tenant named in the request is not the caller's")
+
+	rec := httptest.NewRecorder()
+	h.GetRegion(rec, asTenant(request("/v1/regions/region-two"), "acme"), "region-two")
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
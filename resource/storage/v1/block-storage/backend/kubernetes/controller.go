@@ -35,7 +35,9 @@ func NewController(
 	)
 	deps := commonbackend.NewReferenceResolver(dynClient)
 	handler := NewBlockStoragePluginHandler(repo, plugin, options.MaxConditions, deps)
-	return &Controller{
+	handler.SetTimeouts(options.Plugin, options.Timeouts)
+	handler.MaxAttempts = options.MaxAttempts
+	c := &Controller{
 		GenericController: frameworkcontroller.NewGenericController[*bsdom.BlockStorage](
 			ctrlClient,
 			BlockStorageFromCR,
@@ -46,4 +48,11 @@ func NewController(
 			options.MaxConditions,
 		),
 	}
+	c.RecordBackend(options.Plugin, plugin)
+	c.ConfigureQueue(options.Queue)
+	c.CheckDrift(options.DriftCheckInterval)
+	c.Shard(options.Sharder)
+	c.WatchReferences(commonbackend.BlockStorageReferrer.References...)
+	c.BlockReferencedDeletion(options.ReferenceGraph)
+	return c
 }
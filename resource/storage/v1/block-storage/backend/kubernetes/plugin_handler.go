@@ -3,7 +3,6 @@ package kubernetes
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -14,21 +13,33 @@ import (
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
 
 	frameworkbackend "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
-	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
 	imgdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/image"
 )
 
-// imageGVR is the GroupVersionResource of images that may be stored on a block storage.
+// imageGVR is the GroupVersionResource of the source image a block storage is created from.
 var imageGVR = schema.GroupVersionResource{Group: imgdom.Group, Version: imgdom.Version, Resource: imgdom.Resource}
 
-// DependencyResolver resolves the optional source image a block storage is created from
-// and finds images that are stored on a block storage (referencing it via BlockStorageRef).
+// DependencyResolver resolves the optional source image a block storage is created from.
 type DependencyResolver interface {
 	State(ctx context.Context, gvr schema.GroupVersionResource, ref commondomain.Reference, defaultTenant string) (bool, commondomain.ResourceState, error)
-	Referrers(ctx context.Context, gvr schema.GroupVersionResource, namespace string, fieldPath []string, target commonbackend.ReferenceTarget, defaultTenant string) ([]string, error)
+}
+
+// blockStorageDependencies are the resources a block storage waits on before it is created:
+// its optional source image.
+var blockStorageDependencies = commonbackend.Dependencies[*bsdom.BlockStorage]{
+	{
+		Name: "source image",
+		GVR:  imageGVR,
+		Refs: func(bs *bsdom.BlockStorage) []commondomain.Reference {
+			if bs.Spec.SourceImageRef == nil {
+				return nil
+			}
+			return []commondomain.Reference{*bs.Spec.SourceImageRef}
+		},
+	},
 }
 
 // BlockStoragePluginHandler drives the block-storage reconciliation state machine.
@@ -66,7 +77,7 @@ func (h *BlockStoragePluginHandler) HandleReconcile(ctx context.Context, resourc
 	// its own transition through "updating" with observed size in status, and routing it here
 	// would bypass that and never advance the state.
 	if isBlockStorageActive(resource) && !wantBlockStorageIncreaseSize(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*bsdom.BlockStorage]
@@ -79,19 +90,19 @@ func (h *BlockStoragePluginHandler) HandleReconcile(ctx context.Context, resourc
 		delegate = frameworkbackend.BypassDelegated[*bsdom.BlockStorage]
 
 	case isBlockStorageCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 
 	case wantBlockStorageDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*bsdom.BlockStorage]
 
 	case isBlockStorageDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 
 	case wantBlockStorageIncreaseSize(resource):
 		delegate = frameworkbackend.BypassDelegated[*bsdom.BlockStorage]
 
 	case isBlockStorageIncreasingSize(resource):
-		delegate = h.plugin.IncreaseSize
+		delegate = h.Delegate(backendport.OperationIncreaseSize, h.plugin.IncreaseSize)
 
 	case wantBlockStorageRetryCreate(resource) || wantBlockStorageRetryIncreaseSize(resource):
 		delegate = frameworkbackend.BypassDelegated[*bsdom.BlockStorage]
@@ -118,7 +129,7 @@ func (h *BlockStoragePluginHandler) HandleReconcile(ctx context.Context, resourc
 		return h.setResourceState(ctx, resource, commondomain.ResourceStatePending, false)
 
 	case isBlockStoragePending(resource):
-		return h.ensureSourceImageReady(ctx, resource)
+		return h.ensureDependenciesReady(ctx, resource)
 
 	case isBlockStorageCreating(resource):
 		resource.Status.SizeGB = resource.Spec.SizeGB
@@ -126,7 +137,8 @@ func (h *BlockStoragePluginHandler) HandleReconcile(ctx context.Context, resourc
 		return h.setResourceState(ctx, resource, commondomain.ResourceStateActive, false)
 
 	case wantBlockStorageDelete(resource):
-		return h.ensureNoImageReferrers(ctx, resource)
+		// The controller holds the deletion while other resources still reference the block storage.
+		return h.setResourceState(ctx, resource, commondomain.ResourceStateDeleting, true)
 
 	case isBlockStorageDeleting(resource):
 		// Nothing to do: the controller will remove the finalizers to end the deletion process.
@@ -177,7 +189,7 @@ func (h *BlockStoragePluginHandler) setResourceErrorState(ctx context.Context, r
 		resource.Status = &bsdom.BlockStorageStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -191,69 +203,21 @@ func (h *BlockStoragePluginHandler) setResourceErrorState(ctx context.Context, r
 	return requeue, nil
 }
 
-// ensureSourceImageReady gates the block storage's transition to creating on its optional
-// source image existing and being active. A block storage without a source image proceeds
-// immediately.
-func (h *BlockStoragePluginHandler) ensureSourceImageReady(ctx context.Context, resource *bsdom.BlockStorage) (bool, error) {
-	if resource.Spec.SourceImageRef == nil {
-		return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
-	}
-
-	exists, state, err := h.deps.State(ctx, imageGVR, *resource.Spec.SourceImageRef, resource.Tenant)
-	if err != nil {
-		return h.setResourceErrorState(ctx, resource, err, true)
-	}
-
-	if !exists || state != commondomain.ResourceStateActive {
-		message := fmt.Sprintf("waiting for source image %q to be active", resource.Spec.SourceImageRef.Resource)
-		c := commonbackend.DependencyPendingCondition(commondomain.ResourceStatePending, message)
-		return h.setResourceCondition(ctx, resource, c, true)
-	}
-
-	return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
-}
-
-// ensureNoImageReferrers blocks deletion of a block storage while any image is still
-// stored on it (references it via BlockStorageRef). The block storage keeps its current
-// state (and therefore its cleanup finalizer) until those images are gone. Images are
-// tenant-scoped, so referrers are searched in the block storage's tenant namespace.
-func (h *BlockStoragePluginHandler) ensureNoImageReferrers(ctx context.Context, resource *bsdom.BlockStorage) (bool, error) {
-	namespace := frameworkbackend.ComputeNamespace(&kernelresource.Scope{Tenant: resource.Tenant})
-	target := commonbackend.ReferenceTarget{Tenant: resource.Tenant, Workspace: resource.Workspace, Name: resource.Name}
-
-	referrers, err := h.deps.Referrers(ctx, imageGVR, namespace, []string{"spec", "blockStorageRef"}, target, resource.Tenant)
-	if err != nil {
-		return h.setResourceErrorState(ctx, resource, err, true)
-	}
-
-	if len(referrers) > 0 {
-		message := fmt.Sprintf("deletion blocked: still referenced by images %v", referrers)
-		c := commonbackend.DeletionBlockedCondition(resource.Status.State, message)
-		return h.setResourceCondition(ctx, resource, c, true)
-	}
-
-	return h.setResourceState(ctx, resource, commondomain.ResourceStateDeleting, true)
-}
-
-// setResourceCondition pushes c onto the resource status and persists it, mirroring
-// setResourceState but without forcing a lifecycle transition beyond what c carries.
-func (h *BlockStoragePluginHandler) setResourceCondition(ctx context.Context, resource *bsdom.BlockStorage, c commondomain.StatusCondition, requeue bool) (bool, error) {
+// ensureDependenciesReady gates the block storage's transition to creating on its
+// blockStorageDependencies being active. A block storage without a source image proceeds
+// immediately. One waiting for its image is not requeued: the controller watches images
+// and reconciles it again as soon as its image changes state.
+func (h *BlockStoragePluginHandler) ensureDependenciesReady(ctx context.Context, resource *bsdom.BlockStorage) (bool, error) {
 	if resource.Status == nil {
 		resource.Status = &bsdom.BlockStorageStatus{}
 	}
 
-	resource.Status.PushCondition(c)
-	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
-
-	if _, err := h.repo.UpdateStatus(ctx, resource); err != nil {
-		if errors.Is(err, kernel.ErrNotFound) {
-			return false, nil
-		}
-
+	ready, requeue, err := commonbackend.AwaitDependencies(ctx, resource, &resource.Status.Status, blockStorageDependencies, h.deps, h.repo, h.MaxConditions, h.MaxAttempts)
+	if !ready {
 		return requeue, err
 	}
 
-	return requeue, nil
+	return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
 }
 
 func blockDecreaseSize(_ context.Context, resource *bsdom.BlockStorage) error {
@@ -325,7 +289,8 @@ func wantBlockStorageRetryCreate(resource *bsdom.BlockStorage) bool {
 		resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
 
 func wantBlockStorageRetryIncreaseSize(resource *bsdom.BlockStorage) bool {
@@ -334,5 +299,6 @@ func wantBlockStorageRetryIncreaseSize(resource *bsdom.BlockStorage) bool {
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
 		resource.Status.Conditions[1].State == commondomain.ResourceStateUpdating &&
-		resource.Spec.SizeGB > resource.Status.SizeGB
+		resource.Spec.SizeGB > resource.Status.SizeGB &&
+		!resource.Status.RetriesExhausted()
 }
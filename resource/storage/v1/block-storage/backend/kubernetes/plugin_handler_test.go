@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
 	. "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage/backend/kubernetes"
@@ -326,6 +327,37 @@ func TestBlockStoragePluginHandler_HandleReconcile(t *testing.T) {
 		require.True(t, requeue)
 	})
 
+	t.Run("should not retry create once the attempts are exhausted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		//
+		// Given a resource whose create failed as many times as it is attempted
+		status := commondomain.Status{}
+		status.PushCondition(commonbackend.ConditionFromState(commondomain.ResourceStateCreating))
+		commonbackend.PushErrorCondition(&status, errors.New("unknown image"), 1)
+		resource := &bsdom.BlockStorage{Status: &bsdom.BlockStorageStatus{Status: status}}
+
+		//
+		// And a repo and a plugin that are not expected to be called
+		mockRepo := NewMockRepo[*bsdom.BlockStorage](ctrl)
+		mockPlugin := NewMockBlockStoragePlugin(ctrl)
+
+		//
+		// And a block storage plugin handler
+		handler := NewBlockStoragePluginHandler(mockRepo, mockPlugin, 0, nil)
+
+		//
+		// When we reconcile the resource
+		requeue, err := handler.HandleReconcile(context.Background(), resource)
+
+		//
+		// Then it should leave the resource in error
+		require.NoError(t, err)
+		require.False(t, requeue)
+		require.Equal(t, commondomain.ResourceStateError, resource.Status.State)
+	})
+
 	t.Run("should set state to updating and requeue on retry increase size", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -718,6 +750,7 @@ func TestBlockStoragePluginHandler_HandleReconcile(t *testing.T) {
 			func(_ context.Context, res *bsdom.BlockStorage) (*bsdom.BlockStorage, error) {
 				require.Equal(t, commondomain.ResourceStatePending, res.Status.State)
 				require.Equal(t, "DependencyPending", res.Status.Conditions[0].Type)
+				require.Contains(t, res.Status.Conditions[0].Message, `source image "images/src" (not found)`)
 				return nil, nil
 			}).Times(1)
 
@@ -728,10 +761,10 @@ func TestBlockStoragePluginHandler_HandleReconcile(t *testing.T) {
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
 		require.NoError(t, err)
-		require.True(t, requeue)
+		require.False(t, requeue)
 	})
 
-	t.Run("should block deletion while an image references the block storage", func(t *testing.T) {
+	t.Run("should proceed to deleting when marked for deletion", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -747,53 +780,7 @@ func TestBlockStoragePluginHandler_HandleReconcile(t *testing.T) {
 			},
 		}
 
-		//
-		// And a deps resolver reporting an image still references it
-		mockDeps := NewMockDependencyResolver(ctrl)
-		mockDeps.EXPECT().Referrers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-			Return([]string{"img-1"}, nil).Times(1)
-
-		//
-		// And a repo expected to record a deletion-blocked condition while keeping the state
-		mockRepo := NewMockRepo[*bsdom.BlockStorage](ctrl)
-		mockRepo.EXPECT().UpdateStatus(gomock.Any(), gomock.Any()).DoAndReturn(
-			func(_ context.Context, res *bsdom.BlockStorage) (*bsdom.BlockStorage, error) {
-				require.Equal(t, commondomain.ResourceStateActive, res.Status.State)
-				require.Equal(t, "DeletionBlocked", res.Status.Conditions[0].Type)
-				return nil, nil
-			}).Times(1)
-
-		//
-		// And a plugin whose Delete is not expected to be called
-		mockPlugin := NewMockBlockStoragePlugin(ctrl)
-
-		handler := NewBlockStoragePluginHandler(mockRepo, mockPlugin, 0, mockDeps)
-
-		requeue, err := handler.HandleReconcile(context.Background(), resource)
-
-		require.NoError(t, err)
-		require.True(t, requeue)
-	})
-
-	t.Run("should proceed to deleting when no image references the block storage", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish()
-
-		//
-		// Given an active block storage marked for deletion with no referrers
-		now := time.Now()
-		resource := &bsdom.BlockStorage{
-			RegionalMetadata: commondomain.RegionalMetadata{
-				CommonMetadata: commondomain.CommonMetadata{Name: "bs1", DeletedAt: &now},
-			},
-			Status: &bsdom.BlockStorageStatus{
-				Status: commondomain.Status{State: commondomain.ResourceStateActive},
-			},
-		}
-
 		mockDeps := NewMockDependencyResolver(ctrl)
-		mockDeps.EXPECT().Referrers(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
-			Return(nil, nil).Times(1)
 
 		//
 		// And a repo expected to advance the state to deleting
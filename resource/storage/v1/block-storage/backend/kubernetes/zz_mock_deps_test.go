@@ -13,7 +13,6 @@ import (
 	context "context"
 	reflect "reflect"
 
-	backend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
 	domain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
 	gomock "go.uber.org/mock/gomock"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -43,21 +42,6 @@ func (m *MockDependencyResolver) EXPECT() *MockDependencyResolverMockRecorder {
 	return m.recorder
 }
 
-// Referrers mocks base method.
-func (m *MockDependencyResolver) Referrers(ctx context.Context, gvr schema.GroupVersionResource, namespace string, fieldPath []string, target backend.ReferenceTarget, defaultTenant string) ([]string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Referrers", ctx, gvr, namespace, fieldPath, target, defaultTenant)
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Referrers indicates an expected call of Referrers.
-func (mr *MockDependencyResolverMockRecorder) Referrers(ctx, gvr, namespace, fieldPath, target, defaultTenant any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Referrers", reflect.TypeOf((*MockDependencyResolver)(nil).Referrers), ctx, gvr, namespace, fieldPath, target, defaultTenant)
-}
-
 // State mocks base method.
 func (m *MockDependencyResolver) State(ctx context.Context, gvr schema.GroupVersionResource, ref domain.Reference, defaultTenant string) (bool, domain.ResourceState, error) {
 	m.ctrl.T.Helper()
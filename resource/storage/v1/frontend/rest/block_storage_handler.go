@@ -27,7 +27,7 @@ func (h *Handler) DeleteBlockStorage(w http.ResponseWriter, r *http.Request, ten
 	if params.IfUnmodifiedSince != nil {
 		id.Version = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.BlockStorageWriter, newBlockStorageWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, bsdom.Resource, frest.DeleterFromRepo(h.BlockStorageWriter, newBlockStorageWithIdentity)))
 }
 
 // GetBlockStorage handles GET /v1/tenants/{tenant}/workspaces/{workspace}/block-storages/{name}.
@@ -53,6 +53,8 @@ func (h *Handler) CreateOrUpdateBlockStorage(w http.ResponseWriter, r *http.Requ
 			return blockStorageFromAPI(sdk, p.(*resource.Identity), region)
 		},
 		DomainToAPI: blockStorageToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    bsdom.Resource,
 	})
 }
 
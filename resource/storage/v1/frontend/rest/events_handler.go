@@ -0,0 +1,41 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+	imgdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/image"
+)
+
+// RegisterEventRoutes registers the events, GET .../{name}/events, of the block storages and
+// images on mux under baseURL, wrapped in middlewares. The SECA spec has no such route, so the
+// routes are not part of the generated server.
+func (h *Handler) RegisterEventRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	tenant := baseURL + "/v1/tenants/{tenant}"
+	for collection, fn := range map[string]http.HandlerFunc{
+		tenant + "/workspaces/{workspace}/" + bsdom.Resource: h.GetBlockStorageEvents,
+		tenant + "/" + imgdom.Resource:                       h.GetImageEvents,
+	} {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle("GET "+collection+"/{name}/"+commonfrontend.EventsAction, handler)
+	}
+}
+
+// GetBlockStorageEvents handles GET /v1/tenants/{tenant}/workspaces/{workspace}/block-storages/{name}/events.
+func (h *Handler) GetBlockStorageEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "storage", "resource", "block-storage")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.BlockStorageReader, newBlockStorageWithIdentity, h.Events, bsdom.Kind)
+}
+
+// GetImageEvents handles GET /v1/tenants/{tenant}/images/{name}/events.
+func (h *Handler) GetImageEvents(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "storage", "resource", "image")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant")}}
+	commonfrontend.HandleEvents(w, r, logger, ir, h.ImageReader, newImageWithIdentity, h.Events, imgdom.Kind)
+}
@@ -6,6 +6,9 @@ import (
 
 	sdkstorage "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/foundation.storage.v1"
 
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	backendport "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/integrity"
 	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
 	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
 	imgdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/image"
@@ -21,7 +24,13 @@ type Handler struct {
 	ImageReader        persistencepkg.ReaderRepo[*imgdom.Image]
 	ImageWriter        persistencepkg.WriterRepo[*imgdom.Image]
 	SKUReader          persistencepkg.ReaderRepo[*skudom.StorageSKU]
-	Logger             *slog.Logger
+	// Admission reviews created and updated resources; nil admits every write.
+	Admission admission.Reviewer
+	// Integrity refuses the deletion of resources others still reference; nil checks nothing.
+	Integrity integrity.Checker
+	// Events reads the events served by RegisterEventRoutes.
+	Events backendport.EventReader
+	Logger *slog.Logger
 }
 
 var _ sdkstorage.ServerInterface = (*Handler)(nil)
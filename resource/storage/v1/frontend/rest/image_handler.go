@@ -27,7 +27,7 @@ func (h *Handler) DeleteImage(w http.ResponseWriter, r *http.Request, tenant sdk
 	if params.IfUnmodifiedSince != nil {
 		id.Version = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.ImageWriter, newImageWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, imgdom.Resource, frest.DeleterFromRepo(h.ImageWriter, newImageWithIdentity)))
 }
 
 // GetImage handles GET /v1/tenants/{tenant}/images/{name}.
@@ -53,5 +53,7 @@ func (h *Handler) CreateOrUpdateImage(w http.ResponseWriter, r *http.Request, te
 			return imageFromAPI(sdk, p.(*resource.Identity), region)
 		},
 		DomainToAPI: imageToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    imgdom.Resource,
 	})
 }
@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+	bsdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/block-storage"
+	imgdom "github.com/eu-sovereign-cloud/ecp/resource/storage/v1/image"
+)
+
+// RegisterRetryRoutes registers the retry action, POST .../{name}/retry, of the block storages
+// and images on mux under baseURL, wrapped in middlewares. The SECA spec has no such action,
+// so the routes are not part of the generated server.
+func (h *Handler) RegisterRetryRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	tenant := baseURL + "/v1/tenants/{tenant}"
+	for collection, fn := range map[string]http.HandlerFunc{
+		tenant + "/workspaces/{workspace}/" + bsdom.Resource: h.RetryBlockStorage,
+		tenant + "/" + imgdom.Resource:                       h.RetryImage,
+	} {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle("POST "+collection+"/{name}/"+commonfrontend.RetryAction, handler)
+	}
+}
+
+// RetryBlockStorage handles POST /v1/tenants/{tenant}/workspaces/{workspace}/block-storages/{name}/retry.
+func (h *Handler) RetryBlockStorage(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "storage", "resource", "block-storage")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.BlockStorageReader, h.BlockStorageWriter, newBlockStorageWithIdentity,
+		func(bs *bsdom.BlockStorage) *commondomain.Status {
+			if bs.Status == nil {
+				return nil
+			}
+			return &bs.Status.Status
+		})
+}
+
+// RetryImage handles POST /v1/tenants/{tenant}/images/{name}/retry.
+func (h *Handler) RetryImage(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "storage", "resource", "image")
+	ir := &resource.Identity{Name: r.PathValue("name"), Scope: resource.Scope{Tenant: r.PathValue("tenant")}}
+	commonfrontend.HandleRetry(w, r, logger, ir, h.ImageReader, h.ImageWriter, newImageWithIdentity,
+		func(img *imgdom.Image) *commondomain.Status {
+			if img.Status == nil {
+				return nil
+			}
+			return &img.Status.Status
+		})
+}
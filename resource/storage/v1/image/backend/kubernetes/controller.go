@@ -35,7 +35,9 @@ func NewController(
 	)
 	deps := commonbackend.NewReferenceResolver(dynClient)
 	handler := NewImagePluginHandler(repo, plugin, options.MaxConditions, deps)
-	return &Controller{
+	handler.SetTimeouts(options.Plugin, options.Timeouts)
+	handler.MaxAttempts = options.MaxAttempts
+	c := &Controller{
 		GenericController: frameworkcontroller.NewGenericController[*imgdom.Image](
 			ctrlClient,
 			ImageFromCR,
@@ -46,4 +48,11 @@ func NewController(
 			options.MaxConditions,
 		),
 	}
+	c.RecordBackend(options.Plugin, plugin)
+	c.ConfigureQueue(options.Queue)
+	c.CheckDrift(options.DriftCheckInterval)
+	c.Shard(options.Sharder)
+	c.WatchReferences(commonbackend.ImageReferrer.References...)
+	c.BlockReferencedDeletion(options.ReferenceGraph)
+	return c
 }
@@ -3,7 +3,6 @@ package kubernetes
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -27,6 +26,19 @@ type DependencyResolver interface {
 	State(ctx context.Context, gvr schema.GroupVersionResource, ref commondomain.Reference, defaultTenant string) (bool, commondomain.ResourceState, error)
 }
 
+// imageDependencies are the resources an image waits on before it is created: the block
+// storage it is stored on.
+var imageDependencies = commonbackend.Dependencies[*imgdom.Image]{
+	{
+		Name:       "block storage",
+		GVR:        blockStorageGVR,
+		Workspaced: true,
+		Refs: func(img *imgdom.Image) []commondomain.Reference {
+			return []commondomain.Reference{img.Spec.BlockStorageRef}
+		},
+	},
+}
+
 // ImagePluginHandler drives the image reconciliation state machine.
 type ImagePluginHandler struct {
 	frameworkbackend.GenericPluginHandler[*imgdom.Image]
@@ -58,7 +70,7 @@ func (h *ImagePluginHandler) HandleReconcile(ctx context.Context, resource *imgd
 	// An active resource has no lifecycle transition left to make, so it takes the update
 	// path instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isImageActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*imgdom.Image]
@@ -72,13 +84,13 @@ func (h *ImagePluginHandler) HandleReconcile(ctx context.Context, resource *imgd
 		delegate = frameworkbackend.BypassDelegated[*imgdom.Image]
 
 	case isImageCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 
 	case wantImageDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*imgdom.Image]
 
 	case isImageDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 
 	case wantImageRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*imgdom.Image]
@@ -105,7 +117,7 @@ func (h *ImagePluginHandler) HandleReconcile(ctx context.Context, resource *imgd
 		return h.setResourceState(ctx, resource, commondomain.ResourceStatePending, false)
 
 	case isImagePending(resource):
-		return h.ensureBlockStorageReady(ctx, resource)
+		return h.ensureDependenciesReady(ctx, resource)
 
 	case isImageCreating(resource):
 		return h.setResourceState(ctx, resource, commondomain.ResourceStateActive, false)
@@ -151,7 +163,7 @@ func (h *ImagePluginHandler) setResourceErrorState(ctx context.Context, resource
 		resource.Status = &imgdom.ImageStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -165,43 +177,21 @@ func (h *ImagePluginHandler) setResourceErrorState(ctx context.Context, resource
 	return requeue, nil
 }
 
-// ensureBlockStorageReady gates the image's transition to creating on its referenced
-// block storage existing and being active. While the dependency is not ready the image
-// stays pending and the reconcile is requeued.
-func (h *ImagePluginHandler) ensureBlockStorageReady(ctx context.Context, resource *imgdom.Image) (bool, error) {
-	exists, state, err := h.deps.State(ctx, blockStorageGVR, resource.Spec.BlockStorageRef, resource.Tenant)
-	if err != nil {
-		return h.setResourceErrorState(ctx, resource, err, true)
-	}
-
-	if !exists || state != commondomain.ResourceStateActive {
-		message := fmt.Sprintf("waiting for block storage %q to be active", resource.Spec.BlockStorageRef.Resource)
-		c := commonbackend.DependencyPendingCondition(commondomain.ResourceStatePending, message)
-		return h.setResourceCondition(ctx, resource, c, true)
-	}
-
-	return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
-}
-
-// setResourceCondition pushes c onto the resource status and persists it, mirroring
-// setResourceState but without forcing a lifecycle transition beyond what c carries.
-func (h *ImagePluginHandler) setResourceCondition(ctx context.Context, resource *imgdom.Image, c commondomain.StatusCondition, requeue bool) (bool, error) {
+// ensureDependenciesReady gates the image's transition to creating on its imageDependencies
+// being active. While they are not, the image stays pending without a requeue: the
+// controller watches block storages and reconciles the image again as soon as its block
+// storage changes state.
+func (h *ImagePluginHandler) ensureDependenciesReady(ctx context.Context, resource *imgdom.Image) (bool, error) {
 	if resource.Status == nil {
 		resource.Status = &imgdom.ImageStatus{}
 	}
 
-	resource.Status.PushCondition(c)
-	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
-
-	if _, err := h.repo.UpdateStatus(ctx, resource); err != nil {
-		if errors.Is(err, kernel.ErrNotFound) {
-			return false, nil
-		}
-
+	ready, requeue, err := commonbackend.AwaitDependencies(ctx, resource, &resource.Status.Status, imageDependencies, h.deps, h.repo, h.MaxConditions, h.MaxAttempts)
+	if !ready {
 		return requeue, err
 	}
 
-	return requeue, nil
+	return h.setResourceState(ctx, resource, commondomain.ResourceStateCreating, true)
 }
 
 func isImageActive(resource *imgdom.Image) bool {
@@ -244,5 +234,6 @@ func wantImageRetryCreate(resource *imgdom.Image) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
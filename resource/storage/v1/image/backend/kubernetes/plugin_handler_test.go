@@ -108,7 +108,7 @@ func TestImagePluginHandler_HandleReconcile(t *testing.T) {
 		require.True(t, requeue)
 	})
 
-	t.Run("should stay pending and requeue when block storage is not yet active", func(t *testing.T) {
+	t.Run("should stay pending without a requeue when block storage is not yet active", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -151,9 +151,9 @@ func TestImagePluginHandler_HandleReconcile(t *testing.T) {
 		requeue, err := handler.HandleReconcile(context.Background(), resource)
 
 		//
-		// Then it should stay pending and request a requeue
+		// Then it should stay pending and wait for the block storage watch instead of a requeue
 		require.NoError(t, err)
-		require.True(t, requeue)
+		require.False(t, requeue)
 	})
 
 	t.Run("should set error state and requeue when dependency resolution fails", func(t *testing.T) {
@@ -33,7 +33,10 @@ func NewController(
 		WorkspaceFromCR,
 	)
 	handler := NewWorkspacePluginHandler(repo, plugin, options.MaxConditions)
-	return &Controller{
+	handler.SetTimeouts(options.Plugin, options.Timeouts)
+	// The attempts of a workspace are not bounded: it has no retry action to re-arm it, its
+	// path being the workspace anchor every authorization claim strips.
+	c := &Controller{
 		GenericController: frameworkcontroller.NewGenericController[*wsdom.Workspace](
 			ctrlClient,
 			WorkspaceFromCR,
@@ -44,4 +47,10 @@ func NewController(
 			options.MaxConditions,
 		),
 	}
+	c.RecordBackend(options.Plugin, plugin)
+	c.ConfigureQueue(options.Queue)
+	c.CheckDrift(options.DriftCheckInterval)
+	c.Shard(options.Sharder)
+	c.BlockReferencedDeletion(options.ReferenceGraph)
+	return c
 }
@@ -43,7 +43,7 @@ func (h *WorkspacePluginHandler) HandleReconcile(ctx context.Context, resource *
 	// An active resource has no lifecycle transition left to make, so it takes the update
 	// path instead of the create/delete state machine below. See commonbackend.HandleUpdate.
 	if isWorkspaceActive(resource) {
-		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.plugin.Update, h.repo, h.MaxConditions)
+		return commonbackend.HandleUpdate(ctx, resource, &resource.Status.Status, h.Delegate(backendport.OperationUpdate, h.plugin.Update), h.repo, h.MaxConditions, h.MaxAttempts)
 	}
 
 	var delegate backendport.DelegatedFunc[*wsdom.Workspace]
@@ -57,13 +57,13 @@ func (h *WorkspacePluginHandler) HandleReconcile(ctx context.Context, resource *
 		delegate = frameworkbackend.BypassDelegated[*wsdom.Workspace]
 
 	case isWorkspaceCreating(resource):
-		delegate = h.plugin.Create
+		delegate = h.Delegate(backendport.OperationCreate, h.plugin.Create)
 
 	case wantWorkspaceDelete(resource):
 		delegate = frameworkbackend.BypassDelegated[*wsdom.Workspace]
 
 	case isWorkspaceDeleting(resource):
-		delegate = h.plugin.Delete
+		delegate = h.Delegate(backendport.OperationDelete, h.plugin.Delete)
 
 	case wantWorkspaceRetryCreate(resource):
 		delegate = frameworkbackend.BypassDelegated[*wsdom.Workspace]
@@ -136,7 +136,7 @@ func (h *WorkspacePluginHandler) setResourceErrorState(ctx context.Context, reso
 		resource.Status = &wsdom.WorkspaceStatus{}
 	}
 
-	resource.Status.PushCondition(commonbackend.ConditionFromError(err))
+	commonbackend.PushErrorCondition(&resource.Status.Status, err, h.MaxAttempts)
 	commonbackend.TrimConditions(&resource.Status.Status, h.MaxConditions)
 
 	if _, updateErr := h.repo.UpdateStatus(ctx, resource); updateErr != nil {
@@ -190,5 +190,6 @@ func wantWorkspaceRetryCreate(resource *wsdom.Workspace) bool {
 	return resource.DeletedAt == nil && resource.Status != nil &&
 		resource.Status.State == commondomain.ResourceStateError &&
 		len(resource.Status.Conditions) > 1 &&
-		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating
+		resource.Status.Conditions[1].State == commondomain.ResourceStateCreating &&
+		!resource.Status.RetriesExhausted()
 }
@@ -10,17 +10,34 @@ import (
 
 	frameworkconfig "github.com/eu-sovereign-cloud/ecp/framework/frontend/config"
 	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/admission"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/port/integrity"
 	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
 	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
 	wsdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1"
+	quotadom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota"
 )
 
 // Handler is the HTTP handler for workspace resources.
-// It implements the full sdkworkspace.ServerInterface.
+// It implements the full sdkworkspace.ServerInterface. The quota and usage routes, which
+// SECA does not specify, are in quota_handler.go and mounted with RegisterQuotaRoutes; the
+// sovereignty report is in sovereignty_handler.go.
 type Handler struct {
 	Reader persistencepkg.ReaderRepo[*wsdom.Workspace]
 	Writer persistencepkg.WriterRepo[*wsdom.Workspace]
-	Logger *slog.Logger
+	// Admission reviews created and updated resources; nil admits every write.
+	Admission admission.Reviewer
+	// Integrity refuses the deletion of a workspace still holding resources; nil checks
+	// nothing.
+	Integrity integrity.Checker
+	Logger    *slog.Logger
+
+	QuotaReader persistencepkg.ReaderRepo[*quotadom.Quota]
+	QuotaWriter persistencepkg.WriterRepo[*quotadom.Quota]
+	// Usage, when set, serves the usage routes. A nil Usage leaves them unmounted.
+	Usage UsageReporter
+	// Sovereignty builds the sovereignty report served by RegisterSovereigntyRoutes.
+	Sovereignty SovereigntyReporter
 }
 
 var _ sdkworkspace.ServerInterface = (*Handler)(nil)
@@ -38,7 +55,7 @@ func (h *Handler) DeleteWorkspace(w http.ResponseWriter, r *http.Request, tenant
 	if params.IfUnmodifiedSince != nil {
 		id.Version = strconv.Itoa(*params.IfUnmodifiedSince)
 	}
-	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.Writer, newWorkspaceWithIdentity))
+	frest.HandleDelete(w, r, logger, id, frest.DeleterWithIntegrity(h.Integrity, wsdom.Resource, frest.DeleterFromRepo(h.Writer, newWorkspaceWithIdentity)))
 }
 
 // GetWorkspace handles GET /v1/tenants/{tenant}/workspaces/{name}.
@@ -64,6 +81,8 @@ func (h *Handler) CreateOrUpdateWorkspace(w http.ResponseWriter, r *http.Request
 			return workspaceFromAPI(sdk, p.(*resource.Identity), region)
 		},
 		DomainToAPI: workspaceToAPIWithVerb(http.MethodPut),
+		Admission:   h.Admission,
+		Resource:    wsdom.Resource,
 	})
 }
 
@@ -0,0 +1,182 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	sdkschema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	commonfrontend "github.com/eu-sovereign-cloud/ecp/resource/common/frontend"
+	quotadom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota"
+)
+
+// quotaKind is the metadata kind of a quota. SECA does not define one, so it follows the
+// kebab-case form of the specified kinds.
+const quotaKind = sdkschema.RegionalWorkspaceResourceMetadataKind("quota")
+
+// Quota is the API representation of a quota. SECA has no quota schema; the shape mirrors
+// the specified regional resources. Metadata.Workspace is empty for a tenant quota.
+type Quota struct {
+	Metadata    *sdkschema.RegionalWorkspaceResourceMetadata `json:"metadata,omitempty"`
+	Labels      sdkschema.Labels                             `json:"labels"`
+	Annotations map[string]string                            `json:"annotations,omitempty"`
+	Extensions  map[string]string                            `json:"extensions,omitempty"`
+	Spec        QuotaSpec                                    `json:"spec"`
+	Status      *QuotaStatus                                 `json:"status,omitempty"`
+}
+
+// QuotaSpec is the API representation of a quota's spec.
+type QuotaSpec struct {
+	Limits map[string]int `json:"limits"`
+}
+
+// QuotaStatus is the API representation of a quota's status.
+type QuotaStatus struct {
+	State      sdkschema.ResourceState     `json:"state,omitempty"`
+	Conditions []sdkschema.StatusCondition `json:"conditions"`
+}
+
+// QuotaIterator is a page of quotas.
+type QuotaIterator struct {
+	Items    []Quota                    `json:"items"`
+	Metadata sdkschema.ResponseMetadata `json:"metadata"`
+}
+
+// UsageReport is the API representation of the usage of a tenant or workspace.
+type UsageReport struct {
+	Metadata  sdkschema.ResponseMetadata `json:"metadata"`
+	Used      map[string]int             `json:"used"`
+	Remaining map[string]int             `json:"remaining"`
+	Quotas    []QuotaUsage               `json:"quotas"`
+}
+
+// QuotaUsage is the API representation of one quota within a usage report.
+type QuotaUsage struct {
+	Name      string         `json:"name"`
+	Workspace string         `json:"workspace,omitempty"`
+	Limits    map[string]int `json:"limits"`
+	Used      map[string]int `json:"used"`
+	Remaining map[string]int `json:"remaining"`
+}
+
+// quotaToAPIWithVerb returns a func that converts a Quota to its API representation with
+// the given verb.
+func quotaToAPIWithVerb(verb string) func(q *quotadom.Quota) *Quota {
+	return func(q *quotadom.Quota) *Quota {
+		return quotaToAPI(*q, verb)
+	}
+}
+
+// quotaIteratorToAPI converts a list of Quota to a QuotaIterator.
+func quotaIteratorToAPI(quotas []*quotadom.Quota, nextSkipToken *string) *QuotaIterator {
+	items := make([]Quota, len(quotas))
+	for i, q := range quotas {
+		items[i] = *quotaToAPI(*q, http.MethodGet)
+	}
+	return &QuotaIterator{
+		Items: items,
+		Metadata: sdkschema.ResponseMetadata{
+			Provider:  quotadom.ProviderID,
+			Resource:  quotadom.Resource,
+			Verb:      http.MethodGet,
+			SkipToken: nextSkipToken,
+		},
+	}
+}
+
+// quotaToAPI converts a Quota to its API representation with the given verb.
+func quotaToAPI(q quotadom.Quota, verb string) *Quota {
+	resourceVersion := int64(0)
+	if parsed, err := strconv.ParseInt(q.ResourceVersion, 10, 64); err == nil {
+		resourceVersion = parsed
+	}
+	ref := commondomain.FormatRegionalTenantScopedRef(q.Provider, q.Tenant, quotaKind, q.Name)
+	if q.Workspace != "" {
+		ref = commondomain.FormatRegionalWorkspaceScopedRef(q.Provider, q.Tenant, q.Workspace, quotaKind, q.Name)
+	}
+
+	api := &Quota{
+		Metadata: &sdkschema.RegionalWorkspaceResourceMetadata{
+			ApiVersion:      quotadom.Version,
+			CreatedAt:       q.CreatedAt,
+			LastModifiedAt:  q.UpdatedAt,
+			Kind:            quotaKind,
+			Name:            q.Name,
+			Tenant:          q.Tenant,
+			Workspace:       q.Workspace,
+			Provider:        q.Provider,
+			Region:          q.Region,
+			Resource:        commondomain.FormatRegionalResource(quotaKind, q.Name),
+			Ref:             ref,
+			ResourceVersion: resourceVersion,
+			Verb:            verb,
+			DeletedAt:       q.DeletedAt,
+		},
+		Labels:      q.Labels,
+		Annotations: q.Annotations,
+		Extensions:  q.Extensions,
+		Spec:        QuotaSpec{Limits: q.Spec.Limits},
+	}
+	if api.Labels == nil {
+		api.Labels = make(sdkschema.Labels)
+	}
+	if api.Spec.Limits == nil {
+		api.Spec.Limits = map[string]int{}
+	}
+	if q.Status != nil {
+		api.Status = &QuotaStatus{
+			State:      commonfrontend.ResourceStateToAPI(q.Status.State),
+			Conditions: commonfrontend.ConditionsToAPI(q.Status.Conditions),
+		}
+	}
+	return api
+}
+
+// quotaFromAPI converts an API Quota to a domain Quota.
+func quotaFromAPI(api Quota, id *resource.Identity, region string) *quotadom.Quota {
+	q := &quotadom.Quota{Spec: quotadom.QuotaSpec{Limits: api.Spec.Limits}}
+	q.Name = id.GetName()
+	q.ResourceVersion = id.GetVersion()
+	q.Provider = quotadom.ProviderID
+	q.Tenant = id.GetTenant()
+	q.Workspace = id.GetWorkspace()
+	q.Region = region
+	q.Labels = api.Labels
+	q.Annotations = api.Annotations
+	q.Extensions = api.Extensions
+	return q
+}
+
+// usageReportToAPI converts a UsageReport to its API representation.
+func usageReportToAPI(report *quotadom.UsageReport) *UsageReport {
+	api := &UsageReport{
+		Metadata: sdkschema.ResponseMetadata{
+			Provider: quotadom.ProviderID,
+			Resource: quotadom.UsageResource,
+			Verb:     http.MethodGet,
+		},
+		Used:      nonNilUsage(report.Used),
+		Remaining: nonNilUsage(report.Remaining),
+		Quotas:    make([]QuotaUsage, len(report.Quotas)),
+	}
+	for i, q := range report.Quotas {
+		api.Quotas[i] = QuotaUsage{
+			Name:      q.Name,
+			Workspace: q.Workspace,
+			Limits:    nonNilUsage(q.Limits),
+			Used:      nonNilUsage(q.Used),
+			Remaining: nonNilUsage(q.Remaining),
+		}
+	}
+	return api
+}
+
+// nonNilUsage returns u, or an empty map so it encodes as {} rather than null.
+func nonNilUsage(u quotadom.Usage) map[string]int {
+	if u == nil {
+		return map[string]int{}
+	}
+	return u
+}
@@ -0,0 +1,192 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+
+	frameworkconfig "github.com/eu-sovereign-cloud/ecp/framework/frontend/config"
+	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/validation"
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	quotadom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota"
+)
+
+// UsageReporter reports the usage of a tenant or workspace against the quotas governing
+// it. A scope with an empty Workspace is the whole tenant.
+type UsageReporter interface {
+	Usage(ctx context.Context, scope resource.Scope) (*quotadom.UsageReport, error)
+}
+
+// RegisterQuotaRoutes mounts the quota and usage routes under baseURL on mux. SECA does
+// not specify them, so they are not part of the generated ServerInterface. The reads are
+// wrapped in readMWs and the writes in writeMWs, the way oapi-codegen applies middlewares:
+// the last one runs first. The usage routes are only mounted when h.Usage is set.
+//
+//	PUT    /v1/tenants/{tenant}[/workspaces/{workspace}]/quotas/{name}
+//	GET    /v1/tenants/{tenant}[/workspaces/{workspace}]/quotas[/{name}]
+//	DELETE /v1/tenants/{tenant}[/workspaces/{workspace}]/quotas/{name}
+//	GET    /v1/tenants/{tenant}[/workspaces/{workspace}]/usage
+func (h *Handler) RegisterQuotaRoutes(mux *http.ServeMux, baseURL string, readMWs, writeMWs []func(http.Handler) http.Handler) {
+	handle := func(pattern string, fn http.HandlerFunc, middlewares []func(http.Handler) http.Handler) {
+		var handler http.Handler = fn
+		for _, mw := range middlewares {
+			handler = mw(handler)
+		}
+		mux.Handle(pattern, handler)
+	}
+	for _, scope := range []string{baseURL + "/v1/tenants/{tenant}", baseURL + "/v1/tenants/{tenant}/workspaces/{workspace}"} {
+		collection := scope + "/" + quotadom.Resource
+		handle("GET "+collection, h.ListQuotas, readMWs)
+		handle("GET "+collection+"/{name}", h.GetQuota, readMWs)
+		handle("PUT "+collection+"/{name}", h.CreateOrUpdateQuota, writeMWs)
+		handle("DELETE "+collection+"/{name}", h.DeleteQuota, writeMWs)
+		if h.Usage != nil {
+			handle("GET "+scope+"/"+quotadom.UsageResource, h.GetUsage, readMWs)
+		}
+	}
+}
+
+// ListQuotas handles GET /v1/tenants/{tenant}[/workspaces/{workspace}]/quotas. A tenant
+// lists its tenant quotas, a workspace its own.
+func (h *Handler) ListQuotas(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With("provider", "workspace", "resource", "quota")
+	frest.HandleList(w, r, logger, quotaListParams(r), frest.ListerFromRepo(h.QuotaReader), quotaIteratorToAPI)
+}
+
+// GetQuota handles GET /v1/tenants/{tenant}[/workspaces/{workspace}]/quotas/{name}.
+func (h *Handler) GetQuota(w http.ResponseWriter, r *http.Request) {
+	id := quotaIdentity(r)
+	logger := h.Logger.With("provider", "workspace", "resource", "quota", "name", id.Name)
+	frest.HandleGet(w, r, logger, id, frest.GetterFromRepo(h.QuotaReader, newQuotaWithIdentity), quotaToAPIWithVerb(http.MethodGet))
+}
+
+// CreateOrUpdateQuota handles PUT /v1/tenants/{tenant}[/workspaces/{workspace}]/quotas/{name}.
+// The quota is enforced as soon as the regional gateways see it; no plugin reconciles it,
+// so it is written active.
+func (h *Handler) CreateOrUpdateQuota(w http.ResponseWriter, r *http.Request) {
+	id := quotaIdentity(r)
+	logger := h.Logger.With("provider", "workspace", "resource", "quota", "name", id.Name)
+	region := frameworkconfig.Singleton().Region()
+	frest.HandleUpsert(w, r, logger, frest.UpsertOptions[Quota, *quotadom.Quota, *Quota]{
+		Params:  id,
+		Creator: activeQuotaWriter{persist: h.QuotaWriter.Create, updateStatus: h.QuotaWriter.UpdateStatus},
+		Updater: activeQuotaWriter{persist: h.QuotaWriter.Update, updateStatus: h.QuotaWriter.UpdateStatus},
+		APIToDomain: func(api Quota, p persistencepkg.IdentifiableResource) *quotadom.Quota {
+			return quotaFromAPI(api, p.(*resource.Identity), region)
+		},
+		DomainToAPI: quotaToAPIWithVerb(http.MethodPut),
+	})
+}
+
+// DeleteQuota handles DELETE /v1/tenants/{tenant}[/workspaces/{workspace}]/quotas/{name}.
+func (h *Handler) DeleteQuota(w http.ResponseWriter, r *http.Request) {
+	id := quotaIdentity(r)
+	logger := h.Logger.With("provider", "workspace", "resource", "quota", "name", id.Name)
+	frest.HandleDelete(w, r, logger, id, frest.DeleterFromRepo(h.QuotaWriter, newQuotaWithIdentity))
+}
+
+// GetUsage handles GET /v1/tenants/{tenant}[/workspaces/{workspace}]/usage.
+func (h *Handler) GetUsage(w http.ResponseWriter, r *http.Request) {
+	id := &resource.Identity{Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")}}
+	logger := h.Logger.With("provider", "workspace", "resource", quotadom.UsageResource)
+	frest.HandleGet(w, r, logger, id, usageGetter{h.Usage}, usageReportToAPI)
+}
+
+// usageGetter adapts a UsageReporter to frest.Getter.
+type usageGetter struct {
+	reporter UsageReporter
+}
+
+func (g usageGetter) Do(ctx context.Context, ir persistencepkg.IdentifiableResource) (*quotadom.UsageReport, error) {
+	return g.reporter.Usage(ctx, resource.Scope{Tenant: ir.GetTenant(), Workspace: ir.GetWorkspace()})
+}
+
+// activeQuotaWriter validates and persists a quota, then stamps an active status
+// subresource. Status is a subresource, so the API server drops it on the spec write; the
+// second call persists it.
+type activeQuotaWriter struct {
+	persist      func(context.Context, *quotadom.Quota) (**quotadom.Quota, error)
+	updateStatus func(context.Context, *quotadom.Quota) (**quotadom.Quota, error)
+}
+
+func (a activeQuotaWriter) Do(ctx context.Context, q *quotadom.Quota) (*quotadom.Quota, error) {
+	if err := validateQuota(q); err != nil {
+		return nil, err
+	}
+	saved, err := a.persist(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	(*saved).Status = &quotadom.QuotaStatus{}
+	(*saved).Status.PushCondition(commonbackend.ConditionFromState(commondomain.ResourceStateActive))
+	withStatus, err := a.updateStatus(ctx, *saved)
+	if err != nil {
+		return nil, err
+	}
+	return *withStatus, nil
+}
+
+// validateQuota rejects unknown limit names and negative limits.
+func validateQuota(q *quotadom.Quota) error {
+	var sources []kernel.ErrorSource
+	for _, name := range slices.Sorted(maps.Keys(q.Spec.Limits)) {
+		if limit := q.Spec.Limits[name]; !quotadom.KnownLimit(name) || limit < 0 {
+			sources = append(sources, kernel.ErrorSource{Name: "/spec/limits/" + name, Value: strconv.Itoa(limit)})
+		}
+	}
+	if len(sources) > 0 {
+		return kernel.NewError(kernel.KindValidation, fmt.Errorf("quota %s is invalid", q.Name), sources...)
+	}
+	return nil
+}
+
+// quotaListParams builds the list parameters of a quota list request from its path and
+// query.
+func quotaListParams(r *http.Request) resource.ListParams {
+	query := r.URL.Query()
+	var limit *int
+	if raw := query.Get("limit"); raw != "" {
+		if parsed, err := strconv.Atoi(raw); err == nil {
+			limit = &parsed
+		}
+	}
+	return resource.ListParams{
+		Scope:     resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")},
+		Limit:     validation.GetLimit(limit),
+		SkipToken: query.Get("skipToken"),
+		Selector:  query.Get("labels"),
+	}
+}
+
+// quotaIdentity returns the identity of the quota addressed by r. The workspace is empty
+// for a tenant quota.
+func quotaIdentity(r *http.Request) *resource.Identity {
+	id := &resource.Identity{
+		Name:  r.PathValue("name"),
+		Scope: resource.Scope{Tenant: r.PathValue("tenant"), Workspace: r.PathValue("workspace")},
+	}
+	if v := r.Header.Get("If-Unmodified-Since"); v != "" {
+		if _, err := strconv.Atoi(v); err == nil {
+			id.Version = v
+		}
+	}
+	return id
+}
+
+// newQuotaWithIdentity returns a *quotadom.Quota populated with identity fields from ir.
+func newQuotaWithIdentity(ir persistencepkg.IdentifiableResource) *quotadom.Quota {
+	q := &quotadom.Quota{}
+	q.Name = ir.GetName()
+	q.Tenant = ir.GetTenant()
+	q.Workspace = ir.GetWorkspace()
+	q.ResourceVersion = ir.GetVersion()
+	return q
+}
@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	quotadom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota"
+)
+
+// fakeQuotaStore keeps quotas in memory by workspace and name.
+type fakeQuotaStore struct {
+	entries map[string]*quotadom.Quota
+}
+
+func (f *fakeQuotaStore) List(context.Context, resource.ListFilter, *[]*quotadom.Quota) (*string, error) {
+	return nil, nil
+}
+
+func (f *fakeQuotaStore) Load(_ context.Context, m **quotadom.Quota) error {
+	stored, ok := f.entries[(*m).Workspace+"/"+(*m).Name]
+	if !ok {
+		return kernel.ErrNotFound
+	}
+	cp := *stored
+	*m = &cp
+	return nil
+}
+
+func (f *fakeQuotaStore) Create(_ context.Context, m *quotadom.Quota) (**quotadom.Quota, error) {
+	f.entries[m.Workspace+"/"+m.Name] = m
+	return &m, nil
+}
+
+func (f *fakeQuotaStore) Update(_ context.Context, m *quotadom.Quota) (**quotadom.Quota, error) {
+	f.entries[m.Workspace+"/"+m.Name] = m
+	return &m, nil
+}
+
+func (f *fakeQuotaStore) UpdateStatus(_ context.Context, m *quotadom.Quota) (**quotadom.Quota, error) {
+	return &m, nil
+}
+
+func (f *fakeQuotaStore) Delete(_ context.Context, m *quotadom.Quota) error {
+	delete(f.entries, m.Workspace+"/"+m.Name)
+	return nil
+}
+
+// staticUsage reports the same usage for every scope and records the last scope asked for.
+type staticUsage struct {
+	scope resource.Scope
+}
+
+func (s *staticUsage) Usage(_ context.Context, scope resource.Scope) (*quotadom.UsageReport, error) {
+	s.scope = scope
+	return quotadom.NewUsageReport(quotadom.Usage{"instances": 2}, []quotadom.QuotaUsage{
+		{Name: "default", Limits: quotadom.Usage{"instances": 3}, Used: quotadom.Usage{"instances": 2}, Remaining: quotadom.Usage{"instances": 1}},
+	}), nil
+}
+
+func TestQuotaRoutes(t *testing.T) {
+	store := &fakeQuotaStore{entries: map[string]*quotadom.Quota{}}
+	usage := &staticUsage{}
+	h := &Handler{QuotaReader: store, QuotaWriter: store, Usage: usage, Logger: slog.Default()}
+	mux := http.NewServeMux()
+	h.RegisterQuotaRoutes(mux, "/providers/seca.workspace", nil, nil)
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/providers/seca.workspace/v1/tenants/acme"+path, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve(http.MethodPut, "/quotas/default", `{"spec":{"limits":{"instances":3,"vcpu":12}}}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var created Quota
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.Equal(t, map[string]int{"instances": 3, "vcpu": 12}, created.Spec.Limits)
+	require.NotNil(t, created.Status)
+	require.Equal(t, "seca.workspace/v1/tenants/acme/quotas/default", created.Metadata.Ref)
+
+	rec = serve(http.MethodPut, "/workspaces/dev/quotas/dev", `{"spec":{"limits":{"public-ips":1}}}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "dev", store.entries["dev/dev"].Workspace)
+	require.Equal(t, "acme", store.entries["dev/dev"].Tenant)
+
+	rec = serve(http.MethodPut, "/quotas/broken", `{"spec":{"limits":{"subnets":1,"vcpu":-1}}}`)
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.Contains(t, rec.Body.String(), "/spec/limits/subnets")
+	require.Contains(t, rec.Body.String(), "/spec/limits/vcpu")
+	require.NotContains(t, store.entries, "/broken")
+
+	rec = serve(http.MethodGet, "/workspaces/dev/usage", "")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, resource.Scope{Tenant: "acme", Workspace: "dev"}, usage.scope)
+	var report UsageReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.Equal(t, map[string]int{"instances": 1}, report.Remaining)
+	require.Len(t, report.Quotas, 1)
+
+	require.Equal(t, http.StatusAccepted, serve(http.MethodDelete, "/quotas/default", "").Code)
+	require.NotContains(t, store.entries, "/default")
+}
@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"encoding/csv"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	sdkschema "github.com/eu-sovereign-cloud/go-sdk/pkg/spec/schema"
+
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	sovdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/sovereignty"
+)
+
+// SovereigntyReport is the API representation of the sovereignty report of a tenant.
+type SovereigntyReport struct {
+	Metadata    sdkschema.ResponseMetadata `json:"metadata"`
+	Tenant      string                     `json:"tenant"`
+	GeneratedAt time.Time                  `json:"generatedAt"`
+	Resources   []SovereigntyEntry         `json:"resources"`
+	Summary     []SovereigntyKindSummary   `json:"summary"`
+}
+
+// SovereigntyEntry is the API representation of one resource within the report.
+type SovereigntyEntry struct {
+	Kind        string               `json:"kind"`
+	Workspace   string               `json:"workspace,omitempty"`
+	Name        string               `json:"name"`
+	Provider    string               `json:"provider"`
+	Region      string               `json:"region"`
+	Zone        string               `json:"zone,omitempty"`
+	State       string               `json:"state,omitempty"`
+	Plugin      string               `json:"plugin,omitempty"`
+	BackendRefs []backend.BackendRef `json:"backendRefs"`
+}
+
+// SovereigntyKindSummary is the API representation of the jurisdiction of one kind.
+type SovereigntyKindSummary struct {
+	Kind         string         `json:"kind"`
+	Count        int            `json:"count"`
+	Regions      map[string]int `json:"regions"`
+	Zones        map[string]int `json:"zones"`
+	Plugins      map[string]int `json:"plugins"`
+	Unattributed int            `json:"unattributed"`
+}
+
+// sovereigntyReportToAPI converts a sovereignty report to its API representation.
+func sovereigntyReportToAPI(report *sovdom.Report) *SovereigntyReport {
+	api := &SovereigntyReport{
+		Metadata: sdkschema.ResponseMetadata{
+			Provider: sovdom.ProviderID,
+			Resource: sovdom.Resource,
+			Verb:     http.MethodGet,
+		},
+		Tenant:      report.Tenant,
+		GeneratedAt: report.GeneratedAt,
+		Resources:   make([]SovereigntyEntry, len(report.Resources)),
+		Summary:     make([]SovereigntyKindSummary, len(report.Summary)),
+	}
+	for i, e := range report.Resources {
+		api.Resources[i] = SovereigntyEntry{
+			Kind:        e.Kind,
+			Workspace:   e.Workspace,
+			Name:        e.Name,
+			Provider:    e.Provider,
+			Region:      e.Region,
+			Zone:        e.Zone,
+			State:       e.State,
+			Plugin:      e.Plugin,
+			BackendRefs: e.BackendRefs,
+		}
+		if api.Resources[i].BackendRefs == nil {
+			api.Resources[i].BackendRefs = []backend.BackendRef{}
+		}
+	}
+	for i, s := range report.Summary {
+		api.Summary[i] = SovereigntyKindSummary{
+			Kind:         s.Kind,
+			Count:        s.Count,
+			Regions:      s.Regions,
+			Zones:        s.Zones,
+			Plugins:      s.Plugins,
+			Unattributed: s.Unattributed,
+		}
+	}
+	return api
+}
+
+// writeSovereigntyCSV writes the resources of report as CSV, one row per resource. Backend
+// refs are joined as "kind:id" pairs separated by ";".
+func writeSovereigntyCSV(w io.Writer, report *sovdom.Report) error {
+	out := csv.NewWriter(w)
+	_ = out.Write([]string{"kind", "workspace", "name", "provider", "region", "zone", "state", "plugin", "backend_refs"})
+	for _, e := range report.Resources {
+		refs := make([]string, len(e.BackendRefs))
+		for i, ref := range e.BackendRefs {
+			refs[i] = ref.Kind + ":" + ref.ID
+		}
+		_ = out.Write([]string{e.Kind, e.Workspace, e.Name, e.Provider, e.Region, e.Zone, e.State, e.Plugin, strings.Join(refs, ";")})
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// writeSovereigntySummaryCSV writes the per-kind summary of report as CSV, one row per kind
+// and region. The plugins and zones of a kind are repeated on each of its rows, joined as
+// "name=count" pairs separated by ";".
+func writeSovereigntySummaryCSV(w io.Writer, report *sovdom.Report) error {
+	out := csv.NewWriter(w)
+	_ = out.Write([]string{"kind", "region", "count", "kind_total", "zones", "plugins", "unattributed"})
+	for _, s := range report.Summary {
+		for _, region := range slices.Sorted(maps.Keys(s.Regions)) {
+			_ = out.Write([]string{
+				s.Kind, region, strconv.Itoa(s.Regions[region]), strconv.Itoa(s.Count),
+				joinCounts(s.Zones), joinCounts(s.Plugins), strconv.Itoa(s.Unattributed),
+			})
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// joinCounts renders counts as "name=count" pairs sorted by name and separated by ";".
+func joinCounts(counts map[string]int) string {
+	pairs := make([]string, 0, len(counts))
+	for _, name := range slices.Sorted(maps.Keys(counts)) {
+		pairs = append(pairs, name+"="+strconv.Itoa(counts[name]))
+	}
+	return strings.Join(pairs, ";")
+}
@@ -0,0 +1,89 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+
+	frest "github.com/eu-sovereign-cloud/ecp/framework/frontend/rest"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel"
+	persistencepkg "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/persistence"
+	"github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	sovdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/sovereignty"
+)
+
+// SovereigntyReporter builds the sovereignty report of a tenant.
+type SovereigntyReporter interface {
+	SovereigntyReport(ctx context.Context, tenant string) (*sovdom.Report, error)
+}
+
+// RegisterSovereigntyRoutes mounts the sovereignty report route under baseURL on mux. SECA
+// does not specify it, so it is not part of the generated ServerInterface. The middlewares
+// wrap it the way oapi-codegen applies them: the last one runs first.
+//
+//	GET /v1/tenants/{tenant}/sovereignty-report[?format=json|csv[&view=resources|summary]]
+func (h *Handler) RegisterSovereigntyRoutes(mux *http.ServeMux, baseURL string, middlewares ...func(http.Handler) http.Handler) {
+	var handler http.Handler = http.HandlerFunc(h.GetSovereigntyReport)
+	for _, mw := range middlewares {
+		handler = mw(handler)
+	}
+	mux.Handle("GET "+baseURL+"/v1/tenants/{tenant}/"+sovdom.Resource, handler)
+}
+
+// GetSovereigntyReport handles GET /v1/tenants/{tenant}/sovereignty-report. The report is
+// JSON by default. With format=csv it is one CSV row per resource, or with view=summary
+// one row per kind and region.
+func (h *Handler) GetSovereigntyReport(w http.ResponseWriter, r *http.Request) {
+	id := &resource.Identity{Scope: resource.Scope{Tenant: r.PathValue("tenant")}}
+	logger := h.Logger.With("provider", "workspace", "resource", sovdom.Resource)
+	query := r.URL.Query()
+
+	switch format := query.Get("format"); format {
+	case "", "json":
+		frest.HandleGet(w, r, logger, id, sovereigntyGetter{h.Sovereignty}, sovereigntyReportToAPI)
+		return
+	case "csv":
+	default:
+		frest.WriteErrorResponse(w, r, logger, kernel.NewError(kernel.KindValidation,
+			fmt.Errorf("unsupported report format %q: use json or csv", format),
+			kernel.ErrorSource{Name: "format", Value: format}))
+		return
+	}
+
+	write := writeSovereigntyCSV
+	switch view := query.Get("view"); view {
+	case "", "resources":
+	case "summary":
+		write = writeSovereigntySummaryCSV
+	default:
+		frest.WriteErrorResponse(w, r, logger, kernel.NewError(kernel.KindValidation,
+			fmt.Errorf("unsupported report view %q: use resources or summary", view),
+			kernel.ErrorSource{Name: "view", Value: view}))
+		return
+	}
+
+	report, err := h.Sovereignty.SovereigntyReport(r.Context(), id.Tenant)
+	if err != nil {
+		frest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+	var buf bytes.Buffer
+	if err := write(&buf, report); err != nil {
+		frest.WriteErrorResponse(w, r, logger, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", id.Tenant+"-"+sovdom.Resource+".csv"))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// sovereigntyGetter adapts a SovereigntyReporter to frest.Getter.
+type sovereigntyGetter struct {
+	reporter SovereigntyReporter
+}
+
+func (g sovereigntyGetter) Do(ctx context.Context, ir persistencepkg.IdentifiableResource) (*sovdom.Report, error) {
+	return g.reporter.SovereigntyReport(ctx, ir.GetTenant())
+}
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+	sovdom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/sovereignty"
+)
+
+// staticSovereignty reports two resources for every tenant.
+type staticSovereignty struct{}
+
+func (staticSovereignty) SovereigntyReport(_ context.Context, tenant string) (*sovdom.Report, error) {
+	return sovdom.NewReport(tenant, time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC), []sovdom.Entry{
+		{
+			Kind: "instances", Workspace: "prod", Name: "web-1", Provider: "seca.compute", Region: "itbg-bergamo",
+			Zone: "itbg-1", State: "active", Plugin: "aruba",
+			BackendRefs: []backend.BackendRef{{Kind: "CloudServer", ID: "ns/web-1"}, {Kind: "KeyPair", ID: "ns/web-1-keypair"}},
+		},
+		{Kind: "workspaces", Name: "prod", Provider: "seca.workspace", Region: "itbg-bergamo", State: "creating"},
+	}), nil
+}
+
+func TestSovereigntyRoutes(t *testing.T) {
+	h := &Handler{Sovereignty: staticSovereignty{}, Logger: slog.Default()}
+	mux := http.NewServeMux()
+	h.RegisterSovereigntyRoutes(mux, "/providers/seca.workspace")
+
+	serve := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/providers/seca.workspace/v1/tenants/acme/sovereignty-report"+query, nil)
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := serve("")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var report SovereigntyReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	require.Equal(t, "acme", report.Tenant)
+	require.Len(t, report.Resources, 2)
+	require.Equal(t, "CloudServer", report.Resources[0].BackendRefs[0].Kind)
+	require.NotNil(t, report.Resources[1].BackendRefs, "no refs encode as an empty list")
+	require.Equal(t, 1, report.Summary[1].Unattributed)
+
+	rec = serve("?format=csv")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "text/csv; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, strings.Join([]string{
+		"kind,workspace,name,provider,region,zone,state,plugin,backend_refs",
+		"instances,prod,web-1,seca.compute,itbg-bergamo,itbg-1,active,aruba,CloudServer:ns/web-1;KeyPair:ns/web-1-keypair",
+		"workspaces,,prod,seca.workspace,itbg-bergamo,,creating,,",
+		"",
+	}, "\n"), rec.Body.String())
+
+	rec = serve("?format=csv&view=summary")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, strings.Join([]string{
+		"kind,region,count,kind_total,zones,plugins,unattributed",
+		"instances,itbg-bergamo,1,1,itbg-1=1,aruba=1,0",
+		"workspaces,itbg-bergamo,1,1,,,1",
+		"",
+	}, "\n"), rec.Body.String())
+
+	rec = serve("?format=xml")
+	require.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	require.Contains(t, rec.Body.String(), "format")
+}
@@ -0,0 +1,114 @@
+package kubernetes
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	k8sadapter "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes"
+	k8slabels "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/labels"
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+
+	commonbackend "github.com/eu-sovereign-cloud/ecp/resource/common/backend"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	quotadom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota"
+)
+
+// QuotaFromCR converts either a concrete *Quota or *unstructured.Unstructured into a
+// *quotadom.Quota.
+func QuotaFromCR(obj client.Object) (*quotadom.Quota, error) {
+	var cr Quota
+
+	switch t := obj.(type) {
+	case *Quota:
+		cr = *t
+	case *unstructured.Unstructured:
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(t.Object, &cr); err != nil {
+			return nil, fmt.Errorf("failed to convert unstructured to Quota: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", obj)
+	}
+
+	crLabels := cr.GetLabels()
+	internalLabels := k8slabels.GetInternalLabels(crLabels)
+	keyedLabels := k8slabels.GetKeyedLabels(crLabels)
+
+	q := &quotadom.Quota{
+		Spec: quotadom.QuotaSpec{Limits: maps.Clone(cr.Spec.Limits)},
+	}
+	q.Name = cr.GetName()
+	q.ResourceVersion = cr.GetResourceVersion()
+	q.CreatedAt = cr.GetCreationTimestamp().Time
+	q.UpdatedAt = cr.GetCreationTimestamp().Time
+	q.Provider = strings.ReplaceAll(internalLabels[k8slabels.InternalProviderLabel], "_", "/")
+	q.Tenant = internalLabels[k8slabels.InternalTenantLabel]
+	q.Workspace = internalLabels[k8slabels.InternalWorkspaceLabel]
+	q.Region = internalLabels[k8slabels.InternalRegionLabel]
+	q.Labels = k8slabels.KeyedToOriginal(keyedLabels, cr.CommonData.Labels)
+	q.Annotations = cr.CommonData.Annotations
+	q.Extensions = cr.CommonData.Extensions
+
+	if ts := cr.GetDeletionTimestamp(); ts != nil {
+		q.DeletedAt = &ts.Time
+	}
+
+	q.Status = &quotadom.QuotaStatus{}
+	if cr.Status != nil {
+		q.Status.State = commonbackend.ResourceStateFromCR(cr.Status.State)
+		q.Status.Conditions = commonbackend.ConditionsFromCR(cr.Status.Conditions)
+	} else {
+		q.Status.PushCondition(commondomain.DefaultPendingCondition)
+	}
+
+	return q, nil
+}
+
+// QuotaToCR converts a *quotadom.Quota to a Kubernetes Quota CR. A tenant quota lives in
+// the tenant namespace, a workspace quota in the workspace namespace.
+func QuotaToCR(q *quotadom.Quota) (client.Object, error) {
+	if q == nil {
+		return nil, fmt.Errorf("quota is nil")
+	}
+
+	crLabels := k8slabels.OriginalToKeyed(q.Labels)
+	crLabels[k8slabels.InternalTenantLabel] = q.Tenant
+	crLabels[k8slabels.InternalWorkspaceLabel] = q.Workspace
+	crLabels[k8slabels.InternalProviderLabel] = strings.ReplaceAll(q.Provider, "/", "_")
+	crLabels[k8slabels.InternalRegionLabel] = q.Region
+
+	cr := &Quota{
+		ObjectMeta: v1.ObjectMeta{
+			Name:            q.Name,
+			Namespace:       k8sadapter.ComputeNamespace(q),
+			Labels:          crLabels,
+			ResourceVersion: q.ResourceVersion,
+		},
+		CommonData: schemav1.CommonData{
+			Annotations: q.Annotations,
+			Extensions:  q.Extensions,
+			Labels:      slices.Sorted(maps.Keys(q.Labels)),
+		},
+		Spec: QuotaSpec{Limits: maps.Clone(q.Spec.Limits)},
+	}
+	cr.SetGroupVersionKind(QuotaGVK)
+
+	if q.Status != nil && len(q.Status.Conditions) > 0 {
+		state := commonbackend.ResourceStateToCR(q.Status.State)
+		if state == nil {
+			return nil, fmt.Errorf("quota %s: failed to map resource state domain to CR", q.Name)
+		}
+		cr.Status = &QuotaStatus{
+			State:      *state,
+			Conditions: commonbackend.ConditionsToCR(q.Status.Conditions),
+		}
+	}
+
+	return cr, nil
+}
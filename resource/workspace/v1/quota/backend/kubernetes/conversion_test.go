@@ -0,0 +1,77 @@
+package kubernetes_test
+
+import (
+	"maps"
+	"testing"
+
+	kernelresource "github.com/eu-sovereign-cloud/ecp/framework/kernel/resource"
+	commondomain "github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+	quotadom "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota"
+	. "github.com/eu-sovereign-cloud/ecp/resource/workspace/v1/quota/backend/kubernetes"
+)
+
+// FuzzQuotaRoundTrip verifies that a Quota domain value survives a
+// domain→CR→domain→CR→domain round-trip.
+//
+// Invariants:
+//   - Name, Provider, Tenant, Workspace and Region are stable after one round-trip
+//     (domain2 == domain3).
+//   - Limits survive unchanged.
+func FuzzQuotaRoundTrip(f *testing.F) {
+	f.Add("default", "seca.workspace/v1", "t-1", "", "eu-central-1", "instances", 10, 64)
+	f.Add("", "", "", "", "", "", 0, 0)
+	f.Add("dev", "seca.workspace/v1", "tenant-42", "dev", "itbg-bergamo", "public-ips", 2, 0)
+
+	f.Fuzz(func(t *testing.T, name, provider, tenant, workspace, region, kind string, count, vcpu int) {
+		domain := &quotadom.Quota{
+			RegionalMetadata: commondomain.RegionalMetadata{
+				CommonMetadata: commondomain.CommonMetadata{
+					Name:     name,
+					Provider: provider,
+				},
+				Scope:  kernelresource.Scope{Tenant: tenant, Workspace: workspace},
+				Region: region,
+			},
+			Spec: quotadom.QuotaSpec{Limits: map[string]int{kind: count, quotadom.LimitVCPU: vcpu}},
+		}
+
+		cr1, err := QuotaToCR(domain)
+		if err != nil {
+			return
+		}
+
+		domain2, err := QuotaFromCR(cr1)
+		if err != nil {
+			t.Errorf("CR→domain failed after successful domain→CR: %v", err)
+			return
+		}
+
+		cr2, err := QuotaToCR(domain2)
+		if err != nil {
+			t.Errorf("second domain→CR failed: %v", err)
+			return
+		}
+
+		domain3, err := QuotaFromCR(cr2)
+		if err != nil {
+			t.Errorf("second CR→domain failed: %v", err)
+			return
+		}
+
+		if domain2.Name != domain3.Name {
+			t.Errorf("Name not stable: %q → %q", domain2.Name, domain3.Name)
+		}
+		if domain2.Provider != domain3.Provider {
+			t.Errorf("Provider not stable: %q → %q", domain2.Provider, domain3.Provider)
+		}
+		if domain2.Tenant != domain3.Tenant || domain2.Workspace != domain3.Workspace {
+			t.Errorf("Scope not stable: %+v → %+v", domain2.Scope, domain3.Scope)
+		}
+		if domain2.Region != domain3.Region {
+			t.Errorf("Region not stable: %q → %q", domain2.Region, domain3.Region)
+		}
+		if !maps.Equal(domain3.Spec.Limits, domain.Spec.Limits) {
+			t.Errorf("Limits not preserved: %v → %v", domain.Spec.Limits, domain3.Spec.Limits)
+		}
+	})
+}
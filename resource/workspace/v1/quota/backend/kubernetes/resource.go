@@ -0,0 +1,77 @@
+// +kubebuilder:object:generate=true
+// +groupName=workspace.v1.secapi.cloud
+// +versionName=v1
+
+// Package kubernetes holds the Quota custom resource and its domain conversion.
+//
+// SECA has no quota schema, so unlike the other resources of the group the spec is written
+// by hand rather than generated from the SDK.
+package kubernetes
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+
+	schemav1 "github.com/eu-sovereign-cloud/ecp/framework/backend/kubernetes/schema/v1"
+)
+
+const (
+	Group   = "workspace.v1.secapi.cloud"
+	Version = "v1"
+
+	QuotaResource = "quotas"
+	QuotaKind     = "Quota"
+)
+
+var (
+	GroupVersion  = schema.GroupVersion{Group: Group, Version: Version}
+	SchemeBuilder = &scheme.Builder{GroupVersion: GroupVersion}
+	AddToScheme   = SchemeBuilder.AddToScheme
+
+	QuotaGVR = schema.GroupVersionResource{
+		Group: Group, Version: Version, Resource: QuotaResource,
+	}
+	QuotaGVK = schema.GroupVersionKind{
+		Group: Group, Version: Version, Kind: QuotaKind,
+	}
+)
+
+// QuotaSpec is the desired state of a quota.
+type QuotaSpec struct {
+	// Limits maps a limit name — a resource kind such as "instances", or one of
+	// "block-storage-gb", "vcpu" and "ram" — to its maximum. Names not listed are unlimited.
+	// +optional
+	Limits map[string]int `json:"limits,omitempty"`
+}
+
+// QuotaStatus Current status of the resource
+type QuotaStatus = schemav1.Status
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=quotas,scope=Namespaced,shortName=quota
+// +k8s:openapi-gen=true
+
+// Quota is the API for capping what a tenant or workspace may hold in a region.
+type Quota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec       QuotaSpec           `json:"spec,omitempty"`
+	CommonData schemav1.CommonData `json:"commonData,omitempty"`
+	Status     *QuotaStatus        `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type QuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Quota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Quota{}, &QuotaList{})
+}
@@ -0,0 +1,183 @@
+// Package quota defines the quota resource domain model and identity constants.
+//
+// A Quota caps what a tenant, or one of its workspaces, may hold in a region: the number
+// of resources of each kind, the total block storage size, and the total vCPU and RAM of
+// its instances as declared by their InstanceSKU. The regional gateway checks a write
+// against every quota governing its scope before it is persisted.
+package quota
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/eu-sovereign-cloud/ecp/resource/common/domain"
+)
+
+// Identity constants for the quota resource.
+const (
+	Kind       = "Quota"
+	Resource   = "quotas"
+	Group      = "workspace.v1.secapi.cloud"
+	Version    = "v1"
+	ProviderID = "seca.workspace/v1"
+
+	// UsageResource is the resource path of the usage report.
+	UsageResource = "usage"
+)
+
+// Limit names that are not resource kinds.
+const (
+	// LimitBlockStorageGB caps the total size of the block storages, in GB.
+	LimitBlockStorageGB = "block-storage-gb"
+	// LimitVCPU caps the total vCPU of the instances, as declared by their SKU.
+	LimitVCPU = "vcpu"
+	// LimitRam caps the total RAM of the instances, in the unit of InstanceSKU.Spec.Ram.
+	LimitRam = "ram"
+)
+
+// CountedResources are the resource kinds whose number a quota can cap. Network-scoped
+// kinds (route tables, subnets) are not counted.
+var CountedResources = []string{
+	"workspaces",
+	"instances",
+	"block-storages",
+	"images",
+	"networks",
+	"nics",
+	"public-ips",
+	"internet-gateways",
+	"security-groups",
+	"security-group-rules",
+}
+
+// KnownLimit reports whether name is a limit a quota can set.
+func KnownLimit(name string) bool {
+	switch name {
+	case LimitBlockStorageGB, LimitVCPU, LimitRam:
+		return true
+	}
+	return slices.Contains(CountedResources, name)
+}
+
+// Quota is the domain model for a quota resource. An empty Workspace makes it a tenant
+// quota, which caps the sum over all the tenant's workspaces.
+type Quota struct {
+	domain.RegionalMetadata
+	Spec   QuotaSpec
+	Status *QuotaStatus
+}
+
+// QuotaSpec holds the limits of a quota.
+type QuotaSpec struct {
+	// Limits maps a limit name (a CountedResources kind or one of the Limit* constants) to
+	// its maximum. Names not listed are unlimited.
+	Limits map[string]int
+}
+
+// QuotaStatus defines the status for a quota.
+type QuotaStatus struct {
+	domain.Status
+}
+
+// Usage maps a limit name to the amount in use. Missing names are zero.
+type Usage map[string]int
+
+// Add returns the sum of u and other.
+func (u Usage) Add(other Usage) Usage {
+	sum := maps.Clone(u)
+	if sum == nil {
+		sum = Usage{}
+	}
+	for name, n := range other {
+		sum[name] += n
+	}
+	return sum
+}
+
+// Growth returns the amounts by which next exceeds u; shrinking amounts are left out, as
+// freeing capacity never violates a quota.
+func (u Usage) Growth(next Usage) Usage {
+	growth := Usage{}
+	for name, n := range next {
+		if d := n - u[name]; d > 0 {
+			growth[name] = d
+		}
+	}
+	return growth
+}
+
+// Excess describes one limit a write would exceed.
+type Excess struct {
+	Limit     string
+	Max       int
+	Used      int
+	Requested int
+}
+
+// Remaining returns the capacity left under the limit, never negative.
+func (e Excess) Remaining() int {
+	return max(e.Max-e.Used, 0)
+}
+
+// Remaining returns the capacity left under each limit of s given used, never negative.
+func (s QuotaSpec) Remaining(used Usage) Usage {
+	remaining := Usage{}
+	for name, limit := range s.Limits {
+		remaining[name] = max(limit-used[name], 0)
+	}
+	return remaining
+}
+
+// Exceeded returns the limits of s that adding requested to used would exceed, sorted by
+// limit name. Only the limits requested grows are checked, so a scope already over a
+// lowered quota may still shrink.
+func (s QuotaSpec) Exceeded(used, requested Usage) []Excess {
+	var excess []Excess
+	for _, name := range slices.Sorted(maps.Keys(s.Limits)) {
+		if requested[name] <= 0 {
+			continue
+		}
+		if limit := s.Limits[name]; used[name]+requested[name] > limit {
+			excess = append(excess, Excess{Limit: name, Max: limit, Used: used[name], Requested: requested[name]})
+		}
+	}
+	return excess
+}
+
+// UsageReport is the usage of a tenant or workspace against the quotas governing it.
+type UsageReport struct {
+	// Used is the usage of the reported scope.
+	Used Usage
+	// Quotas holds one entry per quota governing the scope: the tenant quotas and, for a
+	// workspace, its own quotas.
+	Quotas []QuotaUsage
+	// Remaining is the capacity left under each limit set by any of the quotas: the
+	// tightest of their remainders.
+	Remaining Usage
+}
+
+// QuotaUsage is the usage of one quota's scope against its limits.
+type QuotaUsage struct {
+	Name string
+	// Workspace is empty for a tenant quota.
+	Workspace string
+	Limits    Usage
+	// Used is the usage of the quota's scope, which for a tenant quota spans all the
+	// tenant's workspaces.
+	Used      Usage
+	Remaining Usage
+}
+
+// NewUsageReport returns the report of used against quotas, each given with the usage of
+// its own scope.
+func NewUsageReport(used Usage, quotas []QuotaUsage) *UsageReport {
+	report := &UsageReport{Used: used, Quotas: quotas, Remaining: Usage{}}
+	for _, q := range quotas {
+		for name, n := range q.Remaining {
+			if current, ok := report.Remaining[name]; !ok || n < current {
+				report.Remaining[name] = n
+			}
+		}
+	}
+	return report
+}
@@ -0,0 +1,51 @@
+package quota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageGrowth(t *testing.T) {
+	current := Usage{"instances": 1, LimitVCPU: 4, LimitRam: 8}
+	next := Usage{"instances": 1, LimitVCPU: 8, LimitRam: 4}
+
+	assert.Equal(t, Usage{LimitVCPU: 4}, current.Growth(next))
+	assert.Equal(t, Usage{"instances": 1, LimitVCPU: 4}, Usage(nil).Growth(Usage{"instances": 1, LimitVCPU: 4}))
+	assert.Equal(t, Usage{"instances": 2, LimitVCPU: 12, LimitRam: 12}, current.Add(next))
+}
+
+func TestQuotaSpecExceeded(t *testing.T) {
+	spec := QuotaSpec{Limits: map[string]int{"instances": 2, LimitVCPU: 8, "public-ips": 0}}
+	used := Usage{"instances": 2, LimitVCPU: 6, "public-ips": 3}
+
+	excess := spec.Exceeded(used, Usage{"instances": 1, LimitVCPU: 4, LimitRam: 64})
+
+	assert.Equal(t, []Excess{
+		{Limit: "instances", Max: 2, Used: 2, Requested: 1},
+		{Limit: LimitVCPU, Max: 8, Used: 6, Requested: 4},
+	}, excess)
+	assert.Equal(t, 2, excess[1].Remaining())
+	assert.Empty(t, spec.Exceeded(used, Usage{LimitVCPU: 2}), "filling a limit exactly is allowed")
+	assert.Empty(t, spec.Exceeded(used, Usage{"nics": 1}), "unlimited kinds are never exceeded")
+	assert.Equal(t, Usage{"instances": 0, LimitVCPU: 2, "public-ips": 0}, spec.Remaining(used))
+}
+
+func TestKnownLimit(t *testing.T) {
+	for _, name := range []string{"instances", "workspaces", LimitBlockStorageGB, LimitVCPU, LimitRam} {
+		assert.True(t, KnownLimit(name), name)
+	}
+	for _, name := range []string{"subnets", "skus", "cpu", ""} {
+		assert.False(t, KnownLimit(name), name)
+	}
+}
+
+func TestNewUsageReport(t *testing.T) {
+	report := NewUsageReport(Usage{"instances": 3}, []QuotaUsage{
+		{Name: "tenant", Remaining: Usage{"instances": 5, LimitVCPU: 16}},
+		{Name: "dev", Workspace: "dev", Remaining: Usage{"instances": 1}},
+	})
+
+	assert.Equal(t, Usage{"instances": 1, LimitVCPU: 16}, report.Remaining)
+	assert.Empty(t, NewUsageReport(Usage{}, nil).Remaining)
+}
@@ -0,0 +1,100 @@
+// Package sovereignty defines the sovereignty report of a tenant.
+//
+// The report locates every resource a tenant holds in a region: where it lives (region and
+// zone), which CSP plugin runs it, and the provider-side objects backing it, as recorded on
+// the resource by the plugin's controller. It is built by the regional gateway on request
+// and is not persisted.
+package sovereignty
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	backend "github.com/eu-sovereign-cloud/ecp/framework/kernel/port/backend"
+)
+
+// Identity constants for the sovereignty report.
+const (
+	Resource   = "sovereignty-report"
+	ProviderID = "seca.workspace/v1"
+)
+
+// Entry locates one resource of the tenant.
+type Entry struct {
+	// Kind is the resource kind, e.g. "instances".
+	Kind string
+	// Workspace holds the resource; empty for workspaces themselves.
+	Workspace string
+	Name      string
+	// Provider is the SECA provider serving the kind, e.g. "seca.compute".
+	Provider string
+	Region   string
+	// Zone is set for the kinds placed in a zone (instances, subnets).
+	Zone  string
+	State string
+	// Plugin is the CSP plugin reconciling the resource; empty until its controller has
+	// recorded it.
+	Plugin string
+	// BackendRefs are the provider-side objects backing the resource, as named by the
+	// plugin. Empty when the plugin does not name them.
+	BackendRefs []backend.BackendRef
+}
+
+// Attributed reports whether the resource's plugin is known.
+func (e Entry) Attributed() bool {
+	return e.Plugin != ""
+}
+
+// KindSummary is the jurisdiction of the resources of one kind: how many live in each
+// region and are run by each plugin.
+type KindSummary struct {
+	Kind  string
+	Count int
+	// Regions counts the resources per region.
+	Regions map[string]int
+	// Zones counts the resources per zone, for the kinds placed in one.
+	Zones map[string]int
+	// Plugins counts the resources per CSP plugin. Unattributed resources are not counted.
+	Plugins map[string]int
+	// Unattributed counts the resources whose plugin is not recorded yet.
+	Unattributed int
+}
+
+// Report is the sovereignty report of a tenant.
+type Report struct {
+	Tenant      string
+	GeneratedAt time.Time
+	// Resources are sorted by kind, workspace and name.
+	Resources []Entry
+	// Summary holds one entry per kind present, sorted by kind.
+	Summary []KindSummary
+}
+
+// NewReport returns the report of tenant over entries, sorting them and summarizing their
+// jurisdiction per kind.
+func NewReport(tenant string, generatedAt time.Time, entries []Entry) *Report {
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.Workspace, b.Workspace), cmp.Compare(a.Name, b.Name))
+	})
+	report := &Report{Tenant: tenant, GeneratedAt: generatedAt, Resources: entries}
+	for _, e := range entries {
+		if len(report.Summary) == 0 || report.Summary[len(report.Summary)-1].Kind != e.Kind {
+			report.Summary = append(report.Summary, KindSummary{
+				Kind: e.Kind, Regions: map[string]int{}, Zones: map[string]int{}, Plugins: map[string]int{},
+			})
+		}
+		s := &report.Summary[len(report.Summary)-1]
+		s.Count++
+		s.Regions[e.Region]++
+		if e.Zone != "" {
+			s.Zones[e.Zone]++
+		}
+		if e.Attributed() {
+			s.Plugins[e.Plugin]++
+		} else {
+			s.Unattributed++
+		}
+	}
+	return report
+}
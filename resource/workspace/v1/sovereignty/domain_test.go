@@ -0,0 +1,45 @@
+package sovereignty
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReport(t *testing.T) {
+	at := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	report := NewReport("acme", at, []Entry{
+		{Kind: "instances", Workspace: "prod", Name: "web-2", Region: "itbg-bergamo", Zone: "itbg-1", Plugin: "aruba"},
+		{Kind: "block-storages", Workspace: "prod", Name: "disk-1", Region: "itbg-bergamo"},
+		{Kind: "instances", Workspace: "dev", Name: "web-1", Region: "itbg-bergamo", Zone: "itbg-2", Plugin: "aruba"},
+	})
+
+	assert.Equal(t, "acme", report.Tenant)
+	assert.Equal(t, at, report.GeneratedAt)
+
+	var names []string
+	for _, e := range report.Resources {
+		names = append(names, e.Kind+"/"+e.Workspace+"/"+e.Name)
+	}
+	assert.Equal(t, []string{"block-storages/prod/disk-1", "instances/dev/web-1", "instances/prod/web-2"}, names)
+
+	assert.Equal(t, []KindSummary{
+		{
+			Kind: "block-storages", Count: 1,
+			Regions: map[string]int{"itbg-bergamo": 1}, Zones: map[string]int{}, Plugins: map[string]int{},
+			Unattributed: 1,
+		},
+		{
+			Kind: "instances", Count: 2,
+			Regions: map[string]int{"itbg-bergamo": 2}, Zones: map[string]int{"itbg-1": 1, "itbg-2": 1},
+			Plugins: map[string]int{"aruba": 2},
+		},
+	}, report.Summary)
+}
+
+func TestNewReport_Empty(t *testing.T) {
+	report := NewReport("acme", time.Time{}, nil)
+	assert.Empty(t, report.Resources)
+	assert.Empty(t, report.Summary)
+}
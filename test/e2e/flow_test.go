@@ -30,6 +30,7 @@ const (
 func TestEndToEnd(t *testing.T) {
 	ctx := context.Background()
 	blockStorageName := "e2e-bs-" + uuid.New().String()[:8]
+	internetGatewayName := "e2e-igw-" + uuid.New().String()[:8]
 	networkName := "e2e-net-" + uuid.New().String()[:8]
 	subnetName := "e2e-subnet-" + uuid.New().String()[:8]
 	routeTableName := "e2e-rt-" + uuid.New().String()[:8]
@@ -42,6 +43,7 @@ func TestEndToEnd(t *testing.T) {
 		_, _ = networkClient.DeleteSubnetWithResponse(ctx, testTenant, testWorkspace, networkName, subnetName, nil)
 		_, _ = networkClient.DeleteRouteTableWithResponse(ctx, testTenant, testWorkspace, networkName, routeTableName, nil)
 		_, _ = networkClient.DeleteNetworkWithResponse(ctx, testTenant, testWorkspace, networkName, nil)
+		_, _ = networkClient.DeleteInternetGatewayWithResponse(ctx, testTenant, testWorkspace, internetGatewayName, nil)
 		_, _ = storageClient.DeleteBlockStorageWithResponse(ctx, testTenant, testWorkspace, blockStorageName, nil)
 		_, _ = workspaceClient.DeleteWorkspaceWithResponse(ctx, testTenant, testWorkspace, nil)
 	})
@@ -113,6 +115,26 @@ func TestEndToEnd(t *testing.T) {
 		})
 	})
 
+	// A network waits on an active internet gateway in its workspace before it is created,
+	// so the gateway comes first.
+	t.Run("internet gateway created via API reconciles to active", func(t *testing.T) {
+		body := schema.InternetGateway{Spec: schema.InternetGatewaySpec{}}
+		resp, err := networkClient.CreateOrUpdateInternetGatewayWithResponse(ctx, testTenant, testWorkspace, internetGatewayName, nil, body)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode())
+
+		waitForActive(t, "internet gateway", func(ctx context.Context) (schema.ResourceState, bool, error) {
+			r, err := networkClient.GetInternetGatewayWithResponse(ctx, testTenant, testWorkspace, internetGatewayName)
+			if err != nil {
+				return "", false, err
+			}
+			if r.StatusCode() != http.StatusOK || r.JSON200 == nil || r.JSON200.Status == nil {
+				return "", false, nil
+			}
+			return r.JSON200.Status.State, true, nil
+		})
+	})
+
 	// Step 4: a workspace-scoped Network created through the regional gateway. Creating
 	// it provisions the network's own namespace (NetworkChildren), which the network-scoped
 	// resources below live in — so this step must precede the subnet and route table.
@@ -158,8 +158,8 @@ func TestMain(m *testing.M) {
 		dynamicClient,
 		instancek8s.InstanceGVR,
 		testLogger,
-		instancek8s.InstanceToCR,
-		instancek8s.InstanceFromCR,
+		instancek8s.InstanceToCR(nil),
+		instancek8s.InstanceFromCR(nil),
 	)
 
 	workspaceRepo = k8sadapter.NewNamespaceManagingRepoAdapter(